@@ -0,0 +1,66 @@
+package datasources
+
+// Data source name constants
+const (
+	// DataSourceInstanaAlertConfigVersions the name of the terraform-provider-instana data source to read the version history of smart alert configurations
+	DataSourceInstanaAlertConfigVersions = "alert_config_versions"
+)
+
+// Field name constants for the alert config versions
+const (
+	// AlertConfigVersionsFieldAlertConfigType constant value for the schema field alert_config_type
+	AlertConfigVersionsFieldAlertConfigType = "alert_config_type"
+	// AlertConfigVersionsFieldAlertConfigID constant value for the schema field alert_config_id
+	AlertConfigVersionsFieldAlertConfigID = "alert_config_id"
+	// AlertConfigVersionsFieldVersions constant value for the schema field versions
+	AlertConfigVersionsFieldVersions = "versions"
+	// AlertConfigVersionsFieldCreated constant value for the schema field versions.created
+	AlertConfigVersionsFieldCreated = "created"
+	// AlertConfigVersionsFieldDeleted constant value for the schema field versions.deleted
+	AlertConfigVersionsFieldDeleted = "deleted"
+	// AlertConfigVersionsFieldEnabled constant value for the schema field versions.enabled
+	AlertConfigVersionsFieldEnabled = "enabled"
+	// AlertConfigVersionsFieldChangeType constant value for the schema field versions.change_type
+	AlertConfigVersionsFieldChangeType = "change_type"
+	// AlertConfigVersionsFieldAuthorID constant value for the schema field versions.author_id
+	AlertConfigVersionsFieldAuthorID = "author_id"
+	// AlertConfigVersionsFieldAuthorType constant value for the schema field versions.author_type
+	AlertConfigVersionsFieldAuthorType = "author_type"
+)
+
+// Description constants for the alert config versions fields
+const (
+	// AlertConfigVersionsDescDataSource description for the data source
+	AlertConfigVersionsDescDataSource = "Data source for the version history of a smart alert configuration. " +
+		"The created timestamp of a version can be used to pin the alert configuration to that version with the resource instana_alert_config_version_pin."
+	// AlertConfigVersionsDescAlertConfigType description for the alert_config_type field
+	AlertConfigVersionsDescAlertConfigType = "The type of the smart alert configuration. Supported values: application, global_application, website, mobile_app, infra, synthetic."
+	// AlertConfigVersionsDescAlertConfigID description for the alert_config_id field
+	AlertConfigVersionsDescAlertConfigID = "The ID of the smart alert configuration."
+	// AlertConfigVersionsDescVersions description for the versions field
+	AlertConfigVersionsDescVersions = "The versions of the alert configuration sorted descending by their creation timestamp. Versions of deleted configurations are included."
+	// AlertConfigVersionsDescCreated description for the versions.created field
+	AlertConfigVersionsDescCreated = "The creation timestamp of the version in milliseconds since epoch which identifies the version."
+	// AlertConfigVersionsDescDeleted description for the versions.deleted field
+	AlertConfigVersionsDescDeleted = "Indicates whether the alert configuration was deleted with this version."
+	// AlertConfigVersionsDescEnabled description for the versions.enabled field
+	AlertConfigVersionsDescEnabled = "Indicates whether the alert configuration is enabled in this version."
+	// AlertConfigVersionsDescChangeType description for the versions.change_type field
+	AlertConfigVersionsDescChangeType = "The type of the change which created the version, e.g. CREATE, UPDATE, DELETE, ENABLE, DISABLE or RESTORE."
+	// AlertConfigVersionsDescAuthorID description for the versions.author_id field
+	AlertConfigVersionsDescAuthorID = "The ID of the author of the change."
+	// AlertConfigVersionsDescAuthorType description for the versions.author_type field
+	AlertConfigVersionsDescAuthorType = "The type of the author of the change, e.g. API, USER or INSTANA."
+)
+
+// Error message constants
+const (
+	// AlertConfigVersionsErrUnexpectedConfigureType error message for unexpected configure type
+	AlertConfigVersionsErrUnexpectedConfigureType = "Unexpected Data Source Configure Type"
+	// AlertConfigVersionsErrUnexpectedConfigureTypeDetail error message detail for unexpected configure type
+	AlertConfigVersionsErrUnexpectedConfigureTypeDetail = "Expected *instana.ProviderMeta, got: %T. Please report this issue to the provider developers."
+	// AlertConfigVersionsErrReading error message for reading the versions
+	AlertConfigVersionsErrReading = "Error reading alert config versions"
+	// AlertConfigVersionsErrReadingDetail error message detail for reading the versions
+	AlertConfigVersionsErrReadingDetail = "Could not read versions of %s alert config %s: %s"
+)
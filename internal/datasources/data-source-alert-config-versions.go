@@ -0,0 +1,167 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+	"github.com/instana/terraform-provider-instana/internal/util"
+)
+
+// AlertConfigVersionDataSourceModel represents the data model for a single version of a smart alert configuration
+type AlertConfigVersionDataSourceModel struct {
+	Created    types.Int64  `tfsdk:"created"`
+	Deleted    types.Bool   `tfsdk:"deleted"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	ChangeType types.String `tfsdk:"change_type"`
+	AuthorID   types.String `tfsdk:"author_id"`
+	AuthorType types.String `tfsdk:"author_type"`
+}
+
+// AlertConfigVersionsDataSourceModel represents the data model for the alert config versions data source
+type AlertConfigVersionsDataSourceModel struct {
+	AlertConfigType types.String                        `tfsdk:"alert_config_type"`
+	AlertConfigID   types.String                        `tfsdk:"alert_config_id"`
+	Versions        []AlertConfigVersionDataSourceModel `tfsdk:"versions"`
+}
+
+// NewAlertConfigVersionsDataSource creates a new data source for the version history of smart alert configurations
+func NewAlertConfigVersionsDataSource() datasource.DataSource {
+	return &alertConfigVersionsDataSource{}
+}
+
+type alertConfigVersionsDataSource struct {
+	instanaAPI client.InstanaAPI
+}
+
+func (d *alertConfigVersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + DataSourceInstanaAlertConfigVersions
+}
+
+func (d *alertConfigVersionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: AlertConfigVersionsDescDataSource,
+		Attributes: map[string]schema.Attribute{
+			AlertConfigVersionsFieldAlertConfigType: schema.StringAttribute{
+				Description: AlertConfigVersionsDescAlertConfigType,
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(instanaapi.SupportedAlertConfigTypes...),
+				},
+			},
+			AlertConfigVersionsFieldAlertConfigID: schema.StringAttribute{
+				Description: AlertConfigVersionsDescAlertConfigID,
+				Required:    true,
+			},
+			AlertConfigVersionsFieldVersions: schema.ListNestedAttribute{
+				Description: AlertConfigVersionsDescVersions,
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						AlertConfigVersionsFieldCreated: schema.Int64Attribute{
+							Description: AlertConfigVersionsDescCreated,
+							Computed:    true,
+						},
+						AlertConfigVersionsFieldDeleted: schema.BoolAttribute{
+							Description: AlertConfigVersionsDescDeleted,
+							Computed:    true,
+						},
+						AlertConfigVersionsFieldEnabled: schema.BoolAttribute{
+							Description: AlertConfigVersionsDescEnabled,
+							Computed:    true,
+						},
+						AlertConfigVersionsFieldChangeType: schema.StringAttribute{
+							Description: AlertConfigVersionsDescChangeType,
+							Computed:    true,
+						},
+						AlertConfigVersionsFieldAuthorID: schema.StringAttribute{
+							Description: AlertConfigVersionsDescAuthorID,
+							Computed:    true,
+						},
+						AlertConfigVersionsFieldAuthorType: schema.StringAttribute{
+							Description: AlertConfigVersionsDescAuthorType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *alertConfigVersionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerMeta, ok := req.ProviderData.(*shared.ProviderMeta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			AlertConfigVersionsErrUnexpectedConfigureType,
+			fmt.Sprintf(AlertConfigVersionsErrUnexpectedConfigureTypeDetail, req.ProviderData),
+		)
+		return
+	}
+
+	d.instanaAPI = providerMeta.InstanaAPI
+}
+
+func (d *alertConfigVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AlertConfigVersionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alertConfigType := data.AlertConfigType.ValueString()
+	alertConfigID := data.AlertConfigID.ValueString()
+	versions, err := d.readVersions(instanaapi.AlertConfigType(alertConfigType), alertConfigID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			AlertConfigVersionsErrReading,
+			fmt.Sprintf(AlertConfigVersionsErrReadingDetail, alertConfigType, alertConfigID, err),
+		)
+		return
+	}
+
+	data.Versions = mapAlertConfigVersionsToModel(versions)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *alertConfigVersionsDataSource) readVersions(alertConfigType instanaapi.AlertConfigType, alertConfigID string) ([]instanaapi.ConfigVersion, error) {
+	resourcePath, err := alertConfigType.ResourcePath()
+	if err != nil {
+		return nil, err
+	}
+	return instanaapi.From(d.instanaAPI).AlertConfigVersionHistory(resourcePath).GetVersions(alertConfigID)
+}
+
+// mapAlertConfigVersionsToModel maps the versions of the Instana API to the data source model
+func mapAlertConfigVersionsToModel(versions []instanaapi.ConfigVersion) []AlertConfigVersionDataSourceModel {
+	result := make([]AlertConfigVersionDataSourceModel, len(versions))
+	for i, version := range versions {
+		result[i] = AlertConfigVersionDataSourceModel{
+			Created:    types.Int64Value(version.Created),
+			Deleted:    types.BoolValue(version.Deleted),
+			Enabled:    util.SetBoolPointerToState(version.Enabled),
+			ChangeType: types.StringNull(),
+			AuthorID:   types.StringNull(),
+			AuthorType: types.StringNull(),
+		}
+		if version.ChangeSummary != nil {
+			result[i].ChangeType = types.StringValue(version.ChangeSummary.ChangeType)
+			if version.ChangeSummary.Author != nil {
+				result[i].AuthorID = util.SetStringPointerToState(version.ChangeSummary.Author.ID)
+				result[i].AuthorType = util.SetStringPointerToState(version.ChangeSummary.Author.Type)
+			}
+		}
+	}
+	return result
+}
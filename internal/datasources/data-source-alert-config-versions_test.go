@@ -0,0 +1,72 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertConfigVersionsDataSourceMetadata(t *testing.T) {
+	ds := NewAlertConfigVersionsDataSource()
+
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "instana"}, resp)
+
+	require.Equal(t, "instana_alert_config_versions", resp.TypeName)
+}
+
+func TestAlertConfigVersionsDataSourceSchema(t *testing.T) {
+	ds := NewAlertConfigVersionsDataSource()
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	require.Equal(t, AlertConfigVersionsDescDataSource, resp.Schema.Description)
+	require.True(t, resp.Schema.Attributes[AlertConfigVersionsFieldAlertConfigType].(schema.StringAttribute).Required)
+	require.True(t, resp.Schema.Attributes[AlertConfigVersionsFieldAlertConfigID].(schema.StringAttribute).Required)
+	require.True(t, resp.Schema.Attributes[AlertConfigVersionsFieldVersions].(schema.ListNestedAttribute).Computed)
+}
+
+func TestMapAlertConfigVersionsToModel(t *testing.T) {
+	enabled := false
+	authorID := "user-id"
+	authorType := "USER"
+	versions := []instanaapi.ConfigVersion{
+		{
+			ID:      "alert-id",
+			Created: 2000,
+			Enabled: &enabled,
+			ChangeSummary: &instanaapi.ConfigVersionChangeSummary{
+				Author:     &instanaapi.ConfigVersionAuthor{ID: &authorID, Type: &authorType},
+				ChangeType: "DISABLE",
+			},
+		},
+		{ID: "alert-id", Created: 1000},
+	}
+
+	result := mapAlertConfigVersionsToModel(versions)
+
+	require.Equal(t, []AlertConfigVersionDataSourceModel{
+		{
+			Created:    types.Int64Value(2000),
+			Deleted:    types.BoolValue(false),
+			Enabled:    types.BoolValue(false),
+			ChangeType: types.StringValue("DISABLE"),
+			AuthorID:   types.StringValue(authorID),
+			AuthorType: types.StringValue(authorType),
+		},
+		{
+			Created:    types.Int64Value(1000),
+			Deleted:    types.BoolValue(false),
+			Enabled:    types.BoolNull(),
+			ChangeType: types.StringNull(),
+			AuthorID:   types.StringNull(),
+			AuthorType: types.StringNull(),
+		},
+	}, result)
+}
@@ -0,0 +1,58 @@
+package datasources
+
+// Data source name constants
+const (
+	// DataSourceInstanaBackendInfo the name of the terraform-provider-instana data source to read the information about the Instana backend
+	DataSourceInstanaBackendInfo = "backend_info"
+)
+
+// Field name constants for the backend info
+const (
+	// BackendInfoFieldID constant value for the schema field id
+	BackendInfoFieldID = "id"
+	// BackendInfoFieldEndpoint constant value for the schema field endpoint
+	BackendInfoFieldEndpoint = "endpoint"
+	// BackendInfoFieldTenant constant value for the schema field tenant
+	BackendInfoFieldTenant = "tenant"
+	// BackendInfoFieldUnit constant value for the schema field unit
+	BackendInfoFieldUnit = "unit"
+	// BackendInfoFieldVersion constant value for the schema field version
+	BackendInfoFieldVersion = "version"
+	// BackendInfoFieldBranch constant value for the schema field branch
+	BackendInfoFieldBranch = "branch"
+	// BackendInfoFieldCommit constant value for the schema field commit
+	BackendInfoFieldCommit = "commit"
+)
+
+// Description constants for the backend info fields
+const (
+	// BackendInfoDescDataSource description for the data source
+	BackendInfoDescDataSource = "Data source for the information about the Instana backend the provider is connected to. " +
+		"The data source can be used to verify in a plan which tenant unit and version are targeted."
+	// BackendInfoDescID description for the id field
+	BackendInfoDescID = "The ID of the data source which is the endpoint of the backend."
+	// BackendInfoDescEndpoint description for the endpoint field
+	BackendInfoDescEndpoint = "The base URL of the Instana API used by the provider."
+	// BackendInfoDescTenant description for the tenant field
+	BackendInfoDescTenant = "The tenant of the backend. The Instana API does not provide the tenant, so it is determined from the host name of endpoints following the naming scheme <unit>-<tenant>.<domain>, otherwise null."
+	// BackendInfoDescUnit description for the unit field
+	BackendInfoDescUnit = "The tenant unit of the backend. The Instana API does not provide the unit, so it is determined from the host name of endpoints following the naming scheme <unit>-<tenant>.<domain>, otherwise null."
+	// BackendInfoDescVersion description for the version field
+	BackendInfoDescVersion = "The version (image tag) of the Instana backend."
+	// BackendInfoDescBranch description for the branch field
+	BackendInfoDescBranch = "The release branch of the Instana backend."
+	// BackendInfoDescCommit description for the commit field
+	BackendInfoDescCommit = "The commit of the Instana backend."
+)
+
+// Error message constants
+const (
+	// BackendInfoErrUnexpectedConfigureType error message for unexpected configure type
+	BackendInfoErrUnexpectedConfigureType = "Unexpected Data Source Configure Type"
+	// BackendInfoErrUnexpectedConfigureTypeDetail error message detail for unexpected configure type
+	BackendInfoErrUnexpectedConfigureTypeDetail = "Expected *instana.ProviderMeta, got: %T. Please report this issue to the provider developers."
+	// BackendInfoErrReading error message for reading the backend info
+	BackendInfoErrReading = "Error reading Instana backend info"
+	// BackendInfoErrReadingDetail error message detail for reading the backend info
+	BackendInfoErrReadingDetail = "Could not read the information about the Instana backend: %s"
+)
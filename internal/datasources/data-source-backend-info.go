@@ -0,0 +1,125 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+)
+
+// BackendInfoDataSourceModel represents the data model for the backend info data source
+type BackendInfoDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Endpoint types.String `tfsdk:"endpoint"`
+	Tenant   types.String `tfsdk:"tenant"`
+	Unit     types.String `tfsdk:"unit"`
+	Version  types.String `tfsdk:"version"`
+	Branch   types.String `tfsdk:"branch"`
+	Commit   types.String `tfsdk:"commit"`
+}
+
+// NewBackendInfoDataSource creates a new data source for the information about the Instana backend
+func NewBackendInfoDataSource() datasource.DataSource {
+	return &backendInfoDataSource{}
+}
+
+type backendInfoDataSource struct {
+	instanaAPI client.InstanaAPI
+}
+
+func (d *backendInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + DataSourceInstanaBackendInfo
+}
+
+func (d *backendInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: BackendInfoDescDataSource,
+		Attributes: map[string]schema.Attribute{
+			BackendInfoFieldID: schema.StringAttribute{
+				Description: BackendInfoDescID,
+				Computed:    true,
+			},
+			BackendInfoFieldEndpoint: schema.StringAttribute{
+				Description: BackendInfoDescEndpoint,
+				Computed:    true,
+			},
+			BackendInfoFieldTenant: schema.StringAttribute{
+				Description: BackendInfoDescTenant,
+				Computed:    true,
+			},
+			BackendInfoFieldUnit: schema.StringAttribute{
+				Description: BackendInfoDescUnit,
+				Computed:    true,
+			},
+			BackendInfoFieldVersion: schema.StringAttribute{
+				Description: BackendInfoDescVersion,
+				Computed:    true,
+			},
+			BackendInfoFieldBranch: schema.StringAttribute{
+				Description: BackendInfoDescBranch,
+				Computed:    true,
+			},
+			BackendInfoFieldCommit: schema.StringAttribute{
+				Description: BackendInfoDescCommit,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *backendInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerMeta, ok := req.ProviderData.(*shared.ProviderMeta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			BackendInfoErrUnexpectedConfigureType,
+			fmt.Sprintf(BackendInfoErrUnexpectedConfigureTypeDetail, req.ProviderData),
+		)
+		return
+	}
+
+	d.instanaAPI = providerMeta.InstanaAPI
+}
+
+func (d *backendInfoDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	info, err := instanaapi.From(d.instanaAPI).BackendInfo().Read()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			BackendInfoErrReading,
+			fmt.Sprintf(BackendInfoErrReadingDetail, err),
+		)
+		return
+	}
+
+	data := mapBackendInfoToModel(info)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// mapBackendInfoToModel maps the backend info of the Instana API to the data source model
+func mapBackendInfoToModel(info *instanaapi.BackendInfo) BackendInfoDataSourceModel {
+	return BackendInfoDataSourceModel{
+		ID:       types.StringValue(info.Endpoint),
+		Endpoint: types.StringValue(info.Endpoint),
+		Tenant:   stringValueOrNull(info.Tenant),
+		Unit:     stringValueOrNull(info.Unit),
+		Version:  types.StringValue(info.Version.ImageTag),
+		Branch:   types.StringValue(info.Version.Branch),
+		Commit:   types.StringValue(info.Version.Commit),
+	}
+}
+
+// stringValueOrNull returns a null value for empty strings
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
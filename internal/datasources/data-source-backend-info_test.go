@@ -0,0 +1,60 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBackendInfoDataSource(t *testing.T) {
+	ds := NewBackendInfoDataSource()
+	require.NotNil(t, ds)
+}
+
+func TestBackendInfoDataSourceMetadata(t *testing.T) {
+	ds := NewBackendInfoDataSource()
+
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "instana"}, resp)
+
+	require.Equal(t, "instana_backend_info", resp.TypeName)
+}
+
+func TestBackendInfoDataSourceSchema(t *testing.T) {
+	ds := NewBackendInfoDataSource()
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	require.Equal(t, BackendInfoDescDataSource, resp.Schema.Description)
+	require.Len(t, resp.Schema.Attributes, 7)
+	for name, attribute := range resp.Schema.Attributes {
+		require.True(t, attribute.(schema.StringAttribute).Computed, name)
+	}
+}
+
+func TestMapBackendInfoToModel(t *testing.T) {
+	version := instanaapi.InstanaVersionInfo{Branch: "release-301", Commit: "abc123", ImageTag: "3.301.123-0"}
+
+	result := mapBackendInfoToModel(&instanaapi.BackendInfo{Endpoint: "https://prod-acme.instana.io", Tenant: "acme", Unit: "prod", Version: version})
+
+	require.Equal(t, BackendInfoDataSourceModel{
+		ID:       types.StringValue("https://prod-acme.instana.io"),
+		Endpoint: types.StringValue("https://prod-acme.instana.io"),
+		Tenant:   types.StringValue("acme"),
+		Unit:     types.StringValue("prod"),
+		Version:  types.StringValue("3.301.123-0"),
+		Branch:   types.StringValue("release-301"),
+		Commit:   types.StringValue("abc123"),
+	}, result)
+
+	result = mapBackendInfoToModel(&instanaapi.BackendInfo{Endpoint: "https://instana.example.com", Version: version})
+
+	require.True(t, result.Tenant.IsNull())
+	require.True(t, result.Unit.IsNull())
+}
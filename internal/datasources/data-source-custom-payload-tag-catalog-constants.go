@@ -0,0 +1,54 @@
+package datasources
+
+// Data source name constants
+const (
+	// DataSourceInstanaCustomPayloadTagCatalog the name of the terraform-provider-instana data source to read the tag catalog of custom payloads
+	DataSourceInstanaCustomPayloadTagCatalog = "custom_payload_tag_catalog"
+)
+
+// Field name constants for the custom payload tag catalog
+const (
+	// CustomPayloadTagCatalogFieldTagNames constant value for the schema field tag_names
+	CustomPayloadTagCatalogFieldTagNames = "tag_names"
+	// CustomPayloadTagCatalogFieldTags constant value for the schema field tags
+	CustomPayloadTagCatalogFieldTags = "tags"
+	// CustomPayloadTagCatalogFieldTagName constant value for the schema field tags.name
+	CustomPayloadTagCatalogFieldTagName = "name"
+	// CustomPayloadTagCatalogFieldTagType constant value for the schema field tags.type
+	CustomPayloadTagCatalogFieldTagType = "type"
+	// CustomPayloadTagCatalogFieldTagLabel constant value for the schema field tags.label
+	CustomPayloadTagCatalogFieldTagLabel = "label"
+	// CustomPayloadTagCatalogFieldTagDescription constant value for the schema field tags.description
+	CustomPayloadTagCatalogFieldTagDescription = "description"
+)
+
+// Description constants for the custom payload tag catalog fields
+const (
+	// CustomPayloadTagCatalogDescDataSource description for the data source
+	CustomPayloadTagCatalogDescDataSource = "Data source for the catalog of tags which can be used as tag_name of dynamic custom payload fields. " +
+		"Referencing a tag by its name in the tags map fails at plan time when the tag does not exist."
+	// CustomPayloadTagCatalogDescTagNames description for the tag_names field
+	CustomPayloadTagCatalogDescTagNames = "The names of all tags of the catalog."
+	// CustomPayloadTagCatalogDescTags description for the tags field
+	CustomPayloadTagCatalogDescTags = "The tags of the catalog keyed by the tag name."
+	// CustomPayloadTagCatalogDescTagName description for the tags.name field
+	CustomPayloadTagCatalogDescTagName = "The name of the tag."
+	// CustomPayloadTagCatalogDescTagType description for the tags.type field
+	CustomPayloadTagCatalogDescTagType = "The type of the tag values, e.g. STRING or KEY_VALUE_PAIR."
+	// CustomPayloadTagCatalogDescTagLabel description for the tags.label field
+	CustomPayloadTagCatalogDescTagLabel = "The label of the tag."
+	// CustomPayloadTagCatalogDescTagDescription description for the tags.description field
+	CustomPayloadTagCatalogDescTagDescription = "The description of the tag."
+)
+
+// Error message constants
+const (
+	// CustomPayloadTagCatalogErrUnexpectedConfigureType error message for unexpected configure type
+	CustomPayloadTagCatalogErrUnexpectedConfigureType = "Unexpected Data Source Configure Type"
+	// CustomPayloadTagCatalogErrUnexpectedConfigureTypeDetail error message detail for unexpected configure type
+	CustomPayloadTagCatalogErrUnexpectedConfigureTypeDetail = "Expected *instana.ProviderMeta, got: %T. Please report this issue to the provider developers."
+	// CustomPayloadTagCatalogErrReading error message for reading the tag catalog
+	CustomPayloadTagCatalogErrReading = "Error reading custom payload tag catalog"
+	// CustomPayloadTagCatalogErrReadingDetail error message detail for reading the tag catalog
+	CustomPayloadTagCatalogErrReadingDetail = "Could not read custom payload tag catalog: %s"
+)
@@ -0,0 +1,140 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+	"github.com/instana/terraform-provider-instana/internal/util"
+)
+
+// CustomPayloadTagDataSourceModel represents the data model for a single tag of the custom payload tag catalog
+type CustomPayloadTagDataSourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Label       types.String `tfsdk:"label"`
+	Description types.String `tfsdk:"description"`
+}
+
+// CustomPayloadTagCatalogDataSourceModel represents the data model for the custom payload tag catalog data source
+type CustomPayloadTagCatalogDataSourceModel struct {
+	TagNames types.List                                 `tfsdk:"tag_names"`
+	Tags     map[string]CustomPayloadTagDataSourceModel `tfsdk:"tags"`
+}
+
+// NewCustomPayloadTagCatalogDataSource creates a new data source for the custom payload tag catalog
+func NewCustomPayloadTagCatalogDataSource() datasource.DataSource {
+	return &customPayloadTagCatalogDataSource{}
+}
+
+type customPayloadTagCatalogDataSource struct {
+	instanaAPI client.InstanaAPI
+}
+
+func (d *customPayloadTagCatalogDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + DataSourceInstanaCustomPayloadTagCatalog
+}
+
+func (d *customPayloadTagCatalogDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: CustomPayloadTagCatalogDescDataSource,
+		Attributes: map[string]schema.Attribute{
+			CustomPayloadTagCatalogFieldTagNames: schema.ListAttribute{
+				Description: CustomPayloadTagCatalogDescTagNames,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			CustomPayloadTagCatalogFieldTags: schema.MapNestedAttribute{
+				Description: CustomPayloadTagCatalogDescTags,
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						CustomPayloadTagCatalogFieldTagName: schema.StringAttribute{
+							Description: CustomPayloadTagCatalogDescTagName,
+							Computed:    true,
+						},
+						CustomPayloadTagCatalogFieldTagType: schema.StringAttribute{
+							Description: CustomPayloadTagCatalogDescTagType,
+							Computed:    true,
+						},
+						CustomPayloadTagCatalogFieldTagLabel: schema.StringAttribute{
+							Description: CustomPayloadTagCatalogDescTagLabel,
+							Computed:    true,
+						},
+						CustomPayloadTagCatalogFieldTagDescription: schema.StringAttribute{
+							Description: CustomPayloadTagCatalogDescTagDescription,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *customPayloadTagCatalogDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerMeta, ok := req.ProviderData.(*shared.ProviderMeta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			CustomPayloadTagCatalogErrUnexpectedConfigureType,
+			fmt.Sprintf(CustomPayloadTagCatalogErrUnexpectedConfigureTypeDetail, req.ProviderData),
+		)
+		return
+	}
+
+	d.instanaAPI = providerMeta.InstanaAPI
+}
+
+func (d *customPayloadTagCatalogDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	catalog, err := instanaapi.From(d.instanaAPI).CustomPayloadTagCatalog()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			CustomPayloadTagCatalogErrReading,
+			fmt.Sprintf(CustomPayloadTagCatalogErrReadingDetail, err),
+		)
+		return
+	}
+
+	data, diags := mapCustomPayloadTagCatalogToModel(ctx, catalog)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// mapCustomPayloadTagCatalogToModel maps the tag catalog of the Instana API to the data source model
+func mapCustomPayloadTagCatalogToModel(ctx context.Context, catalog *instanaapi.TagCatalog) (CustomPayloadTagCatalogDataSourceModel, diag.Diagnostics) {
+	tags := make(map[string]CustomPayloadTagDataSourceModel, len(catalog.Tags))
+	tagNames := make([]string, 0, len(catalog.Tags))
+	for _, tag := range catalog.Tags {
+		if _, exists := tags[tag.Name]; !exists {
+			tagNames = append(tagNames, tag.Name)
+		}
+		tags[tag.Name] = CustomPayloadTagDataSourceModel{
+			Name:        types.StringValue(tag.Name),
+			Type:        types.StringValue(tag.Type),
+			Label:       util.SetStringPointerToState(tag.Label),
+			Description: util.SetStringPointerToState(tag.Description),
+		}
+	}
+	sort.Strings(tagNames)
+
+	tagNamesList, diags := types.ListValueFrom(ctx, types.StringType, tagNames)
+	return CustomPayloadTagCatalogDataSourceModel{
+		TagNames: tagNamesList,
+		Tags:     tags,
+	}, diags
+}
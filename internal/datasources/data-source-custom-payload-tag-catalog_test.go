@@ -0,0 +1,60 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCustomPayloadTagCatalogDataSource(t *testing.T) {
+	ds := NewCustomPayloadTagCatalogDataSource()
+	require.NotNil(t, ds)
+}
+
+func TestCustomPayloadTagCatalogDataSourceMetadata(t *testing.T) {
+	ds := NewCustomPayloadTagCatalogDataSource()
+
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "instana"}, resp)
+
+	require.Equal(t, "instana_custom_payload_tag_catalog", resp.TypeName)
+}
+
+func TestCustomPayloadTagCatalogDataSourceSchema(t *testing.T) {
+	ds := NewCustomPayloadTagCatalogDataSource()
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	require.Equal(t, CustomPayloadTagCatalogDescDataSource, resp.Schema.Description)
+	require.True(t, resp.Schema.Attributes[CustomPayloadTagCatalogFieldTagNames].(schema.ListAttribute).Computed)
+	require.True(t, resp.Schema.Attributes[CustomPayloadTagCatalogFieldTags].(schema.MapNestedAttribute).Computed)
+}
+
+func TestMapCustomPayloadTagCatalogToModel(t *testing.T) {
+	label := "Cluster Name"
+	catalog := &instanaapi.TagCatalog{
+		Tags: []instanaapi.TagCatalogTag{
+			{Name: "kubernetes.pod.name", Type: "STRING"},
+			{Name: "kubernetes.cluster.name", Type: "STRING", Label: &label},
+			{Name: "kubernetes.pod.name", Type: "STRING"},
+		},
+	}
+
+	result, diags := mapCustomPayloadTagCatalogToModel(context.Background(), catalog)
+
+	require.False(t, diags.HasError())
+	require.Equal(t, types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("kubernetes.cluster.name"),
+		types.StringValue("kubernetes.pod.name"),
+	}), result.TagNames)
+	require.Len(t, result.Tags, 2)
+	require.Equal(t, label, result.Tags["kubernetes.cluster.name"].Label.ValueString())
+	require.True(t, result.Tags["kubernetes.pod.name"].Description.IsNull())
+}
@@ -179,6 +179,7 @@ func (d *RbacTeamDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 							},
 							team.TeamFieldScopeRestrictedApplicationFilterTagFilterExpression: schema.StringAttribute{
 								Description: team.TeamDescScopeRestrictedApplicationFilterTagFilterExpression,
+								CustomType:  shared.TagFilterType{},
 								Computed:    true,
 							},
 						},
@@ -322,7 +323,7 @@ func (d *RbacTeamDataSource) Read(ctx context.Context, req datasource.ReadReques
 			if teamData.Scope.RestrictedApplicationFilter.Scope != nil {
 				scopeModel.RestrictedApplicationFilter.Scope = types.StringValue(string(*teamData.Scope.RestrictedApplicationFilter.Scope))
 			}
-			scopeModel.RestrictedApplicationFilter.TagFilterExpression = types.StringNull()
+			scopeModel.RestrictedApplicationFilter.TagFilterExpression = shared.NewTagFilterNull()
 		}
 
 		data.Scope = scopeModel
@@ -0,0 +1,222 @@
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/instana/instana-go-client/client"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	// ImportsFileName the name of the generated file containing the import blocks
+	ImportsFileName = "imports.tf"
+	// VariablesFileName the name of the generated file containing the variables of sensitive values
+	VariablesFileName = "variables.tf"
+
+	providerName = "instana"
+)
+
+// Dump the JSON representation of the objects of a tenant unit by resource type name. A dump allows to export the
+// configuration offline.
+type Dump map[string][]json.RawMessage
+
+// FetchDump reads all objects of the given resource types from the Instana API. Resource types which cannot be read
+// are reported as warnings and are not part of the dump.
+func FetchDump(resourceTypes []ResourceType, api client.InstanaAPI) (Dump, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	dump := Dump{}
+	for _, resourceType := range resourceTypes {
+		objects, err := resourceType.Fetch(api)
+		if err != nil {
+			diags.AddWarning("Failed to read objects", fmt.Sprintf("The %s objects are not exported: %s", resourceType.Name(), err))
+			continue
+		}
+		dump[resourceType.Name()] = objects
+	}
+	return dump, diags
+}
+
+// ReadDump reads a dump in JSON format
+func ReadDump(reader io.Reader) (Dump, error) {
+	dump := Dump{}
+	if err := json.NewDecoder(reader).Decode(&dump); err != nil {
+		return nil, fmt.Errorf("failed to read dump: %w", err)
+	}
+	return dump, nil
+}
+
+// Write writes the dump in JSON format
+func (d Dump) Write(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(d)
+}
+
+// New creates a new Exporter for the given resource types
+func New(resourceTypes []ResourceType) *Exporter {
+	return &Exporter{resourceTypes: resourceTypes}
+}
+
+// Exporter generates terraform configuration files and import blocks for the objects of a dump
+type Exporter struct {
+	resourceTypes []ResourceType
+}
+
+// exportedObject an object of the dump with its terraform address
+type exportedObject struct {
+	resourceType ResourceType
+	object       *Object
+	reference    reference
+}
+
+// Export generates the terraform configuration of all objects of the dump. The result contains the content of the
+// generated files by file name: one file per resource type with the resource blocks, the import blocks and the
+// variables replacing sensitive values. IDs of exported objects within reference attributes (e.g. the alert channel
+// IDs of alert configurations) are replaced by references to the exported resources when the object is of the
+// resource type referenced by the attribute.
+func (e *Exporter) Export(ctx context.Context, dump Dump) (map[string][]byte, diag.Diagnostics) {
+	objects, diags := e.mapObjects(ctx, dump)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	references := map[string]map[string]reference{}
+	for _, obj := range objects {
+		name := obj.resourceType.Name()
+		if _, ok := references[name]; !ok {
+			references[name] = map[string]reference{}
+		}
+		references[name][obj.object.ID] = obj.reference
+	}
+
+	files := map[string]*hclwrite.File{}
+	imports := hclwrite.NewEmptyFile()
+	variables := hclwrite.NewEmptyFile()
+	for _, obj := range objects {
+		fileName := obj.resourceType.Name() + ".tf"
+		file, ok := files[fileName]
+		if !ok {
+			file = hclwrite.NewEmptyFile()
+			files[fileName] = file
+		} else {
+			file.Body().AppendNewline()
+		}
+
+		resourceSchema := obj.resourceType.Schema()
+		writer := &objectWriter{
+			address:             obj.reference.address(),
+			referenceAttributes: obj.resourceType.ReferenceAttributes(),
+			references:          references,
+		}
+		block := file.Body().AppendNewBlock("resource", []string{obj.reference.resourceType, obj.reference.label})
+		if err := writer.writeBody(block.Body(), resourceSchema.Attributes, resourceSchema.Blocks, obj.object.Value, nil); err != nil {
+			diags.AddError("Failed to generate configuration", fmt.Sprintf("The configuration of %s (%s) cannot be generated: %s", obj.reference.address(), obj.object.ID, err))
+			return nil, diags
+		}
+
+		if len(imports.Body().Blocks()) > 0 {
+			imports.Body().AppendNewline()
+		}
+		importBody := imports.Body().AppendNewBlock("import", nil).Body()
+		importBody.SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: obj.reference.resourceType}, hcl.TraverseAttr{Name: obj.reference.label}})
+		importBody.SetAttributeValue("id", cty.StringVal(obj.object.ID))
+
+		for _, variable := range writer.variables {
+			if len(variables.Body().Blocks()) > 0 {
+				variables.Body().AppendNewline()
+			}
+			variableBody := variables.Body().AppendNewBlock("variable", []string{variable.name}).Body()
+			variableBody.SetAttributeValue("description", cty.StringVal(variable.description))
+			variableBody.SetAttributeValue("sensitive", cty.True)
+		}
+	}
+
+	result := map[string][]byte{}
+	for fileName, file := range files {
+		result[fileName] = hclwrite.Format(file.Bytes())
+	}
+	if len(objects) > 0 {
+		result[ImportsFileName] = hclwrite.Format(imports.Bytes())
+	}
+	if len(variables.Body().Blocks()) > 0 {
+		result[VariablesFileName] = hclwrite.Format(variables.Bytes())
+	}
+	return result, diags
+}
+
+// mapObjects maps the objects of the dump to their terraform state values and assigns unique labels based on the
+// names of the objects. The objects are sorted by resource type and label.
+func (e *Exporter) mapObjects(ctx context.Context, dump Dump) ([]exportedObject, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	resourceTypes := map[string]ResourceType{}
+	for _, resourceType := range e.resourceTypes {
+		resourceTypes[resourceType.Name()] = resourceType
+	}
+
+	var result []exportedObject
+	for _, name := range sortedKeys(dump) {
+		resourceType, ok := resourceTypes[name]
+		if !ok {
+			diags.AddWarning("Unknown resource type", fmt.Sprintf("The objects of the unknown resource type %s are not exported.", name))
+			continue
+		}
+
+		var objects []exportedObject
+		labels := map[string]bool{}
+		for _, data := range dump[name] {
+			obj, mapDiags := resourceType.Map(ctx, data)
+			diags.Append(mapDiags...)
+			if mapDiags.HasError() {
+				return nil, diags
+			}
+			label := uniqueLabel(labels, objectLabel(resourceType, obj))
+			objects = append(objects, exportedObject{
+				resourceType: resourceType,
+				object:       obj,
+				reference: reference{
+					resourceType: providerName + "_" + name,
+					label:        label,
+					idAttribute:  resourceType.IDAttribute(),
+				},
+			})
+		}
+		sort.SliceStable(objects, func(i, j int) bool {
+			return objects[i].reference.label < objects[j].reference.label
+		})
+		result = append(result, objects...)
+	}
+	return result, diags
+}
+
+// objectLabel returns the label of the resource block of the given object which is derived from the name of the
+// object or the ID when the object has no name
+func objectLabel(resourceType ResourceType, obj *Object) string {
+	if nameAttribute := resourceType.NameAttribute(); nameAttribute != "" {
+		var fields map[string]tftypes.Value
+		if err := obj.Value.As(&fields); err == nil {
+			var name string
+			if value, ok := fields[nameAttribute]; ok && value.IsKnown() && !value.IsNull() && value.As(&name) == nil && name != "" {
+				return toIdentifier(name)
+			}
+		}
+	}
+	return toIdentifier(obj.ID)
+}
+
+// uniqueLabel returns the given label or the label with a numeric suffix when the label is already used
+func uniqueLabel(labels map[string]bool, label string) string {
+	candidate := label
+	for i := 2; labels[candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", label, i)
+	}
+	labels[candidate] = true
+	return candidate
+}
@@ -0,0 +1,259 @@
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testObject struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Secret     string   `json:"secret,omitempty"`
+	ChannelIDs []string `json:"channelIds,omitempty"`
+	Threshold  *float64 `json:"threshold,omitempty"`
+}
+
+func (o *testObject) GetIDForResourcePath() string {
+	return o.ID
+}
+
+type testObjectModel struct {
+	ID         types.String  `tfsdk:"id"`
+	Name       types.String  `tfsdk:"name"`
+	Secret     types.String  `tfsdk:"secret"`
+	ChannelIDs []string      `tfsdk:"channel_ids"`
+	Threshold  types.Float64 `tfsdk:"threshold"`
+	Created    types.String  `tfsdk:"created"`
+}
+
+type testRestResource struct {
+	rest.RestResource[*testObject]
+	objects []*testObject
+	err     error
+}
+
+func (r *testRestResource) GetAll() (*[]*testObject, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return &r.objects, nil
+}
+
+type testResourceHandle struct {
+	resourcehandle.ResourceHandle[*testObject]
+	resourceName        string
+	referenceAttributes map[string]string
+	restResource        *testRestResource
+}
+
+func (h *testResourceHandle) MetaData() *resourcehandle.ResourceMetaData {
+	return &resourcehandle.ResourceMetaData{
+		ResourceName:        h.resourceName,
+		ReferenceAttributes: h.referenceAttributes,
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"id":          schema.StringAttribute{Computed: true},
+				"name":        schema.StringAttribute{Required: true},
+				"secret":      schema.StringAttribute{Optional: true, Sensitive: true},
+				"channel_ids": schema.SetAttribute{Optional: true, ElementType: types.StringType},
+				"threshold":   schema.Float64Attribute{Optional: true},
+				"created":     schema.StringAttribute{Computed: true},
+			},
+		},
+	}
+}
+
+func (h *testResourceHandle) GetRestResource(_ client.InstanaAPI) rest.RestResource[*testObject] {
+	return h.restResource
+}
+
+func (h *testResourceHandle) UpdateState(ctx context.Context, state *tfsdk.State, _ *tfsdk.Plan, obj *testObject) diag.Diagnostics {
+	model := &testObjectModel{
+		ID:         types.StringValue(obj.ID),
+		Name:       types.StringValue(obj.Name),
+		Secret:     types.StringNull(),
+		ChannelIDs: obj.ChannelIDs,
+		Threshold:  types.Float64PointerValue(obj.Threshold),
+		Created:    types.StringValue("2024-01-01"),
+	}
+	if obj.Secret != "" {
+		model.Secret = types.StringValue(obj.Secret)
+	}
+	return state.Set(ctx, model)
+}
+
+func newTestResourceTypes() []ResourceType {
+	return []ResourceType{
+		NewResourceType[*testObject](&testResourceHandle{resourceName: "test_channel"}),
+		NewResourceType[*testObject](&testResourceHandle{
+			resourceName:        "test_config",
+			referenceAttributes: map[string]string{"channel_ids": "test_channel"},
+		}),
+	}
+}
+
+func newTestDump(t *testing.T, objects map[string][]*testObject) Dump {
+	dump := Dump{}
+	for name, list := range objects {
+		for _, obj := range list {
+			data, err := json.Marshal(obj)
+			require.NoError(t, err)
+			dump[name] = append(dump[name], data)
+		}
+	}
+	return dump
+}
+
+func TestExport(t *testing.T) {
+	threshold := 2.5
+	dump := newTestDump(t, map[string][]*testObject{
+		"test_channel": {
+			{ID: "channel-2", Name: "Ops Team", Secret: "s3cr3t"},
+			{ID: "channel-1", Name: "Dev Team"},
+			{ID: "channel-3", Name: "Dev-Team"},
+		},
+		"test_config": {
+			{ID: "config-1", Name: "1st config", ChannelIDs: []string{"channel-1", "unknown"}, Threshold: &threshold},
+		},
+	})
+
+	files, diags := New(newTestResourceTypes()).Export(context.Background(), dump)
+
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, `resource "instana_test_channel" "dev_team" {
+  name = "Dev Team"
+}
+
+resource "instana_test_channel" "dev_team_2" {
+  name = "Dev-Team"
+}
+
+resource "instana_test_channel" "ops_team" {
+  name   = "Ops Team"
+  secret = var.instana_test_channel_ops_team_secret
+}
+`, string(files["test_channel.tf"]))
+	assert.Equal(t, `resource "instana_test_config" "_1st_config" {
+  channel_ids = [instana_test_channel.dev_team.id, "unknown"]
+  name        = "1st config"
+  threshold   = 2.5
+}
+`, string(files["test_config.tf"]))
+	assert.Equal(t, `import {
+  to = instana_test_channel.dev_team
+  id = "channel-1"
+}
+
+import {
+  to = instana_test_channel.dev_team_2
+  id = "channel-3"
+}
+
+import {
+  to = instana_test_channel.ops_team
+  id = "channel-2"
+}
+
+import {
+  to = instana_test_config._1st_config
+  id = "config-1"
+}
+`, string(files[ImportsFileName]))
+	assert.Equal(t, `variable "instana_test_channel_ops_team_secret" {
+  description = "Sensitive value of secret of instana_test_channel.ops_team"
+  sensitive   = true
+}
+`, string(files[VariablesFileName]))
+}
+
+func TestExportShouldReferenceObjectsOfTheReferencedResourceTypeOnly(t *testing.T) {
+	dump := newTestDump(t, map[string][]*testObject{
+		"test_channel": {{ID: "shared-id", Name: "channel"}},
+		"test_config": {
+			{ID: "shared-id", Name: "config"},
+			{ID: "config-2", Name: "other", ChannelIDs: []string{"shared-id", "config-3"}},
+			{ID: "config-3", Name: "third"},
+		},
+	})
+
+	files, diags := New(newTestResourceTypes()).Export(context.Background(), dump)
+
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Contains(t, string(files["test_config.tf"]), `channel_ids = [instana_test_channel.channel.id, "config-3"]`)
+	assert.NotContains(t, files, VariablesFileName)
+}
+
+func TestExportShouldNotReferenceObjectsWithinAttributesWhichAreNoReferenceAttributes(t *testing.T) {
+	dump := newTestDump(t, map[string][]*testObject{
+		"test_channel": {
+			{ID: "channel-1", Name: "channel"},
+			{ID: "channel-2", Name: "other", ChannelIDs: []string{"channel-1"}},
+		},
+	})
+
+	files, diags := New(newTestResourceTypes()).Export(context.Background(), dump)
+
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Contains(t, string(files["test_channel.tf"]), `channel_ids = ["channel-1"]`)
+}
+
+func TestExportShouldWarnAboutUnknownResourceTypes(t *testing.T) {
+	dump := newTestDump(t, map[string][]*testObject{"test_unknown": {{ID: "id", Name: "name"}}})
+
+	files, diags := New(newTestResourceTypes()).Export(context.Background(), dump)
+
+	require.False(t, diags.HasError())
+	require.Len(t, diags.Warnings(), 1)
+	assert.Equal(t, "Unknown resource type", diags.Warnings()[0].Summary())
+	assert.Empty(t, files)
+}
+
+func TestExportShouldFailForInvalidObjects(t *testing.T) {
+	dump := Dump{"test_channel": {json.RawMessage(`"invalid"`)}}
+
+	_, diags := New(newTestResourceTypes()).Export(context.Background(), dump)
+
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Invalid Object", diags.Errors()[0].Summary())
+}
+
+func TestFetchDumpAndReadWrittenDump(t *testing.T) {
+	resourceTypes := []ResourceType{
+		NewResourceType[*testObject](&testResourceHandle{resourceName: "test_channel", restResource: &testRestResource{objects: []*testObject{{ID: "channel-1", Name: "Dev Team"}}}}),
+		NewResourceType[*testObject](&testResourceHandle{resourceName: "test_config", restResource: &testRestResource{err: errors.New("forbidden")}}),
+	}
+
+	dump, diags := FetchDump(resourceTypes, &testutils.MockInstanaAPI{})
+
+	require.False(t, diags.HasError())
+	require.Len(t, diags.Warnings(), 1)
+	assert.Contains(t, diags.Warnings()[0].Detail(), "forbidden")
+	require.Len(t, dump["test_channel"], 1)
+	assert.NotContains(t, dump, "test_config")
+
+	var buffer bytes.Buffer
+	require.NoError(t, dump.Write(&buffer))
+	readDump, err := ReadDump(&buffer)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(dump["test_channel"][0]), string(readDump["test_channel"][0]))
+}
+
+func TestToIdentifier(t *testing.T) {
+	assert.Equal(t, "my_alert_config", toIdentifier("My Alert-Config!"))
+	assert.Equal(t, "_123", toIdentifier("123"))
+	assert.Equal(t, "object", toIdentifier("!!!"))
+}
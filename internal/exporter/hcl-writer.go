@@ -0,0 +1,325 @@
+package exporter
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// reference the terraform address of an exported object which can be referenced by other objects
+type reference struct {
+	resourceType string
+	label        string
+	idAttribute  string
+}
+
+func (r reference) address() string {
+	return r.resourceType + "." + r.label
+}
+
+func (r reference) traversal() hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: r.resourceType},
+		hcl.TraverseAttr{Name: r.label},
+		hcl.TraverseAttr{Name: r.idAttribute},
+	}
+}
+
+// sensitiveVariable a variable which replaces a sensitive value in the generated configuration
+type sensitiveVariable struct {
+	name        string
+	description string
+}
+
+// objectWriter writes the attributes and blocks of a single object into a HCL body
+type objectWriter struct {
+	address             string
+	referenceAttributes map[string]string
+	references          map[string]map[string]reference
+	variables           []sensitiveVariable
+}
+
+// writeBody writes the configurable attributes and blocks of the given object value into the body. Computed only and
+// deprecated attributes as well as null values are omitted.
+func (w *objectWriter) writeBody(body *hclwrite.Body, attributes map[string]schema.Attribute, blocks map[string]schema.Block, value tftypes.Value, variablePath []string) error {
+	var fields map[string]tftypes.Value
+	if err := value.As(&fields); err != nil {
+		return err
+	}
+
+	for _, name := range sortedKeys(attributes) {
+		attribute := attributes[name]
+		fieldValue, ok := fields[name]
+		if !ok || !isConfigurable(attribute) || fieldValue.IsNull() || !fieldValue.IsKnown() {
+			continue
+		}
+		if attribute.IsSensitive() {
+			variable := w.addSensitiveVariable(childPath(variablePath, name))
+			body.SetAttributeTraversal(name, hcl.Traversal{hcl.TraverseRoot{Name: "var"}, hcl.TraverseAttr{Name: variable}})
+			continue
+		}
+		tokens, err := w.attributeTokens(name, attribute, fieldValue, childPath(variablePath, name))
+		if err != nil {
+			return fmt.Errorf("attribute %s: %w", name, err)
+		}
+		body.SetAttributeRaw(name, tokens)
+	}
+
+	for _, name := range sortedKeys(blocks) {
+		fieldValue, ok := fields[name]
+		if !ok || fieldValue.IsNull() || !fieldValue.IsKnown() || blocks[name].GetDeprecationMessage() != "" {
+			continue
+		}
+		if err := w.writeBlock(body, name, blocks[name], fieldValue, childPath(variablePath, name)); err != nil {
+			return fmt.Errorf("block %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (w *objectWriter) writeBlock(body *hclwrite.Body, name string, block schema.Block, value tftypes.Value, variablePath []string) error {
+	switch b := block.(type) {
+	case schema.SingleNestedBlock:
+		return w.writeBody(body.AppendNewBlock(name, nil).Body(), b.Attributes, b.Blocks, value, variablePath)
+	case schema.ListNestedBlock:
+		return w.writeNestedBlocks(body, name, b.NestedObject.Attributes, b.NestedObject.Blocks, value, variablePath)
+	case schema.SetNestedBlock:
+		return w.writeNestedBlocks(body, name, b.NestedObject.Attributes, b.NestedObject.Blocks, value, variablePath)
+	default:
+		return fmt.Errorf("unsupported block type %T", block)
+	}
+}
+
+func (w *objectWriter) writeNestedBlocks(body *hclwrite.Body, name string, attributes map[string]schema.Attribute, blocks map[string]schema.Block, value tftypes.Value, variablePath []string) error {
+	var elements []tftypes.Value
+	if err := value.As(&elements); err != nil {
+		return err
+	}
+	for i, element := range elements {
+		if err := w.writeBody(body.AppendNewBlock(name, nil).Body(), attributes, blocks, element, childPath(variablePath, fmt.Sprint(i))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attributeTokens returns the tokens of the value of the given attribute. Nested attributes are written with the
+// configurable attributes of their nested objects only.
+func (w *objectWriter) attributeTokens(name string, attribute schema.Attribute, value tftypes.Value, variablePath []string) (hclwrite.Tokens, error) {
+	switch a := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		return w.nestedObjectTokens(a.Attributes, value, variablePath)
+	case schema.ListNestedAttribute:
+		return w.nestedObjectListTokens(a.NestedObject.Attributes, value, variablePath)
+	case schema.SetNestedAttribute:
+		return w.nestedObjectListTokens(a.NestedObject.Attributes, value, variablePath)
+	case schema.MapNestedAttribute:
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		var attrs []hclwrite.ObjectAttrTokens
+		for _, key := range sortedKeys(elements) {
+			tokens, err := w.nestedObjectTokens(a.NestedObject.Attributes, elements[key], childPath(variablePath, key))
+			if err != nil {
+				return nil, err
+			}
+			attrs = append(attrs, hclwrite.ObjectAttrTokens{Name: hclwrite.TokensForValue(cty.StringVal(key)), Value: tokens})
+		}
+		return hclwrite.TokensForObject(attrs), nil
+	default:
+		return w.valueTokens(name, value)
+	}
+}
+
+func (w *objectWriter) nestedObjectListTokens(attributes map[string]schema.Attribute, value tftypes.Value, variablePath []string) (hclwrite.Tokens, error) {
+	var elements []tftypes.Value
+	if err := value.As(&elements); err != nil {
+		return nil, err
+	}
+	tuple := make([]hclwrite.Tokens, 0, len(elements))
+	for i, element := range elements {
+		tokens, err := w.nestedObjectTokens(attributes, element, childPath(variablePath, fmt.Sprint(i)))
+		if err != nil {
+			return nil, err
+		}
+		tuple = append(tuple, tokens)
+	}
+	return hclwrite.TokensForTuple(tuple), nil
+}
+
+func (w *objectWriter) nestedObjectTokens(attributes map[string]schema.Attribute, value tftypes.Value, variablePath []string) (hclwrite.Tokens, error) {
+	if value.IsNull() {
+		return hclwrite.TokensForValue(cty.NullVal(cty.DynamicPseudoType)), nil
+	}
+	var fields map[string]tftypes.Value
+	if err := value.As(&fields); err != nil {
+		return nil, err
+	}
+
+	var attrs []hclwrite.ObjectAttrTokens
+	for _, name := range sortedKeys(attributes) {
+		attribute := attributes[name]
+		fieldValue, ok := fields[name]
+		if !ok || !isConfigurable(attribute) || fieldValue.IsNull() || !fieldValue.IsKnown() {
+			continue
+		}
+		var tokens hclwrite.Tokens
+		if attribute.IsSensitive() {
+			variable := w.addSensitiveVariable(childPath(variablePath, name))
+			tokens = hclwrite.TokensForTraversal(hcl.Traversal{hcl.TraverseRoot{Name: "var"}, hcl.TraverseAttr{Name: variable}})
+		} else {
+			var err error
+			if tokens, err = w.attributeTokens(name, attribute, fieldValue, childPath(variablePath, name)); err != nil {
+				return nil, err
+			}
+		}
+		attrs = append(attrs, hclwrite.ObjectAttrTokens{Name: hclwrite.TokensForIdentifier(name), Value: tokens})
+	}
+	return hclwrite.TokensForObject(attrs), nil
+}
+
+// valueTokens returns the tokens of a plain value. Strings holding the ID of an exported object are written as
+// reference to the object when the attribute is a reference attribute of the resource type of the object.
+func (w *objectWriter) valueTokens(attributeName string, value tftypes.Value) (hclwrite.Tokens, error) {
+	if value.IsNull() || !value.IsKnown() {
+		return hclwrite.TokensForValue(cty.NullVal(cty.DynamicPseudoType)), nil
+	}
+
+	valueType := value.Type()
+	switch {
+	case valueType.Is(tftypes.String):
+		var s string
+		if err := value.As(&s); err != nil {
+			return nil, err
+		}
+		if ref, ok := w.reference(attributeName, s); ok {
+			return hclwrite.TokensForTraversal(ref.traversal()), nil
+		}
+		return hclwrite.TokensForValue(cty.StringVal(s)), nil
+	case valueType.Is(tftypes.Number):
+		var n big.Float
+		if err := value.As(&n); err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForValue(cty.NumberVal(&n)), nil
+	case valueType.Is(tftypes.Bool):
+		var b bool
+		if err := value.As(&b); err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForValue(cty.BoolVal(b)), nil
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Set{}), valueType.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		tuple := make([]hclwrite.Tokens, 0, len(elements))
+		for _, element := range elements {
+			tokens, err := w.valueTokens(attributeName, element)
+			if err != nil {
+				return nil, err
+			}
+			tuple = append(tuple, tokens)
+		}
+		return hclwrite.TokensForTuple(tuple), nil
+	case valueType.Is(tftypes.Map{}):
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		var attrs []hclwrite.ObjectAttrTokens
+		for _, key := range sortedKeys(elements) {
+			tokens, err := w.valueTokens(attributeName, elements[key])
+			if err != nil {
+				return nil, err
+			}
+			attrs = append(attrs, hclwrite.ObjectAttrTokens{Name: hclwrite.TokensForValue(cty.StringVal(key)), Value: tokens})
+		}
+		return hclwrite.TokensForObject(attrs), nil
+	case valueType.Is(tftypes.Object{}):
+		var fields map[string]tftypes.Value
+		if err := value.As(&fields); err != nil {
+			return nil, err
+		}
+		var attrs []hclwrite.ObjectAttrTokens
+		for _, key := range sortedKeys(fields) {
+			tokens, err := w.valueTokens(key, fields[key])
+			if err != nil {
+				return nil, err
+			}
+			attrs = append(attrs, hclwrite.ObjectAttrTokens{Name: hclwrite.TokensForIdentifier(key), Value: tokens})
+		}
+		return hclwrite.TokensForObject(attrs), nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %s", valueType)
+	}
+}
+
+func (w *objectWriter) addSensitiveVariable(variablePath []string) string {
+	name := toIdentifier(strings.ReplaceAll(w.address, ".", "_") + "_" + strings.Join(variablePath, "_"))
+	w.variables = append(w.variables, sensitiveVariable{
+		name:        name,
+		description: fmt.Sprintf("Sensitive value of %s of %s", strings.Join(variablePath, "."), w.address),
+	})
+	return name
+}
+
+// reference returns the reference to the exported object with the given ID when the attribute holds IDs of objects of
+// the resource type of the object
+func (w *objectWriter) reference(attributeName string, id string) (reference, bool) {
+	resourceType, ok := w.referenceAttributes[attributeName]
+	if !ok {
+		return reference{}, false
+	}
+	ref, ok := w.references[resourceType][id]
+	return ref, ok && ref.address() != w.address
+}
+
+// isConfigurable returns true when the attribute can be set in the configuration
+func isConfigurable(attribute schema.Attribute) bool {
+	return (attribute.IsRequired() || attribute.IsOptional()) && attribute.GetDeprecationMessage() == ""
+}
+
+// toIdentifier converts the given value to a valid terraform identifier in snake case
+func toIdentifier(value string) string {
+	var builder strings.Builder
+	lastUnderscore := true
+	for _, r := range strings.ToLower(value) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			builder.WriteRune(r)
+			lastUnderscore = false
+		} else if !lastUnderscore {
+			builder.WriteRune('_')
+			lastUnderscore = true
+		}
+	}
+	identifier := strings.TrimSuffix(builder.String(), "_")
+	if identifier == "" {
+		return "object"
+	}
+	if identifier[0] >= '0' && identifier[0] <= '9' {
+		return "_" + identifier
+	}
+	return identifier
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func childPath(parent []string, name string) []string {
+	return append(append(make([]string, 0, len(parent)+1), parent...), name)
+}
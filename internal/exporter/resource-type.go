@@ -0,0 +1,129 @@
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+)
+
+// objectNameAttributes the attributes which usually hold the name of an object, in order of precedence
+var objectNameAttributes = []string{"name", "title", "label"}
+
+// Object an exported object of a resource type with the terraform state value of the object
+type Object struct {
+	ID    string
+	Value tftypes.Value
+}
+
+// ResourceType provides the objects of a terraform resource type for the export
+type ResourceType interface {
+	// Name returns the name of the resource type without the provider prefix
+	Name() string
+
+	// Schema returns the schema of the resource type
+	Schema() schema.Schema
+
+	// IDAttribute returns the name of the attribute holding the ID of the objects
+	IDAttribute() string
+
+	// NameAttribute returns the name of the attribute holding the name of the objects or an empty string when the
+	// objects have no name
+	NameAttribute() string
+
+	// ReferenceAttributes returns the names of the referenced resource types without the provider prefix by the names
+	// of the attributes holding IDs of objects of these resource types
+	ReferenceAttributes() map[string]string
+
+	// Fetch reads all objects of the resource type from the Instana API and returns them as JSON
+	Fetch(api client.InstanaAPI) ([]json.RawMessage, error)
+
+	// Map maps the JSON of an object to the terraform state value of the object using the UpdateState function of
+	// the resource handle
+	Map(ctx context.Context, data json.RawMessage) (*Object, diag.Diagnostics)
+}
+
+// NewResourceType creates the ResourceType of the given resource handle
+func NewResourceType[T client.InstanaDataObject](handle resourcehandle.ResourceHandle[T]) ResourceType {
+	return &resourceTypeImpl[T]{handle: handle}
+}
+
+type resourceTypeImpl[T client.InstanaDataObject] struct {
+	handle resourcehandle.ResourceHandle[T]
+}
+
+// Name implementation of ResourceType interface
+func (r *resourceTypeImpl[T]) Name() string {
+	return r.handle.MetaData().ResourceName
+}
+
+// Schema implementation of ResourceType interface
+func (r *resourceTypeImpl[T]) Schema() schema.Schema {
+	return r.handle.MetaData().Schema
+}
+
+// IDAttribute implementation of ResourceType interface
+func (r *resourceTypeImpl[T]) IDAttribute() string {
+	return r.handle.MetaData().IDAttribute()
+}
+
+// NameAttribute implementation of ResourceType interface
+func (r *resourceTypeImpl[T]) NameAttribute() string {
+	metaData := r.handle.MetaData()
+	if metaData.ImportNameAttribute != "" {
+		return metaData.ImportNameAttribute
+	}
+	for _, attribute := range objectNameAttributes {
+		if _, ok := metaData.Schema.Attributes[attribute]; ok {
+			return attribute
+		}
+	}
+	return ""
+}
+
+// ReferenceAttributes implementation of ResourceType interface
+func (r *resourceTypeImpl[T]) ReferenceAttributes() map[string]string {
+	return r.handle.MetaData().ReferenceAttributes
+}
+
+// Fetch implementation of ResourceType interface
+func (r *resourceTypeImpl[T]) Fetch(api client.InstanaAPI) ([]json.RawMessage, error) {
+	objects, err := r.handle.GetRestResource(api).GetAll()
+	if err != nil {
+		return nil, err
+	}
+	if objects == nil {
+		return nil, nil
+	}
+
+	result := make([]json.RawMessage, 0, len(*objects))
+	for _, obj := range *objects {
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %s object %s: %w", r.Name(), obj.GetIDForResourcePath(), err)
+		}
+		result = append(result, data)
+	}
+	return result, nil
+}
+
+// Map implementation of ResourceType interface
+func (r *resourceTypeImpl[T]) Map(ctx context.Context, data json.RawMessage) (*Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var obj T
+	if err := json.Unmarshal(data, &obj); err != nil {
+		diags.AddError("Invalid Object", fmt.Sprintf("Failed to unmarshal %s object: %s", r.Name(), err))
+		return nil, diags
+	}
+
+	state, diags := resourcehandle.MapObjectToState(ctx, r.handle, obj)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &Object{ID: obj.GetIDForResourcePath(), Value: state.Raw}, diags
+}
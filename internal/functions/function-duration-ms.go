@@ -0,0 +1,53 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// NewDurationMsFunction creates the provider function to convert durations to milliseconds
+func NewDurationMsFunction() function.Function {
+	return &durationMsFunction{}
+}
+
+type durationMsFunction struct{}
+
+func (f *durationMsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = FunctionDurationMs
+}
+
+func (f *durationMsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     DurationMsSummary,
+		Description: DurationMsDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        ParameterDuration,
+				Description: ParameterDurationDescription,
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *durationMsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = req.Arguments.Get(ctx, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf(ErrInvalidDuration, value, err))
+		return
+	}
+	if duration < 0 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf(ErrNegativeDuration, value))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, duration.Milliseconds())
+}
@@ -0,0 +1,27 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDurationMsFunction(t *testing.T) {
+	f := NewDurationMsFunction()
+	assert.Equal(t, FunctionDurationMs, functionName(f))
+
+	for duration, expected := range map[string]int64{"5m": 300000, "1h30m": 5400000, "500ms": 500, "0s": 0} {
+		result, err := runFunction(t, f, types.StringValue(duration))
+		require.Nil(t, err, duration)
+		assert.Equal(t, types.Int64Value(expected), result, duration)
+	}
+}
+
+func TestDurationMsFunctionShouldFailForInvalidDurations(t *testing.T) {
+	for _, duration := range []string{"5 minutes", "", "-5m"} {
+		_, err := runFunction(t, NewDurationMsFunction(), types.StringValue(duration))
+		require.NotNil(t, err, duration)
+	}
+}
@@ -0,0 +1,49 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
+)
+
+// NewNormalizeTagFilterFunction creates the provider function to normalize tag filter expressions
+func NewNormalizeTagFilterFunction() function.Function {
+	return &normalizeTagFilterFunction{}
+}
+
+type normalizeTagFilterFunction struct{}
+
+func (f *normalizeTagFilterFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = FunctionNormalizeTagFilter
+}
+
+func (f *normalizeTagFilterFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     NormalizeTagFilterSummary,
+		Description: NormalizeTagFilterDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        ParameterExpression,
+				Description: ParameterExpressionDescription,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *normalizeTagFilterFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression string
+	resp.Error = req.Arguments.Get(ctx, &expression)
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := tagfilter.Normalize(expression)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf(ErrInvalidTagFilter, expression, err))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, normalized)
+}
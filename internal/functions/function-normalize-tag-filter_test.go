@@ -0,0 +1,26 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTagFilterFunction(t *testing.T) {
+	f := NewNormalizeTagFilterFunction()
+	assert.Equal(t, FunctionNormalizeTagFilter, functionName(f))
+
+	result, err := runFunction(t, f, types.StringValue("service.name equals 'my-service' and agent.tag:env@src equals 'prod'"))
+
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("(service.name@dest EQUALS 'my-service' AND agent.tag:'env'@src EQUALS 'prod')"), result)
+}
+
+func TestNormalizeTagFilterFunctionShouldFailForInvalidExpression(t *testing.T) {
+	_, err := runFunction(t, NewNormalizeTagFilterFunction(), types.StringValue("service.name equals"))
+
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "is not a valid tag filter expression")
+}
@@ -0,0 +1,90 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/instana/terraform-provider-instana/internal/util"
+)
+
+// NewSeverityFromAPIFunction creates the provider function to convert the numeric severity of the Instana API to its
+// name
+func NewSeverityFromAPIFunction() function.Function {
+	return &severityFromAPIFunction{}
+}
+
+type severityFromAPIFunction struct{}
+
+func (f *severityFromAPIFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = FunctionSeverityFromAPI
+}
+
+func (f *severityFromAPIFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     SeverityFromAPISummary,
+		Description: SeverityFromAPIDescription,
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:        ParameterSeverity,
+				Description: ParameterSeverityFromAPIDescription,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *severityFromAPIFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var severity int64
+	resp.Error = req.Arguments.Get(ctx, &severity)
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := util.ConvertSeverityFromInstanaAPIToTerraformRepresentation(int(severity))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+// NewSeverityToAPIFunction creates the provider function to convert the name of a severity to the numeric severity of
+// the Instana API
+func NewSeverityToAPIFunction() function.Function {
+	return &severityToAPIFunction{}
+}
+
+type severityToAPIFunction struct{}
+
+func (f *severityToAPIFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = FunctionSeverityToAPI
+}
+
+func (f *severityToAPIFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     SeverityToAPISummary,
+		Description: SeverityToAPIDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        ParameterSeverity,
+				Description: ParameterSeverityToAPIDescription,
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *severityToAPIFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var severity string
+	resp.Error = req.Arguments.Get(ctx, &severity)
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := util.ConvertSeverityFromTerraformToInstanaAPIRepresentation(severity)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, int64(result))
+}
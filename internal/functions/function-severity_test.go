@@ -0,0 +1,41 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeverityFromAPIFunction(t *testing.T) {
+	f := NewSeverityFromAPIFunction()
+	assert.Equal(t, FunctionSeverityFromAPI, functionName(f))
+
+	result, err := runFunction(t, f, types.Int64Value(5))
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("warning"), result)
+
+	result, err = runFunction(t, f, types.Int64Value(10))
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("critical"), result)
+
+	_, err = runFunction(t, f, types.Int64Value(7))
+	require.NotNil(t, err)
+}
+
+func TestSeverityToAPIFunction(t *testing.T) {
+	f := NewSeverityToAPIFunction()
+	assert.Equal(t, FunctionSeverityToAPI, functionName(f))
+
+	result, err := runFunction(t, f, types.StringValue("warning"))
+	require.Nil(t, err)
+	assert.Equal(t, types.Int64Value(5), result)
+
+	result, err = runFunction(t, f, types.StringValue("critical"))
+	require.Nil(t, err)
+	assert.Equal(t, types.Int64Value(10), result)
+
+	_, err = runFunction(t, f, types.StringValue("info"))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,75 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
+)
+
+// NewTagFilterComparisonFunction creates the provider function to create comparison tag filter expressions
+func NewTagFilterComparisonFunction() function.Function {
+	return &tagFilterComparisonFunction{}
+}
+
+type tagFilterComparisonFunction struct{}
+
+func (f *tagFilterComparisonFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = FunctionTagFilterComparison
+}
+
+func (f *tagFilterComparisonFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     TagFilterComparisonSummary,
+		Description: TagFilterComparisonDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        ParameterEntity,
+				Description: ParameterEntityDescription,
+			},
+			function.StringParameter{
+				Name:        ParameterOperator,
+				Description: ParameterOperatorDescription,
+			},
+			function.StringParameter{
+				Name:        ParameterValue,
+				Description: ParameterValueDescription,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *tagFilterComparisonFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var entity, operator, value string
+	resp.Error = req.Arguments.Get(ctx, &entity, &operator, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := newTagFilterComparison(entity, operator, value)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf(ErrInvalidTagFilterComparison, entity, operator, err))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+// newTagFilterComparison returns the normalized comparison expression of the given entity, operator and value. The
+// expression must consist of exactly one comparison, so that the entity or operator cannot inject further
+// expressions.
+func newTagFilterComparison(entity string, operator string, value string) (string, error) {
+	expression := fmt.Sprintf("%s %s %s", entity, strings.ToUpper(operator), tagfilter.QuoteStringValue(value))
+	parsed, err := tagfilter.NewParser().Parse(expression)
+	if err != nil {
+		return "", err
+	}
+	or := parsed.Expression
+	if or.Operator != nil || or.Left.Operator != nil || or.Left.Left.Primary == nil || or.Left.Left.Primary.Comparison == nil {
+		return "", errors.New(ErrNoSingleComparison)
+	}
+	return tagfilter.Normalize(expression)
+}
@@ -0,0 +1,40 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagFilterComparisonFunctionShouldEscapeValue(t *testing.T) {
+	f := NewTagFilterComparisonFunction()
+	assert.Equal(t, FunctionTagFilterComparison, functionName(f))
+
+	result, err := runFunction(t, f, types.StringValue("service.name"), types.StringValue("equals"), types.StringValue("it's OR true"))
+
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("service.name@dest EQUALS 'it\\'s OR true'"), result)
+}
+
+func TestTagFilterComparisonFunctionWithTagKeyAndOrigin(t *testing.T) {
+	result, err := runFunction(t, NewTagFilterComparisonFunction(), types.StringValue("agent.tag:env@src"), types.StringValue("NOT_EQUAL"), types.StringValue("prod"))
+
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("agent.tag:'env'@src NOT_EQUAL 'prod'"), result)
+}
+
+func TestTagFilterComparisonFunctionShouldFailForInvalidOperator(t *testing.T) {
+	_, err := runFunction(t, NewTagFilterComparisonFunction(), types.StringValue("service.name"), types.StringValue("LIKE"), types.StringValue("a"))
+
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "is not a valid tag filter expression")
+}
+
+func TestTagFilterComparisonFunctionShouldFailWhenEntityContainsFurtherExpressions(t *testing.T) {
+	_, err := runFunction(t, NewTagFilterComparisonFunction(), types.StringValue("endpoint.name EQUALS 'a' OR service.name"), types.StringValue("EQUALS"), types.StringValue("b"))
+
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), ErrNoSingleComparison)
+}
@@ -0,0 +1,80 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
+)
+
+// NewTagFilterAndFunction creates the provider function to combine tag filter expressions with AND
+func NewTagFilterAndFunction() function.Function {
+	return &tagFilterLogicalOperationFunction{name: FunctionTagFilterAnd, summary: TagFilterAndSummary, operator: TagFilterOperatorAnd}
+}
+
+// NewTagFilterOrFunction creates the provider function to combine tag filter expressions with OR
+func NewTagFilterOrFunction() function.Function {
+	return &tagFilterLogicalOperationFunction{name: FunctionTagFilterOr, summary: TagFilterOrSummary, operator: TagFilterOperatorOr}
+}
+
+type tagFilterLogicalOperationFunction struct {
+	name     string
+	summary  string
+	operator string
+}
+
+func (f *tagFilterLogicalOperationFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f *tagFilterLogicalOperationFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     f.summary,
+		Description: fmt.Sprintf(TagFilterLogicalOperationDescription, f.operator),
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        ParameterExpressions,
+				Description: ParameterExpressionsDescription,
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *tagFilterLogicalOperationFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expressions []string
+	resp.Error = req.Arguments.Get(ctx, &expressions)
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := combineTagFilters(expressions, f.operator)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+// combineTagFilters combines the given non-empty tag filter expressions with the given logical operator and returns the
+// normalized expression
+func combineTagFilters(expressions []string, operator string) (string, error) {
+	var operands []string
+	for i, expression := range expressions {
+		if strings.TrimSpace(expression) == "" {
+			continue
+		}
+		if _, err := tagfilter.Normalize(expression); err != nil {
+			return "", fmt.Errorf(ErrInvalidTagFilterElement, i, expression, err)
+		}
+		operands = append(operands, "("+expression+")")
+	}
+	if len(operands) == 0 {
+		return "", nil
+	}
+	return tagfilter.Normalize(strings.Join(operands, " "+operator+" "))
+}
@@ -0,0 +1,52 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newExpressionsList(expressions ...string) types.List {
+	elements := make([]attr.Value, len(expressions))
+	for i, expression := range expressions {
+		elements[i] = types.StringValue(expression)
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func TestTagFilterAndFunctionShouldKeepPrecedenceOfExpressions(t *testing.T) {
+	f := NewTagFilterAndFunction()
+	assert.Equal(t, FunctionTagFilterAnd, functionName(f))
+
+	result, err := runFunction(t, f, newExpressionsList("service.name EQUALS 'a' OR service.name EQUALS 'b'", "", "agent.tag:env EQUALS 'prod'"))
+
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("((service.name@dest EQUALS 'a' OR service.name@dest EQUALS 'b') AND agent.tag:'env'@dest EQUALS 'prod')"), result)
+}
+
+func TestTagFilterOrFunction(t *testing.T) {
+	f := NewTagFilterOrFunction()
+	assert.Equal(t, FunctionTagFilterOr, functionName(f))
+
+	result, err := runFunction(t, f, newExpressionsList("service.name EQUALS 'a' AND endpoint.name EQUALS 'b'", "service.name EQUALS 'c'"))
+
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("((service.name@dest EQUALS 'a' AND endpoint.name@dest EQUALS 'b') OR service.name@dest EQUALS 'c')"), result)
+}
+
+func TestTagFilterLogicalOperationFunctionShouldReturnEmptyStringWhenAllExpressionsAreEmpty(t *testing.T) {
+	result, err := runFunction(t, NewTagFilterAndFunction(), newExpressionsList("", " "))
+
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue(""), result)
+}
+
+func TestTagFilterLogicalOperationFunctionShouldFailForInvalidExpression(t *testing.T) {
+	_, err := runFunction(t, NewTagFilterOrFunction(), newExpressionsList("service.name EQUALS 'a'", "service.name EQUALS 'b') OR (true"))
+
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "element 1")
+}
@@ -0,0 +1,31 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/stretchr/testify/require"
+)
+
+// runFunction validates the definition of the given function and runs the function with the given arguments
+func runFunction(t *testing.T, f function.Function, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	ctx := context.Background()
+	definitionResp := &function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, definitionResp)
+	validateResp := &function.DefinitionValidateResponse{}
+	definitionResp.Definition.ValidateImplementation(ctx, function.DefinitionValidateRequest{}, validateResp)
+	require.False(t, validateResp.Diagnostics.HasError(), "%v", validateResp.Diagnostics)
+
+	resp := &function.RunResponse{Result: function.NewResultData(definitionResp.Definition.Return.GetType().ValueType(ctx))}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, resp)
+	return resp.Result.Value(), resp.Error
+}
+
+// functionName returns the name of the given function
+func functionName(f function.Function) string {
+	resp := &function.MetadataResponse{}
+	f.Metadata(context.Background(), function.MetadataRequest{}, resp)
+	return resp.Name
+}
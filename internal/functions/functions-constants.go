@@ -0,0 +1,114 @@
+package functions
+
+// Function name constants
+const (
+	// FunctionNormalizeTagFilter the name of the provider function to normalize tag filter expressions
+	FunctionNormalizeTagFilter = "normalize_tag_filter"
+	// FunctionTagFilterAnd the name of the provider function to combine tag filter expressions with AND
+	FunctionTagFilterAnd = "tag_filter_and"
+	// FunctionTagFilterOr the name of the provider function to combine tag filter expressions with OR
+	FunctionTagFilterOr = "tag_filter_or"
+	// FunctionTagFilterComparison the name of the provider function to create a comparison tag filter expression
+	FunctionTagFilterComparison = "tag_filter_comparison"
+	// FunctionSeverityFromAPI the name of the provider function to convert the severity of the Instana API
+	FunctionSeverityFromAPI = "severity_from_api"
+	// FunctionSeverityToAPI the name of the provider function to convert the severity to the Instana API representation
+	FunctionSeverityToAPI = "severity_to_api"
+	// FunctionDurationMs the name of the provider function to convert durations to milliseconds
+	FunctionDurationMs = "duration_ms"
+)
+
+// Operator constants of the tag filter functions
+const (
+	// TagFilterOperatorAnd the logical AND operator of tag filter expressions
+	TagFilterOperatorAnd = "AND"
+	// TagFilterOperatorOr the logical OR operator of tag filter expressions
+	TagFilterOperatorOr = "OR"
+)
+
+// Parameter name constants
+const (
+	// ParameterExpression the name of the tag filter expression parameter
+	ParameterExpression = "expression"
+	// ParameterExpressions the name of the tag filter expressions parameter
+	ParameterExpressions = "expressions"
+	// ParameterEntity the name of the entity parameter
+	ParameterEntity = "entity"
+	// ParameterOperator the name of the operator parameter
+	ParameterOperator = "operator"
+	// ParameterValue the name of the value parameter
+	ParameterValue = "value"
+	// ParameterSeverity the name of the severity parameter
+	ParameterSeverity = "severity"
+	// ParameterDuration the name of the duration parameter
+	ParameterDuration = "duration"
+)
+
+// Description constants
+const (
+	// NormalizeTagFilterSummary summary of the normalize_tag_filter function
+	NormalizeTagFilterSummary = "Normalizes a tag filter expression"
+	// NormalizeTagFilterDescription description of the normalize_tag_filter function
+	NormalizeTagFilterDescription = "Returns the normalized representation of the given tag filter expression as it is stored in the state " +
+		"of the resources, e.g. with explicit entity origins and upper case operators. Fails when the expression is not valid."
+	// TagFilterAndSummary summary of the tag_filter_and function
+	TagFilterAndSummary = "Combines tag filter expressions with AND"
+	// TagFilterOrSummary summary of the tag_filter_or function
+	TagFilterOrSummary = "Combines tag filter expressions with OR"
+	// TagFilterLogicalOperationDescription description of the tag_filter_and and tag_filter_or functions
+	TagFilterLogicalOperationDescription = "Returns the normalized tag filter expression which combines the given expressions with the logical %s operator. " +
+		"Each expression is put in brackets, so that the precedence of the operators of the given expressions is kept. Empty expressions " +
+		"are ignored and an empty string is returned when all expressions are empty. Fails when one of the expressions is not valid."
+	// TagFilterComparisonSummary summary of the tag_filter_comparison function
+	TagFilterComparisonSummary = "Creates a tag filter comparison expression"
+	// TagFilterComparisonDescription description of the tag_filter_comparison function
+	TagFilterComparisonDescription = "Returns the normalized tag filter expression which compares the given entity (e.g. service.name or " +
+		"agent.tag:env) with the given value using the given operator (e.g. EQUALS or CONTAINS). The value is quoted and escaped, so that " +
+		"values containing quotes, backslashes or keywords do not break the expression."
+	// SeverityFromAPISummary summary of the severity_from_api function
+	SeverityFromAPISummary = "Converts the severity of the Instana API to its name"
+	// SeverityFromAPIDescription description of the severity_from_api function
+	SeverityFromAPIDescription = "Returns the name of the severity (warning or critical) for the numeric severity of the Instana API (5 or 10)."
+	// SeverityToAPISummary summary of the severity_to_api function
+	SeverityToAPISummary = "Converts the name of a severity to the Instana API representation"
+	// SeverityToAPIDescription description of the severity_to_api function
+	SeverityToAPIDescription = "Returns the numeric severity of the Instana API (5 or 10) for the name of the severity (warning or critical)."
+	// DurationMsSummary summary of the duration_ms function
+	DurationMsSummary = "Converts a duration to milliseconds"
+	// DurationMsDescription description of the duration_ms function
+	DurationMsDescription = "Returns the number of milliseconds of the given duration. The duration is a sequence of decimal numbers with " +
+		"unit suffix (ms, s, m or h), e.g. 5m, 1h30m or 500ms. Fails for negative durations."
+
+	// ParameterExpressionDescription description of the expression parameter
+	ParameterExpressionDescription = "The tag filter expression"
+	// ParameterExpressionsDescription description of the expressions parameter
+	ParameterExpressionsDescription = "The tag filter expressions to combine"
+	// ParameterEntityDescription description of the entity parameter
+	ParameterEntityDescription = "The entity of the comparison, optionally with tag key and entity origin, e.g. agent.tag:env@src"
+	// ParameterOperatorDescription description of the operator parameter
+	ParameterOperatorDescription = "The comparison operator, e.g. EQUALS, NOT_EQUAL, CONTAINS or STARTS_WITH"
+	// ParameterValueDescription description of the value parameter
+	ParameterValueDescription = "The value to compare the entity with"
+	// ParameterSeverityFromAPIDescription description of the severity parameter of the severity_from_api function
+	ParameterSeverityFromAPIDescription = "The numeric severity of the Instana API"
+	// ParameterSeverityToAPIDescription description of the severity parameter of the severity_to_api function
+	ParameterSeverityToAPIDescription = "The name of the severity"
+	// ParameterDurationDescription description of the duration parameter
+	ParameterDurationDescription = "The duration, e.g. 5m"
+)
+
+// Error message constants
+const (
+	// ErrInvalidTagFilter error message for invalid tag filter expressions
+	ErrInvalidTagFilter = "%q is not a valid tag filter expression: %s"
+	// ErrInvalidTagFilterElement error message for invalid elements of a list of tag filter expressions
+	ErrInvalidTagFilterElement = "element %d (%q) is not a valid tag filter expression: %s"
+	// ErrInvalidTagFilterComparison error message for invalid comparisons
+	ErrInvalidTagFilterComparison = "the comparison of %q with operator %q is not a valid tag filter expression: %s"
+	// ErrNoSingleComparison error message when the entity or operator of a comparison result in more than one comparison
+	ErrNoSingleComparison = "the expression must consist of a single comparison"
+	// ErrInvalidDuration error message for invalid durations
+	ErrInvalidDuration = "%q is not a valid duration: %s"
+	// ErrNegativeDuration error message for negative durations
+	ErrNegativeDuration = "%q is a negative duration"
+)
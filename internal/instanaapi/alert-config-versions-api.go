@@ -0,0 +1,221 @@
+package instanaapi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/shared/rest"
+)
+
+// AlertConfigType the type of a smart alert configuration. The smart alert configurations of all types expose the
+// same version history endpoints below different base paths.
+type AlertConfigType string
+
+const (
+	// AlertConfigTypeApplication constant value for application alert configurations
+	AlertConfigTypeApplication = AlertConfigType("application")
+	// AlertConfigTypeGlobalApplication constant value for global application alert configurations
+	AlertConfigTypeGlobalApplication = AlertConfigType("global_application")
+	// AlertConfigTypeWebsite constant value for website alert configurations
+	AlertConfigTypeWebsite = AlertConfigType("website")
+	// AlertConfigTypeMobileApp constant value for mobile app alert configurations
+	AlertConfigTypeMobileApp = AlertConfigType("mobile_app")
+	// AlertConfigTypeInfra constant value for infrastructure alert configurations
+	AlertConfigTypeInfra = AlertConfigType("infra")
+	// AlertConfigTypeSynthetic constant value for synthetic alert configurations
+	AlertConfigTypeSynthetic = AlertConfigType("synthetic")
+)
+
+var alertConfigResourcePaths = map[AlertConfigType]string{
+	AlertConfigTypeApplication:       ApplicationAlertConfigsResourcePath,
+	AlertConfigTypeGlobalApplication: GlobalApplicationAlertConfigsResourcePath,
+	AlertConfigTypeWebsite:           WebsiteAlertConfigsResourcePath,
+	AlertConfigTypeMobileApp:         MobileAppAlertConfigsResourcePath,
+	AlertConfigTypeInfra:             InfraAlertConfigsResourcePath,
+	AlertConfigTypeSynthetic:         SyntheticAlertConfigsResourcePath,
+}
+
+// SupportedAlertConfigTypes list of all supported smart alert configuration types
+var SupportedAlertConfigTypes = []string{
+	string(AlertConfigTypeApplication),
+	string(AlertConfigTypeGlobalApplication),
+	string(AlertConfigTypeWebsite),
+	string(AlertConfigTypeMobileApp),
+	string(AlertConfigTypeInfra),
+	string(AlertConfigTypeSynthetic),
+}
+
+// ResourcePath returns the base path of the smart alert configurations of the alert config type
+func (t AlertConfigType) ResourcePath() (string, error) {
+	resourcePath, ok := alertConfigResourcePaths[t]
+	if !ok {
+		return "", fmt.Errorf("unsupported alert config type %s; supported types are %s", t, strings.Join(SupportedAlertConfigTypes, ", "))
+	}
+	return resourcePath, nil
+}
+
+// ConfigVersionAuthor the author of a version of a configuration
+type ConfigVersionAuthor struct {
+	ID   *string `json:"id"`
+	Type *string `json:"type"`
+}
+
+// ConfigVersionChangeSummary the summary of the change which created a version of a configuration
+type ConfigVersionChangeSummary struct {
+	Author     *ConfigVersionAuthor `json:"author"`
+	ChangeType string               `json:"changeType"`
+}
+
+// ConfigVersion a single version of a configuration. Versions are identified by their creation timestamp.
+type ConfigVersion struct {
+	ID            string                      `json:"id"`
+	Created       int64                       `json:"created"`
+	Deleted       bool                        `json:"deleted"`
+	Enabled       *bool                       `json:"enabled"`
+	ChangeSummary *ConfigVersionChangeSummary `json:"changeSummary"`
+}
+
+// AlertConfigVersionHistory provides access to the version history of smart alert configurations via the endpoints
+// <resource path>/<id>/versions and <resource path>/<id>/restore/<created>.
+type AlertConfigVersionHistory interface {
+	// GetVersions returns all versions of the alert configuration with the given ID sorted descending by their
+	// creation timestamp, including the versions of deleted configurations
+	GetVersions(id string) ([]ConfigVersion, error)
+	// Restore restores the version of the alert configuration with the given ID which was created at the given
+	// timestamp. Restoring creates a new version.
+	Restore(id string, created int64) error
+}
+
+// NewAlertConfigVersionHistory creates a new AlertConfigVersionHistory for the smart alert configurations of the
+// given resource path
+func NewAlertConfigVersionHistory(resourcePath string, restClient RestClient) AlertConfigVersionHistory {
+	return &alertConfigVersionHistoryImpl{
+		resourcePath: resourcePath,
+		client:       restClient,
+	}
+}
+
+type alertConfigVersionHistoryImpl struct {
+	resourcePath string
+	client       RestClient
+}
+
+func (h *alertConfigVersionHistoryImpl) GetVersions(id string) ([]ConfigVersion, error) {
+	data, err := h.client.Get(h.resourcePath + "/" + id + "/versions")
+	if err != nil {
+		return nil, err
+	}
+	versions, err := unmarshalList[ConfigVersion](data)
+	if err != nil {
+		return nil, err
+	}
+	return *versions, nil
+}
+
+func (h *alertConfigVersionHistoryImpl) Restore(id string, created int64) error {
+	_, err := h.client.Put(fmt.Sprintf("%s/%s/restore/%d", h.resourcePath, id, created), nil)
+	return err
+}
+
+// AlertConfigVersionPin pins a smart alert configuration to a historic version. Version is the creation timestamp
+// of the restored version and CurrentVersion the creation timestamp of the latest version of the configuration.
+type AlertConfigVersionPin struct {
+	AlertConfigType AlertConfigType
+	AlertConfigID   string
+	Version         int64
+	CurrentVersion  int64
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. Version pins are identified by the alert
+// config type and the ID of the alert configuration in the format <alert config type>/<alert config id>.
+func (p *AlertConfigVersionPin) GetIDForResourcePath() string {
+	return string(p.AlertConfigType) + "/" + p.AlertConfigID
+}
+
+// ParseAlertConfigVersionPinID parses the ID of an AlertConfigVersionPin in the format
+// <alert config type>/<alert config id>
+func ParseAlertConfigVersionPinID(id string) (AlertConfigType, string, error) {
+	alertConfigType, alertConfigID, found := strings.Cut(id, "/")
+	if !found || alertConfigID == "" {
+		return "", "", fmt.Errorf("invalid alert config version pin id %s; expected <alert config type>/<alert config id>", id)
+	}
+	if _, err := AlertConfigType(alertConfigType).ResourcePath(); err != nil {
+		return "", "", err
+	}
+	return AlertConfigType(alertConfigType), alertConfigID, nil
+}
+
+// NewAlertConfigVersionPinRestResource creates the REST resource for version pins of smart alert configurations.
+// Creating or updating a pin restores the pinned version, reading a pin returns the latest version of the alert
+// configuration and deleting a pin leaves the alert configuration unchanged.
+func NewAlertConfigVersionPinRestResource(restClient RestClient) rest.RestResource[*AlertConfigVersionPin] {
+	return &alertConfigVersionPinRestResource{client: restClient}
+}
+
+type alertConfigVersionPinRestResource struct {
+	client RestClient
+}
+
+func (r *alertConfigVersionPinRestResource) GetAll() (*[]*AlertConfigVersionPin, error) {
+	return nil, fmt.Errorf("listing alert config version pins is not supported by the Instana API")
+}
+
+func (r *alertConfigVersionPinRestResource) GetOne(id string) (*AlertConfigVersionPin, error) {
+	alertConfigType, alertConfigID, err := ParseAlertConfigVersionPinID(id)
+	if err != nil {
+		return nil, err
+	}
+	history, err := r.history(alertConfigType)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := history.GetVersions(alertConfigID)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 || versions[0].Deleted {
+		return nil, fmt.Errorf("%w: %s alert config with id %s", client.ErrEntityNotFound, alertConfigType, alertConfigID)
+	}
+	return &AlertConfigVersionPin{
+		AlertConfigType: alertConfigType,
+		AlertConfigID:   alertConfigID,
+		CurrentVersion:  versions[0].Created,
+	}, nil
+}
+
+func (r *alertConfigVersionPinRestResource) Create(data *AlertConfigVersionPin) (*AlertConfigVersionPin, error) {
+	return r.Update(data)
+}
+
+func (r *alertConfigVersionPinRestResource) Update(data *AlertConfigVersionPin) (*AlertConfigVersionPin, error) {
+	history, err := r.history(data.AlertConfigType)
+	if err != nil {
+		return nil, err
+	}
+	if err := history.Restore(data.AlertConfigID, data.Version); err != nil {
+		return nil, err
+	}
+	pin, err := r.GetOne(data.GetIDForResourcePath())
+	if err != nil {
+		return nil, err
+	}
+	pin.Version = data.Version
+	return pin, nil
+}
+
+func (r *alertConfigVersionPinRestResource) Delete(data *AlertConfigVersionPin) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+func (r *alertConfigVersionPinRestResource) DeleteByID(_ string) error {
+	return nil
+}
+
+func (r *alertConfigVersionPinRestResource) history(alertConfigType AlertConfigType) (AlertConfigVersionHistory, error) {
+	resourcePath, err := alertConfigType.ResourcePath()
+	if err != nil {
+		return nil, err
+	}
+	return NewAlertConfigVersionHistory(resourcePath, r.client), nil
+}
@@ -0,0 +1,95 @@
+package instanaapi_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	websiteAlertConfigVersionsPath = instanaapi.WebsiteAlertConfigsResourcePath + "/alert-id/versions"
+	websiteAlertConfigRestorePath  = instanaapi.WebsiteAlertConfigsResourcePath + "/alert-id/restore/1000"
+	websiteAlertConfigVersionsJSON = `[
+		{"id":"alert-id","created":2000,"deleted":false,"enabled":true,"changeSummary":{"author":{"id":"user-id","type":"USER"},"changeType":"UPDATE"}},
+		{"id":"alert-id","created":1000,"deleted":false,"changeSummary":{"author":{"type":"API"},"changeType":"CREATE"}}
+	]`
+)
+
+func TestShouldReadVersionsOfAlertConfig(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodGet, websiteAlertConfigVersionsPath, func(w http.ResponseWriter, _ *http.Request) {
+		server.WriteJSONResponse(w, []byte(websiteAlertConfigVersionsJSON))
+	})
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewAlertConfigVersionHistory(instanaapi.WebsiteAlertConfigsResourcePath, instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	versions, err := sut.GetVersions("alert-id")
+
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, int64(2000), versions[0].Created)
+	assert.True(t, *versions[0].Enabled)
+	assert.Equal(t, "UPDATE", versions[0].ChangeSummary.ChangeType)
+	assert.Equal(t, "user-id", *versions[0].ChangeSummary.Author.ID)
+	assert.Nil(t, versions[1].Enabled)
+	assert.Nil(t, versions[1].ChangeSummary.Author.ID)
+}
+
+func TestShouldRestoreVersionOfAlertConfigWhenPinIsCreated(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	versions := websiteAlertConfigVersionsJSON
+	server.AddRoute(http.MethodPut, websiteAlertConfigRestorePath, func(w http.ResponseWriter, _ *http.Request) {
+		versions = `[{"id":"alert-id","created":3000,"changeSummary":{"changeType":"RESTORE"}}]`
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server.AddRoute(http.MethodGet, websiteAlertConfigVersionsPath, func(w http.ResponseWriter, _ *http.Request) {
+		server.WriteJSONResponse(w, []byte(versions))
+	})
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewAlertConfigVersionPinRestResource(instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	result, err := sut.Create(&instanaapi.AlertConfigVersionPin{AlertConfigType: instanaapi.AlertConfigTypeWebsite, AlertConfigID: "alert-id", Version: 1000})
+
+	require.NoError(t, err)
+	assert.Equal(t, &instanaapi.AlertConfigVersionPin{AlertConfigType: instanaapi.AlertConfigTypeWebsite, AlertConfigID: "alert-id", Version: 1000, CurrentVersion: 3000}, result)
+	assert.Equal(t, 1, server.GetCallCount(http.MethodPut, websiteAlertConfigRestorePath))
+	assert.Equal(t, "website/alert-id", result.GetIDForResourcePath())
+}
+
+func TestShouldReturnErrEntityNotFoundForPinOfDeletedAlertConfig(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodGet, websiteAlertConfigVersionsPath, func(w http.ResponseWriter, _ *http.Request) {
+		server.WriteJSONResponse(w, []byte(`[{"id":"alert-id","created":3000,"deleted":true}]`))
+	})
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewAlertConfigVersionPinRestResource(instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	_, err := sut.GetOne("website/alert-id")
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, client.ErrEntityNotFound))
+}
+
+func TestShouldParseAlertConfigVersionPinID(t *testing.T) {
+	alertConfigType, alertConfigID, err := instanaapi.ParseAlertConfigVersionPinID("global_application/alert-id")
+	require.NoError(t, err)
+	assert.Equal(t, instanaapi.AlertConfigTypeGlobalApplication, alertConfigType)
+	assert.Equal(t, "alert-id", alertConfigID)
+
+	for _, id := range []string{"alert-id", "website/", "unknown/alert-id"} {
+		_, _, err = instanaapi.ParseAlertConfigVersionPinID(id)
+		assert.Error(t, err, id)
+	}
+}
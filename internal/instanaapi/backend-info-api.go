@@ -0,0 +1,77 @@
+package instanaapi
+
+import (
+	"net/url"
+	"strings"
+)
+
+const (
+	// InstanaVersionResourcePath path to the version information of the Instana backend
+	InstanaVersionResourcePath = "/api/instana/version"
+)
+
+// InstanaVersionInfo is the representation of the version information of the Instana backend
+type InstanaVersionInfo struct {
+	Branch   string `json:"branch"`
+	Commit   string `json:"commit"`
+	ImageTag string `json:"imageTag"`
+}
+
+// BackendInfo describes the Instana backend the provider is connected to. Tenant and Unit are empty when they cannot
+// be determined from the host name of the endpoint.
+type BackendInfo struct {
+	Endpoint string
+	Tenant   string
+	Unit     string
+	Version  InstanaVersionInfo
+}
+
+// NewBackendInfoReader creates a new BackendInfoReader for the given base URL of the Instana API
+func NewBackendInfoReader(baseURL string, restClient RestClient) BackendInfoReader {
+	return &backendInfoReaderImpl{baseURL: baseURL, client: restClient}
+}
+
+// BackendInfoReader reads the information about the Instana backend
+type BackendInfoReader interface {
+	// Read reads the version information of the backend with the configured API token. The call fails when the
+	// token is not valid for the tenant unit of the endpoint.
+	Read() (*BackendInfo, error)
+}
+
+type backendInfoReaderImpl struct {
+	baseURL string
+	client  RestClient
+}
+
+// Read implementation of BackendInfoReader interface
+func (r *backendInfoReaderImpl) Read() (*BackendInfo, error) {
+	data, err := r.client.Get(InstanaVersionResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	version, err := unmarshalObject[*InstanaVersionInfo](data)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &BackendInfo{Endpoint: r.baseURL, Version: *version}
+	if baseURL, err := url.Parse(r.baseURL); err == nil {
+		info.Tenant, info.Unit = ParseTenantUnit(baseURL.Hostname())
+	}
+	return info, nil
+}
+
+// ParseTenantUnit determines the tenant and unit from a host name following the Instana naming scheme
+// <unit>-<tenant>.<domain> (e.g. prod-acme.instana.io). Empty values are returned for other host names like
+// regional SaaS endpoints or IP addresses.
+func ParseTenantUnit(host string) (tenant string, unit string) {
+	label, domain, found := strings.Cut(strings.ToLower(host), ".")
+	if !found || domain == "" || strings.Count(label, "-") != 1 {
+		return "", ""
+	}
+	unit, tenant, _ = strings.Cut(label, "-")
+	if unit == "" || tenant == "" {
+		return "", ""
+	}
+	return tenant, unit
+}
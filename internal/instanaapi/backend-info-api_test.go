@@ -0,0 +1,70 @@
+package instanaapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldReadBackendInfo(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodGet, instanaapi.InstanaVersionResourcePath, func(w http.ResponseWriter, _ *http.Request) {
+		server.WriteJSONResponse(w, []byte(`{"branch":"release-301","commit":"abc123","imageTag":"3.301.123-0"}`))
+	})
+	server.Start()
+	defer server.Close()
+
+	clientConfig := newTestClientConfig(server)
+	sut := instanaapi.NewInstanaAPI(nil, clientConfig)
+
+	result, err := sut.BackendInfo().Read()
+
+	require.NoError(t, err)
+	assert.Equal(t, &instanaapi.BackendInfo{
+		Endpoint: clientConfig.BaseURL,
+		Version:  instanaapi.InstanaVersionInfo{Branch: "release-301", Commit: "abc123", ImageTag: "3.301.123-0"},
+	}, result)
+}
+
+func TestShouldFailToReadBackendInfoWhenVersionCannotBeRead(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodGet, instanaapi.InstanaVersionResourcePath, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	server.Start()
+	defer server.Close()
+
+	_, err := instanaapi.NewInstanaAPI(nil, newTestClientConfig(server)).BackendInfo().Read()
+
+	require.Error(t, err)
+}
+
+func TestParseTenantUnit(t *testing.T) {
+	tests := []struct {
+		host   string
+		tenant string
+		unit   string
+	}{
+		{host: "prod-acme.instana.io", tenant: "acme", unit: "prod"},
+		{host: "Test-ACME.instana.example.com", tenant: "acme", unit: "test"},
+		{host: "saas-eu-west-1.instana.io", tenant: "", unit: ""},
+		{host: "instana.example.com", tenant: "", unit: ""},
+		{host: "-acme.instana.io", tenant: "", unit: ""},
+		{host: "prod-acme", tenant: "", unit: ""},
+		{host: "127.0.0.1", tenant: "", unit: ""},
+		{host: "localhost", tenant: "", unit: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			tenant, unit := instanaapi.ParseTenantUnit(tt.host)
+
+			assert.Equal(t, tt.tenant, tenant)
+			assert.Equal(t, tt.unit, unit)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package instanaapi
+
+// BaselineUpdater recalculates the historic baselines of smart alert configurations via the dedicated endpoint
+// <resource path>/<id>/update-baseline. The endpoint is available for application, website and mobile app alert
+// configurations.
+type BaselineUpdater interface {
+	// UpdateBaseline recalculates the baseline of the alert configuration with the given ID
+	UpdateBaseline(id string) error
+}
+
+// NewBaselineUpdater creates a new BaselineUpdater for the smart alert configurations of the given resource path
+func NewBaselineUpdater(resourcePath string, restClient RestClient) BaselineUpdater {
+	return &baselineUpdaterImpl{
+		resourcePath: resourcePath,
+		client:       restClient,
+	}
+}
+
+type baselineUpdaterImpl struct {
+	resourcePath string
+	client       RestClient
+}
+
+func (u *baselineUpdaterImpl) UpdateBaseline(id string) error {
+	_, err := u.client.Post(u.resourcePath+"/"+id+"/update-baseline", nil)
+	return err
+}
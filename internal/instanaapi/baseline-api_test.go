@@ -0,0 +1,27 @@
+package instanaapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldUpdateBaselineViaDedicatedEndpoint(t *testing.T) {
+	updateBaselinePath := instanaapi.MobileAppAlertConfigsResourcePath + "/alert-id/update-baseline"
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodPost, updateBaselinePath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Content-Type"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewBaselineUpdater(instanaapi.MobileAppAlertConfigsResourcePath, instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	require.NoError(t, sut.UpdateBaseline("alert-id"))
+	assert.Equal(t, 1, server.GetCallCount(http.MethodPost, updateBaselinePath))
+}
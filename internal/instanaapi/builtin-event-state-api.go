@@ -0,0 +1,122 @@
+package instanaapi
+
+import (
+	"fmt"
+
+	"github.com/instana/instana-go-client/shared/rest"
+)
+
+// BuiltinEventSpecificationsResourcePath path to the built-in event specifications of the Instana API
+const BuiltinEventSpecificationsResourcePath = "/api/events/settings/event-specifications/built-in"
+
+// BuiltinEventState the enabled state of a built-in event specification. OriginalEnabled is the state of the built-in
+// event before it was managed and is restored when the state is deleted.
+type BuiltinEventState struct {
+	ID              string
+	Enabled         bool
+	OriginalEnabled bool
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. The state is identified by the ID of the
+// built-in event specification.
+func (s *BuiltinEventState) GetIDForResourcePath() string {
+	return s.ID
+}
+
+type builtinEventSpecification struct {
+	ID      string `json:"id"`
+	Enabled *bool  `json:"enabled"`
+}
+
+// NewBuiltinEventStateRestResource creates the REST resource for the enabled state of built-in event specifications.
+// Built-in events can neither be created nor deleted. Creating a state records the current state as original state
+// and enables or disables the built-in event via <resource path>/<id>/enable and <resource path>/<id>/disable.
+// Deleting a state restores the original state.
+func NewBuiltinEventStateRestResource(restClient RestClient) rest.RestResource[*BuiltinEventState] {
+	return &builtinEventStateRestResource{client: restClient}
+}
+
+type builtinEventStateRestResource struct {
+	client RestClient
+}
+
+func (r *builtinEventStateRestResource) GetAll() (*[]*BuiltinEventState, error) {
+	data, err := r.client.Get(BuiltinEventSpecificationsResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	specs, err := unmarshalList[builtinEventSpecification](data)
+	if err != nil {
+		return nil, err
+	}
+	states := make([]*BuiltinEventState, len(*specs))
+	for i, spec := range *specs {
+		states[i] = spec.toState()
+	}
+	return &states, nil
+}
+
+func (r *builtinEventStateRestResource) GetOne(id string) (*BuiltinEventState, error) {
+	data, err := r.client.Get(BuiltinEventSpecificationsResourcePath + "/" + id)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := unmarshalObject[*builtinEventSpecification](data)
+	if err != nil {
+		return nil, err
+	}
+	if spec.ID == "" {
+		spec.ID = id
+	}
+	return spec.toState(), nil
+}
+
+func (r *builtinEventStateRestResource) Create(data *BuiltinEventState) (*BuiltinEventState, error) {
+	current, err := r.GetOne(data.ID)
+	if err != nil {
+		return nil, err
+	}
+	return r.apply(data.ID, data.Enabled, current.Enabled)
+}
+
+func (r *builtinEventStateRestResource) Update(data *BuiltinEventState) (*BuiltinEventState, error) {
+	return r.apply(data.ID, data.Enabled, data.OriginalEnabled)
+}
+
+func (r *builtinEventStateRestResource) Delete(data *BuiltinEventState) error {
+	return r.setEnabled(data.ID, data.OriginalEnabled)
+}
+
+func (r *builtinEventStateRestResource) DeleteByID(id string) error {
+	return fmt.Errorf("the original state of the built-in event %s is required to restore it on delete", id)
+}
+
+func (r *builtinEventStateRestResource) apply(id string, enabled bool, originalEnabled bool) (*BuiltinEventState, error) {
+	if err := r.setEnabled(id, enabled); err != nil {
+		return nil, err
+	}
+	state, err := r.GetOne(id)
+	if err != nil {
+		return nil, err
+	}
+	state.OriginalEnabled = originalEnabled
+	return state, nil
+}
+
+func (r *builtinEventStateRestResource) setEnabled(id string, enabled bool) error {
+	operation := "disable"
+	if enabled {
+		operation = "enable"
+	}
+	_, err := r.client.Post(BuiltinEventSpecificationsResourcePath+"/"+id+"/"+operation, nil)
+	return err
+}
+
+func (s *builtinEventSpecification) toState() *BuiltinEventState {
+	enabled := s.Enabled == nil || *s.Enabled
+	return &BuiltinEventState{
+		ID:              s.ID,
+		Enabled:         enabled,
+		OriginalEnabled: enabled,
+	}
+}
@@ -0,0 +1,84 @@
+package instanaapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	builtinEventPath        = instanaapi.BuiltinEventSpecificationsResourcePath + "/event-id"
+	builtinEventEnablePath  = builtinEventPath + "/enable"
+	builtinEventDisablePath = builtinEventPath + "/disable"
+)
+
+func newBuiltinEventTestServer(enabled *bool) testutils.TestHTTPServer {
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodPost, builtinEventEnablePath, func(w http.ResponseWriter, _ *http.Request) {
+		*enabled = true
+		server.WriteJSONResponse(w, []byte(`{"id":"event-id","enabled":true}`))
+	})
+	server.AddRoute(http.MethodPost, builtinEventDisablePath, func(w http.ResponseWriter, _ *http.Request) {
+		*enabled = false
+		server.WriteJSONResponse(w, []byte(`{"id":"event-id","enabled":false}`))
+	})
+	server.AddRoute(http.MethodGet, builtinEventPath, func(w http.ResponseWriter, _ *http.Request) {
+		if *enabled {
+			server.WriteJSONResponse(w, []byte(`{"id":"event-id","name":"event","enabled":true}`))
+		} else {
+			server.WriteJSONResponse(w, []byte(`{"id":"event-id","name":"event","enabled":false}`))
+		}
+	})
+	return server
+}
+
+func TestShouldDisableBuiltinEventAndRecordOriginalStateWhenStateIsCreated(t *testing.T) {
+	enabled := true
+	server := newBuiltinEventTestServer(&enabled)
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewBuiltinEventStateRestResource(instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	result, err := sut.Create(&instanaapi.BuiltinEventState{ID: "event-id", Enabled: false})
+
+	require.NoError(t, err)
+	assert.Equal(t, &instanaapi.BuiltinEventState{ID: "event-id", Enabled: false, OriginalEnabled: true}, result)
+	assert.Equal(t, 1, server.GetCallCount(http.MethodPost, builtinEventDisablePath))
+	assert.Equal(t, 0, server.GetCallCount(http.MethodPost, builtinEventEnablePath))
+}
+
+func TestShouldReadCurrentStateOfBuiltinEvent(t *testing.T) {
+	enabled := false
+	server := newBuiltinEventTestServer(&enabled)
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewBuiltinEventStateRestResource(instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	result, err := sut.GetOne("event-id")
+
+	require.NoError(t, err)
+	assert.Equal(t, "event-id", result.GetIDForResourcePath())
+	assert.False(t, result.Enabled)
+}
+
+func TestShouldRestoreOriginalStateOfBuiltinEventWhenStateIsDeleted(t *testing.T) {
+	enabled := false
+	server := newBuiltinEventTestServer(&enabled)
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewBuiltinEventStateRestResource(instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	err := sut.Delete(&instanaapi.BuiltinEventState{ID: "event-id", Enabled: false, OriginalEnabled: true})
+
+	require.NoError(t, err)
+	assert.True(t, enabled)
+	assert.Equal(t, 1, server.GetCallCount(http.MethodPost, builtinEventEnablePath))
+	assert.Error(t, sut.DeleteByID("event-id"))
+}
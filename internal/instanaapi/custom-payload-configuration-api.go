@@ -0,0 +1,83 @@
+package instanaapi
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/instana/instana-go-client/shared/types"
+)
+
+const (
+	// CustomPayloadConfigurationResourcePath path to the global custom payload configuration of the Instana API
+	CustomPayloadConfigurationResourcePath = "/api/events/settings/custom-payload-configurations"
+	// CustomPayloadTagCatalogResourcePath path to the catalog of the tags supported by dynamic custom payload fields
+	CustomPayloadTagCatalogResourcePath = CustomPayloadConfigurationResourcePath + "/catalog"
+)
+
+// CustomPayloadConfiguration is the representation of the global custom payload configuration in Instana. The
+// custom payload fields are attached to all alerts of the tenant unit.
+type CustomPayloadConfiguration struct {
+	Fields      []types.CustomPayloadField[any] `json:"fields"`
+	LastUpdated int64                           `json:"lastUpdated,omitempty"`
+}
+
+type customPayloadFieldJSON struct {
+	Type  types.CustomPayloadType `json:"type"`
+	Key   string                  `json:"key"`
+	Value json.RawMessage         `json:"value"`
+}
+
+// UnmarshalJSON decodes the custom payload fields into the value types of the instana-go-client, so that the
+// shared custom payload field mappers can be used
+func (c *CustomPayloadConfiguration) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Fields      []customPayloadFieldJSON `json:"fields"`
+		LastUpdated int64                    `json:"lastUpdated"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	fields := make([]types.CustomPayloadField[any], len(raw.Fields))
+	for i, field := range raw.Fields {
+		var value any
+		switch field.Type {
+		case types.DynamicCustomPayloadType:
+			dynamicValue := types.DynamicCustomPayloadFieldValue{}
+			if err := json.Unmarshal(field.Value, &dynamicValue); err != nil {
+				return fmt.Errorf("invalid dynamic value of custom payload field %s; %w", field.Key, err)
+			}
+			value = dynamicValue
+		case types.StaticStringCustomPayloadType:
+			var staticValue string
+			if err := json.Unmarshal(field.Value, &staticValue); err != nil {
+				return fmt.Errorf("invalid static value of custom payload field %s; %w", field.Key, err)
+			}
+			value = staticValue
+		default:
+			return fmt.Errorf("unsupported type %s of custom payload field %s", field.Type, field.Key)
+		}
+		fields[i] = types.CustomPayloadField[any]{Type: field.Type, Key: field.Key, Value: value}
+	}
+
+	c.Fields = fields
+	c.LastUpdated = raw.LastUpdated
+	return nil
+}
+
+// TagCatalogTag a single tag of a tag catalog
+type TagCatalogTag struct {
+	Name                  string  `json:"name"`
+	Type                  string  `json:"type"`
+	Label                 *string `json:"label,omitempty"`
+	Description           *string `json:"description,omitempty"`
+	CanApplyToSource      bool    `json:"canApplyToSource"`
+	CanApplyToDestination bool    `json:"canApplyToDestination"`
+	IDTag                 bool    `json:"idTag"`
+}
+
+// TagCatalog is the representation of a tag catalog of the Instana API. Only the flat list of tags is modeled,
+// the tag tree is intended for the UI only.
+type TagCatalog struct {
+	Tags []TagCatalogTag `json:"tags"`
+}
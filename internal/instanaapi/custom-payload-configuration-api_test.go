@@ -0,0 +1,91 @@
+package instanaapi_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/instana/instana-go-client/shared/types"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const customPayloadConfigurationJSON = `{"fields":[{"type":"staticString","key":"team","value":"platform"},{"type":"dynamic","key":"cluster","value":{"tagName":"kubernetes.cluster.name"}}],"lastUpdated":1700000000000}`
+
+func TestShouldDecodeCustomPayloadFieldsIntoGoClientValueTypes(t *testing.T) {
+	config := instanaapi.CustomPayloadConfiguration{}
+
+	require.NoError(t, json.Unmarshal([]byte(customPayloadConfigurationJSON), &config))
+
+	assert.Equal(t, int64(1700000000000), config.LastUpdated)
+	assert.Equal(t, []types.CustomPayloadField[any]{
+		{Type: types.StaticStringCustomPayloadType, Key: "team", Value: "platform"},
+		{Type: types.DynamicCustomPayloadType, Key: "cluster", Value: types.DynamicCustomPayloadFieldValue{TagName: "kubernetes.cluster.name"}},
+	}, config.Fields)
+}
+
+func TestShouldFailToDecodeCustomPayloadFieldsOfUnsupportedType(t *testing.T) {
+	config := instanaapi.CustomPayloadConfiguration{}
+
+	err := json.Unmarshal([]byte(`{"fields":[{"type":"unknown","key":"key","value":"value"}]}`), &config)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported type unknown")
+}
+
+func TestShouldUpsertAndReadSingletonResource(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	stored := []byte(`{"fields":[]}`)
+	server.AddRoute(http.MethodPut, instanaapi.CustomPayloadConfigurationResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		stored = body
+		// the API responds with a list instead of the stored object
+		server.WriteJSONResponse(w, []byte(`[]`))
+	})
+	server.AddRoute(http.MethodGet, instanaapi.CustomPayloadConfigurationResourcePath, func(w http.ResponseWriter, _ *http.Request) {
+		server.WriteJSONResponse(w, stored)
+	})
+	server.AddRoute(http.MethodDelete, instanaapi.CustomPayloadConfigurationResourcePath, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewSingletonRestResource[*instanaapi.CustomPayloadConfiguration](instanaapi.CustomPayloadConfigurationResourcePath, instanaapi.NewRestClient(newTestClientConfig(server)))
+	config := &instanaapi.CustomPayloadConfiguration{
+		Fields: []types.CustomPayloadField[any]{
+			{Type: types.StaticStringCustomPayloadType, Key: "team", Value: "platform"},
+		},
+	}
+
+	result, err := sut.Upsert(config)
+
+	require.NoError(t, err)
+	assert.Equal(t, config.Fields, result.Fields)
+	assert.Equal(t, 1, server.GetCallCount(http.MethodGet, instanaapi.CustomPayloadConfigurationResourcePath))
+	require.NoError(t, sut.Delete())
+	assert.Equal(t, 1, server.GetCallCount(http.MethodDelete, instanaapi.CustomPayloadConfigurationResourcePath))
+}
+
+func TestShouldReadCustomPayloadTagCatalog(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodGet, instanaapi.CustomPayloadTagCatalogResourcePath, func(w http.ResponseWriter, _ *http.Request) {
+		server.WriteJSONResponse(w, []byte(`{"tagTree":[],"tags":[{"name":"kubernetes.cluster.name","type":"STRING","label":"Cluster"}]}`))
+	})
+	server.Start()
+	defer server.Close()
+
+	clientConfig := newTestClientConfig(server)
+	sut := instanaapi.NewInstanaAPI(nil, clientConfig)
+
+	result, err := sut.CustomPayloadTagCatalog()
+
+	require.NoError(t, err)
+	require.Len(t, result.Tags, 1)
+	assert.Equal(t, "kubernetes.cluster.name", result.Tags[0].Name)
+	assert.Equal(t, "Cluster", *result.Tags[0].Label)
+}
@@ -0,0 +1,66 @@
+package instanaapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// ApplicationAlertConfigsResourcePath path to the application alert configurations of the Instana API
+	ApplicationAlertConfigsResourcePath = "/api/events/settings/application-alert-configs"
+	// GlobalApplicationAlertConfigsResourcePath path to the global application alert configurations of the Instana API
+	GlobalApplicationAlertConfigsResourcePath = "/api/events/settings/global-alert-configs/applications"
+	// WebsiteAlertConfigsResourcePath path to the website alert configurations of the Instana API
+	WebsiteAlertConfigsResourcePath = "/api/events/settings/website-alert-configs"
+	// MobileAppAlertConfigsResourcePath path to the mobile app alert configurations of the Instana API
+	MobileAppAlertConfigsResourcePath = "/api/events/settings/mobile-app-alert-configs"
+	// InfraAlertConfigsResourcePath path to the infrastructure alert configurations of the Instana API
+	InfraAlertConfigsResourcePath = "/api/events/settings/infra-alert-configs"
+	// SyntheticAlertConfigsResourcePath path to the global synthetic alert configurations of the Instana API
+	SyntheticAlertConfigsResourcePath = "/api/events/settings/global-alert-configs/synthetics"
+)
+
+// EnablementToggle enables and disables objects of the Instana API via the dedicated endpoints
+// <resource path>/<id>/enable and <resource path>/<id>/disable, without sending the whole object.
+type EnablementToggle interface {
+	// SetEnabled enables or disables the object with the given ID
+	SetEnabled(id string, enabled bool) error
+	// IsEnabled returns the enabled flag of the object with the given ID. Objects without enabled flag are enabled.
+	IsEnabled(id string) (bool, error)
+}
+
+// NewEnablementToggle creates a new EnablementToggle for the objects of the given resource path
+func NewEnablementToggle(resourcePath string, restClient RestClient) EnablementToggle {
+	return &enablementToggleImpl{
+		resourcePath: resourcePath,
+		client:       restClient,
+	}
+}
+
+type enablementToggleImpl struct {
+	resourcePath string
+	client       RestClient
+}
+
+func (t *enablementToggleImpl) SetEnabled(id string, enabled bool) error {
+	operation := "disable"
+	if enabled {
+		operation = "enable"
+	}
+	_, err := t.client.Put(t.resourcePath+"/"+id+"/"+operation, nil)
+	return err
+}
+
+func (t *enablementToggleImpl) IsEnabled(id string) (bool, error) {
+	data, err := t.client.Get(t.resourcePath + "/" + id)
+	if err != nil {
+		return false, err
+	}
+	var object struct {
+		Enabled *bool `json:"enabled"`
+	}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return false, fmt.Errorf("failed to parse json; %w", err)
+	}
+	return object.Enabled == nil || *object.Enabled, nil
+}
@@ -0,0 +1,60 @@
+package instanaapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const infraAlertConfigPath = instanaapi.InfraAlertConfigsResourcePath + "/alert-id"
+
+func TestShouldEnableAndDisableObjectsViaDedicatedEndpoints(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	for _, operation := range []string{"enable", "disable"} {
+		server.AddRoute(http.MethodPut, infraAlertConfigPath+"/"+operation, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewEnablementToggle(instanaapi.InfraAlertConfigsResourcePath, instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	require.NoError(t, sut.SetEnabled("alert-id", false))
+	require.NoError(t, sut.SetEnabled("alert-id", true))
+	assert.Equal(t, 1, server.GetCallCount(http.MethodPut, infraAlertConfigPath+"/disable"))
+	assert.Equal(t, 1, server.GetCallCount(http.MethodPut, infraAlertConfigPath+"/enable"))
+}
+
+func TestShouldReadEnabledStateOfObjects(t *testing.T) {
+	testCases := map[string]struct {
+		response string
+		expected bool
+	}{
+		"enabled":  {response: `{"id":"alert-id","enabled":true}`, expected: true},
+		"disabled": {response: `{"id":"alert-id","enabled":false}`, expected: false},
+		"missing":  {response: `{"id":"alert-id"}`, expected: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := testutils.NewTestHTTPServer()
+			server.AddRoute(http.MethodGet, infraAlertConfigPath, func(w http.ResponseWriter, _ *http.Request) {
+				server.WriteJSONResponse(w, []byte(testCase.response))
+			})
+			server.Start()
+			defer server.Close()
+
+			sut := instanaapi.NewEnablementToggle(instanaapi.InfraAlertConfigsResourcePath, instanaapi.NewRestClient(newTestClientConfig(server)))
+
+			enabled, err := sut.IsEnabled("alert-id")
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, enabled)
+		})
+	}
+}
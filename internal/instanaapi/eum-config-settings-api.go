@@ -0,0 +1,267 @@
+package instanaapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/instana/instana-go-client/shared/rest"
+)
+
+const (
+	// WebsiteMonitoringConfigResourcePath path to the website monitoring configurations of the Instana API
+	WebsiteMonitoringConfigResourcePath = "/api/website-monitoring/config"
+	// MobileAppMonitoringConfigResourcePath path to the mobile app monitoring configurations of the Instana API
+	MobileAppMonitoringConfigResourcePath = "/api/mobile-app-monitoring/config"
+
+	// GeoLocationConfigurationSubPath path of the geo location configuration relative to a website or mobile app
+	GeoLocationConfigurationSubPath = "geo-location"
+	// GeoMappingRulesSubPath path of the geo mapping rules relative to a website or mobile app
+	GeoMappingRulesSubPath = "geo-mapping-rules"
+	// IPMaskingConfigurationSubPath path of the IP masking configuration relative to a website or mobile app
+	IPMaskingConfigurationSubPath = "ip-masking"
+
+	mediaTypeCSV = "text/csv"
+)
+
+// EUMApplicationType the type of end user monitoring application (website or mobile app) which owns a
+// configuration. Websites and mobile apps expose the same configuration endpoints below different base paths.
+type EUMApplicationType string
+
+const (
+	// EUMApplicationTypeWebsite constant value for websites
+	EUMApplicationTypeWebsite = EUMApplicationType("website")
+	// EUMApplicationTypeMobileApp constant value for mobile apps
+	EUMApplicationTypeMobileApp = EUMApplicationType("mobile_app")
+)
+
+// ResourcePath returns the base path of the monitoring configurations of the EUM application type
+func (t EUMApplicationType) ResourcePath() string {
+	if t == EUMApplicationTypeMobileApp {
+		return MobileAppMonitoringConfigResourcePath
+	}
+	return WebsiteMonitoringConfigResourcePath
+}
+
+// GeoDetailRemoval the level of geo location details which are removed from the collected beacons
+type GeoDetailRemoval string
+
+const (
+	// GeoDetailRemovalNoRemoval keeps all geo location details
+	GeoDetailRemovalNoRemoval = GeoDetailRemoval("NO_REMOVAL")
+	// GeoDetailRemovalRemoveCoordinates removes the coordinates
+	GeoDetailRemovalRemoveCoordinates = GeoDetailRemoval("REMOVE_COORDINATES")
+	// GeoDetailRemovalRemoveCity removes the city and the coordinates
+	GeoDetailRemovalRemoveCity = GeoDetailRemoval("REMOVE_CITY")
+	// GeoDetailRemovalRemoveAll removes all geo location details
+	GeoDetailRemovalRemoveAll = GeoDetailRemoval("REMOVE_ALL")
+)
+
+// SupportedGeoDetailRemovals list of all supported geo detail removal levels
+var SupportedGeoDetailRemovals = []string{
+	string(GeoDetailRemovalNoRemoval),
+	string(GeoDetailRemovalRemoveCoordinates),
+	string(GeoDetailRemovalRemoveCity),
+	string(GeoDetailRemovalRemoveAll),
+}
+
+// IPMasking the masking level applied to the IP addresses of the collected beacons
+type IPMasking string
+
+const (
+	// IPMaskingDefault stores the full IP address
+	IPMaskingDefault = IPMasking("DEFAULT")
+	// IPMaskingStrict masks the last octet of the IP address
+	IPMaskingStrict = IPMasking("STRICT")
+	// IPMaskingRemoveAllDetails removes the IP address
+	IPMaskingRemoveAllDetails = IPMasking("REMOVE_ALL_DETAILS")
+)
+
+// SupportedIPMaskings list of all supported IP masking levels
+var SupportedIPMaskings = []string{
+	string(IPMaskingDefault),
+	string(IPMaskingStrict),
+	string(IPMaskingRemoveAllDetails),
+}
+
+// GeoLocationConfiguration is the representation of the geo location configuration of a website or mobile app.
+// The geo mapping rules are managed via GeoMappingRules and passed through unchanged.
+type GeoLocationConfiguration struct {
+	ConfigID         string            `json:"-"`
+	GeoDetailRemoval GeoDetailRemoval  `json:"geoDetailRemoval"`
+	GeoMappingRules  []json.RawMessage `json:"geoMappingRules,omitempty"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. Geo location configurations are
+// identified by the ID of the website or mobile app they belong to.
+func (c *GeoLocationConfiguration) GetIDForResourcePath() string {
+	return c.ConfigID
+}
+
+// GeoMappingRules is the representation of the custom geo mapping rules of a website or mobile app. The Instana API
+// transfers the rules as CSV document.
+type GeoMappingRules struct {
+	ConfigID string
+	CSV      string
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. Geo mapping rules are identified by the
+// ID of the website or mobile app they belong to.
+func (r *GeoMappingRules) GetIDForResourcePath() string {
+	return r.ConfigID
+}
+
+// IPMaskingConfiguration is the representation of the IP masking configuration of a website or mobile app
+type IPMaskingConfiguration struct {
+	ConfigID  string    `json:"-"`
+	IPMasking IPMasking `json:"ipMasking"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. IP masking configurations are
+// identified by the ID of the website or mobile app they belong to.
+func (c *IPMaskingConfiguration) GetIDForResourcePath() string {
+	return c.ConfigID
+}
+
+// NewGeoLocationConfigurationRestResource creates the REST resource for the geo location configurations of the
+// given EUM application type. The geo mapping rules of the current configuration are kept on update.
+func NewGeoLocationConfigurationRestResource(appType EUMApplicationType, restClient RestClient) rest.RestResource[*GeoLocationConfiguration] {
+	return &eumConfigSettingsRestResource[*GeoLocationConfiguration]{
+		resourcePath: appType.ResourcePath(),
+		subPath:      GeoLocationConfigurationSubPath,
+		client:       restClient,
+		marshal:      marshalJSON[*GeoLocationConfiguration],
+		unmarshal: func(id string, data []byte) (*GeoLocationConfiguration, error) {
+			config, err := unmarshalObject[*GeoLocationConfiguration](data)
+			if err != nil {
+				return nil, err
+			}
+			config.ConfigID = id
+			return config, nil
+		},
+		merge: func(current *GeoLocationConfiguration, desired *GeoLocationConfiguration) *GeoLocationConfiguration {
+			desired.GeoMappingRules = current.GeoMappingRules
+			return desired
+		},
+		defaults: func(id string) *GeoLocationConfiguration {
+			return &GeoLocationConfiguration{ConfigID: id, GeoDetailRemoval: GeoDetailRemovalNoRemoval}
+		},
+	}
+}
+
+// NewGeoMappingRulesRestResource creates the REST resource for the geo mapping rules of the given EUM application
+// type
+func NewGeoMappingRulesRestResource(appType EUMApplicationType, restClient RestClient) rest.RestResource[*GeoMappingRules] {
+	return &eumConfigSettingsRestResource[*GeoMappingRules]{
+		resourcePath: appType.ResourcePath(),
+		subPath:      GeoMappingRulesSubPath,
+		mediaType:    mediaTypeCSV,
+		client:       restClient,
+		marshal: func(rules *GeoMappingRules) ([]byte, error) {
+			return []byte(rules.CSV), nil
+		},
+		unmarshal: func(id string, data []byte) (*GeoMappingRules, error) {
+			return &GeoMappingRules{ConfigID: id, CSV: string(data)}, nil
+		},
+		defaults: func(id string) *GeoMappingRules {
+			return &GeoMappingRules{ConfigID: id}
+		},
+	}
+}
+
+// NewIPMaskingConfigurationRestResource creates the REST resource for the IP masking configurations of the given
+// EUM application type
+func NewIPMaskingConfigurationRestResource(appType EUMApplicationType, restClient RestClient) rest.RestResource[*IPMaskingConfiguration] {
+	return &eumConfigSettingsRestResource[*IPMaskingConfiguration]{
+		resourcePath: appType.ResourcePath(),
+		subPath:      IPMaskingConfigurationSubPath,
+		client:       restClient,
+		marshal:      marshalJSON[*IPMaskingConfiguration],
+		unmarshal: func(id string, data []byte) (*IPMaskingConfiguration, error) {
+			config, err := unmarshalObject[*IPMaskingConfiguration](data)
+			if err != nil {
+				return nil, err
+			}
+			config.ConfigID = id
+			return config, nil
+		},
+		defaults: func(id string) *IPMaskingConfiguration {
+			return &IPMaskingConfiguration{ConfigID: id, IPMasking: IPMaskingDefault}
+		},
+	}
+}
+
+// eumConfigSettingsRestResource is a rest.RestResource for settings which exist exactly once per website or
+// mobile app at <resource path>/<id>/<sub path>. The settings cannot be created or deleted; create and update
+// overwrite the settings and delete restores the defaults.
+type eumConfigSettingsRestResource[T rest.InstanaDataObject] struct {
+	resourcePath string
+	subPath      string
+	mediaType    string
+	client       RestClient
+	marshal      func(data T) ([]byte, error)
+	unmarshal    func(id string, data []byte) (T, error)
+	merge        func(current T, desired T) T
+	defaults     func(id string) T
+}
+
+func (r *eumConfigSettingsRestResource[T]) GetAll() (*[]T, error) {
+	return nil, fmt.Errorf("listing %s settings is not supported by the Instana API", r.subPath)
+}
+
+func (r *eumConfigSettingsRestResource[T]) GetOne(id string) (T, error) {
+	data, err := r.client.GetWithMediaType(r.settingsPath(id), r.mediaType)
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+	return r.unmarshal(id, data)
+}
+
+func (r *eumConfigSettingsRestResource[T]) Create(data T) (T, error) {
+	return r.Update(data)
+}
+
+func (r *eumConfigSettingsRestResource[T]) Update(data T) (T, error) {
+	id := data.GetIDForResourcePath()
+	if r.merge != nil {
+		current, err := r.GetOne(id)
+		if err != nil {
+			var empty T
+			return empty, err
+		}
+		data = r.merge(current, data)
+	}
+
+	payload, err := r.marshal(data)
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+	if _, err := r.client.PutWithMediaType(r.settingsPath(id), r.mediaType, payload); err != nil {
+		var empty T
+		return empty, err
+	}
+	return r.GetOne(id)
+}
+
+func (r *eumConfigSettingsRestResource[T]) Delete(data T) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+func (r *eumConfigSettingsRestResource[T]) DeleteByID(id string) error {
+	_, err := r.Update(r.defaults(id))
+	return err
+}
+
+func (r *eumConfigSettingsRestResource[T]) settingsPath(id string) string {
+	return strings.Join([]string{r.resourcePath, id, r.subPath}, "/")
+}
+
+func marshalJSON[T any](data T) ([]byte, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal json; %w", err)
+	}
+	return payload, nil
+}
@@ -0,0 +1,103 @@
+package instanaapi_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	websiteGeoLocationPath          = instanaapi.WebsiteMonitoringConfigResourcePath + "/website-id/" + instanaapi.GeoLocationConfigurationSubPath
+	mobileAppGeoMappingPath         = instanaapi.MobileAppMonitoringConfigResourcePath + "/mobile-app-id/" + instanaapi.GeoMappingRulesSubPath
+	websiteIPMaskingPath            = instanaapi.WebsiteMonitoringConfigResourcePath + "/website-id/" + instanaapi.IPMaskingConfigurationSubPath
+	geoMappingRulesCSV              = "10.0.0.0/8,EU,Europe,CH,Switzerland\n"
+	geoLocationWithRulesJSON        = `{"geoDetailRemoval":"NO_REMOVAL","geoMappingRules":[{"cidr":"10.0.0.0/8","subdivisions":[]}]}`
+	updatedGeoLocationWithRulesJSON = `{"geoDetailRemoval":"REMOVE_CITY","geoMappingRules":[{"cidr":"10.0.0.0/8","subdivisions":[]}]}`
+)
+
+func TestShouldUpdateGeoLocationConfigurationAndKeepGeoMappingRules(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	stored := []byte(geoLocationWithRulesJSON)
+	server.AddRoute(http.MethodGet, websiteGeoLocationPath, func(w http.ResponseWriter, _ *http.Request) {
+		server.WriteJSONResponse(w, stored)
+	})
+	server.AddRoute(http.MethodPut, websiteGeoLocationPath, func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		stored = body
+		server.WriteJSONResponse(w, body)
+	})
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewGeoLocationConfigurationRestResource(instanaapi.EUMApplicationTypeWebsite, instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	result, err := sut.Update(&instanaapi.GeoLocationConfiguration{ConfigID: "website-id", GeoDetailRemoval: instanaapi.GeoDetailRemovalRemoveCity})
+
+	require.NoError(t, err)
+	assert.JSONEq(t, updatedGeoLocationWithRulesJSON, string(stored))
+	assert.Equal(t, "website-id", result.ConfigID)
+	assert.Equal(t, instanaapi.GeoDetailRemovalRemoveCity, result.GeoDetailRemoval)
+}
+
+func TestShouldWriteAndReadGeoMappingRulesAsCSV(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	stored := ""
+	server.AddRoute(http.MethodGet, mobileAppGeoMappingPath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "text/csv", r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", "text/csv")
+		_, _ = w.Write([]byte(stored))
+	})
+	server.AddRoute(http.MethodPut, mobileAppGeoMappingPath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "text/csv", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		stored = string(body)
+		w.WriteHeader(http.StatusOK)
+	})
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewGeoMappingRulesRestResource(instanaapi.EUMApplicationTypeMobileApp, instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	result, err := sut.Create(&instanaapi.GeoMappingRules{ConfigID: "mobile-app-id", CSV: geoMappingRulesCSV})
+
+	require.NoError(t, err)
+	assert.Equal(t, &instanaapi.GeoMappingRules{ConfigID: "mobile-app-id", CSV: geoMappingRulesCSV}, result)
+
+	require.NoError(t, sut.DeleteByID("mobile-app-id"))
+	assert.Empty(t, stored)
+}
+
+func TestShouldRestoreDefaultIPMaskingOnDelete(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	stored := []byte(`{"ipMasking":"STRICT"}`)
+	server.AddRoute(http.MethodGet, websiteIPMaskingPath, func(w http.ResponseWriter, _ *http.Request) {
+		server.WriteJSONResponse(w, stored)
+	})
+	server.AddRoute(http.MethodPut, websiteIPMaskingPath, func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		stored = body
+		server.WriteJSONResponse(w, body)
+	})
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewIPMaskingConfigurationRestResource(instanaapi.EUMApplicationTypeWebsite, instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	current, err := sut.GetOne("website-id")
+	require.NoError(t, err)
+	assert.Equal(t, &instanaapi.IPMaskingConfiguration{ConfigID: "website-id", IPMasking: instanaapi.IPMaskingStrict}, current)
+
+	require.NoError(t, sut.Delete(current))
+	assert.JSONEq(t, `{"ipMasking":"DEFAULT"}`, string(stored))
+
+	_, err = sut.GetAll()
+	require.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package instanaapi
+
+// HTTPEndpointConfigsResourcePath path to the HTTP endpoint configuration resource of the Instana API
+const HTTPEndpointConfigsResourcePath = "/api/application-monitoring/settings/http-endpoint"
+
+// HTTPPathSegmentMatchingType type of the matching rule of a single path segment
+type HTTPPathSegmentMatchingType string
+
+const (
+	// HTTPPathSegmentMatchingTypeFixed matches a path segment with a fixed name
+	HTTPPathSegmentMatchingTypeFixed = HTTPPathSegmentMatchingType("FIXED")
+	// HTTPPathSegmentMatchingTypeParameter matches any path segment and uses the given name as path parameter
+	HTTPPathSegmentMatchingTypeParameter = HTTPPathSegmentMatchingType("PARAMETER")
+	// HTTPPathSegmentMatchingTypeMatchAll matches all remaining path segments
+	HTTPPathSegmentMatchingTypeMatchAll = HTTPPathSegmentMatchingType("MATCH_ALL")
+)
+
+// SupportedHTTPPathSegmentMatchingTypes list of all supported path segment matching types
+var SupportedHTTPPathSegmentMatchingTypes = []string{
+	string(HTTPPathSegmentMatchingTypeFixed),
+	string(HTTPPathSegmentMatchingTypeParameter),
+	string(HTTPPathSegmentMatchingTypeMatchAll),
+}
+
+// HTTPPathSegmentMatchingRule the matching rule of a single path segment of an HTTP endpoint rule
+type HTTPPathSegmentMatchingRule struct {
+	Type HTTPPathSegmentMatchingType `json:"type"`
+	Name *string                     `json:"name,omitempty"`
+}
+
+// HTTPEndpointRule a rule to derive HTTP endpoint names from the path segments of calls
+type HTTPEndpointRule struct {
+	Enabled      bool                          `json:"enabled"`
+	PathSegments []HTTPPathSegmentMatchingRule `json:"pathSegments"`
+	TestCases    []string                      `json:"testCases,omitempty"`
+}
+
+// HTTPEndpointConfig is the representation of the HTTP endpoint configuration of a service in Instana
+type HTTPEndpointConfig struct {
+	ServiceID                                      string             `json:"serviceId"`
+	EndpointNameByFirstPathSegmentRuleEnabled      bool               `json:"endpointNameByFirstPathSegmentRuleEnabled"`
+	EndpointNameByCollectedPathTemplateRuleEnabled bool               `json:"endpointNameByCollectedPathTemplateRuleEnabled"`
+	Rules                                          []HTTPEndpointRule `json:"rules"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. HTTP endpoint configurations are
+// identified by the ID of the service they belong to.
+func (c *HTTPEndpointConfig) GetIDForResourcePath() string {
+	return c.ServiceID
+}
@@ -0,0 +1,160 @@
+// Package instanaapi extends the client.InstanaAPI of the instana-go-client with the Instana API endpoints
+// which are used by the provider but not (yet) part of the instana-go-client. Once an endpoint is available
+// in the instana-go-client the corresponding accessor should be removed from this package.
+package instanaapi
+
+import (
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/config"
+	"github.com/instana/instana-go-client/shared/rest"
+)
+
+// InstanaAPI is the extended interface of the client.InstanaAPI providing access to additional REST resources
+type InstanaAPI interface {
+	client.InstanaAPI
+
+	// Releases returns the REST resource for releases. GetAll reads the releases without a time range and therefore
+	// only returns the releases of the default time range of the Instana API.
+	Releases() rest.RestResource[*Release]
+	// HTTPEndpointConfigs returns the REST resource for HTTP endpoint configurations of services
+	HTTPEndpointConfigs() rest.RestResource[*HTTPEndpointConfig]
+	// ServiceConfigs returns the REST resource for custom service configurations
+	ServiceConfigs() rest.RestResource[*ServiceConfig]
+	// ManualServiceConfigs returns the REST resource for manual service configurations
+	ManualServiceConfigs() rest.RestResource[*ManualServiceConfig]
+	// CustomPayloadConfiguration returns the singleton REST resource for the global custom payload configuration
+	CustomPayloadConfiguration() rest.SingletonRestResource[*CustomPayloadConfiguration]
+	// CustomPayloadTagCatalog reads the catalog of the tags supported by dynamic custom payload fields
+	CustomPayloadTagCatalog() (*TagCatalog, error)
+	// GeoLocationConfigurations returns the REST resource for the geo location configurations of websites or mobile apps
+	GeoLocationConfigurations(appType EUMApplicationType) rest.RestResource[*GeoLocationConfiguration]
+	// GeoMappingRules returns the REST resource for the geo mapping rules of websites or mobile apps
+	GeoMappingRules(appType EUMApplicationType) rest.RestResource[*GeoMappingRules]
+	// IPMaskingConfigurations returns the REST resource for the IP masking configurations of websites or mobile apps
+	IPMaskingConfigurations(appType EUMApplicationType) rest.RestResource[*IPMaskingConfiguration]
+	// EnablementToggle returns the EnablementToggle for the objects of the given resource path
+	EnablementToggle(resourcePath string) EnablementToggle
+	// BaselineUpdater returns the BaselineUpdater for the smart alert configurations of the given resource path
+	BaselineUpdater(resourcePath string) BaselineUpdater
+	// AlertConfigVersionHistory returns the AlertConfigVersionHistory for the smart alert configurations of the given
+	// resource path
+	AlertConfigVersionHistory(resourcePath string) AlertConfigVersionHistory
+	// AlertConfigVersionPins returns the REST resource for version pins of smart alert configurations
+	AlertConfigVersionPins() rest.RestResource[*AlertConfigVersionPin]
+	// BuiltinEventStates returns the REST resource for the enabled state of built-in event specifications
+	BuiltinEventStates() rest.RestResource[*BuiltinEventState]
+	// SyntheticCallsSettings returns the singleton REST resource for the synthetic calls settings
+	SyntheticCallsSettings() rest.SingletonRestResource[*SyntheticCallsSettings]
+	// BackendInfo returns the BackendInfoReader for the Instana backend of the configured endpoint
+	BackendInfo() BackendInfoReader
+}
+
+// NewInstanaAPI creates a new extended InstanaAPI delegating to the given client.InstanaAPI for all
+// endpoints supported by the instana-go-client
+func NewInstanaAPI(delegate client.InstanaAPI, clientConfig *config.ClientConfig) InstanaAPI {
+	return &instanaAPIImpl{
+		InstanaAPI: delegate,
+		client:     NewRestClient(clientConfig),
+		baseURL:    clientConfig.BaseURL,
+	}
+}
+
+type instanaAPIImpl struct {
+	client.InstanaAPI
+	client  RestClient
+	baseURL string
+}
+
+// Releases implementation of InstanaAPI interface
+func (api *instanaAPIImpl) Releases() rest.RestResource[*Release] {
+	return NewRestResource[*Release](ReleasesResourcePath, CreateModePOST, UpdateModePUT, api.client)
+}
+
+// HTTPEndpointConfigs implementation of InstanaAPI interface
+func (api *instanaAPIImpl) HTTPEndpointConfigs() rest.RestResource[*HTTPEndpointConfig] {
+	return NewRestResource[*HTTPEndpointConfig](HTTPEndpointConfigsResourcePath, CreateModePOST, UpdateModePUT, api.client)
+}
+
+// ServiceConfigs implementation of InstanaAPI interface
+func (api *instanaAPIImpl) ServiceConfigs() rest.RestResource[*ServiceConfig] {
+	return NewServiceConfigRestResource(api.client)
+}
+
+// ManualServiceConfigs implementation of InstanaAPI interface
+func (api *instanaAPIImpl) ManualServiceConfigs() rest.RestResource[*ManualServiceConfig] {
+	return NewManualServiceConfigRestResource(api.client)
+}
+
+// CustomPayloadConfiguration implementation of InstanaAPI interface
+func (api *instanaAPIImpl) CustomPayloadConfiguration() rest.SingletonRestResource[*CustomPayloadConfiguration] {
+	return NewSingletonRestResource[*CustomPayloadConfiguration](CustomPayloadConfigurationResourcePath, api.client)
+}
+
+// CustomPayloadTagCatalog implementation of InstanaAPI interface
+func (api *instanaAPIImpl) CustomPayloadTagCatalog() (*TagCatalog, error) {
+	data, err := api.client.Get(CustomPayloadTagCatalogResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalObject[*TagCatalog](data)
+}
+
+// SyntheticCallsSettings implementation of InstanaAPI interface
+func (api *instanaAPIImpl) SyntheticCallsSettings() rest.SingletonRestResource[*SyntheticCallsSettings] {
+	return NewSingletonRestResource[*SyntheticCallsSettings](SyntheticCallsSettingsResourcePath, api.client)
+}
+
+// GeoLocationConfigurations implementation of InstanaAPI interface
+func (api *instanaAPIImpl) GeoLocationConfigurations(appType EUMApplicationType) rest.RestResource[*GeoLocationConfiguration] {
+	return NewGeoLocationConfigurationRestResource(appType, api.client)
+}
+
+// GeoMappingRules implementation of InstanaAPI interface
+func (api *instanaAPIImpl) GeoMappingRules(appType EUMApplicationType) rest.RestResource[*GeoMappingRules] {
+	return NewGeoMappingRulesRestResource(appType, api.client)
+}
+
+// IPMaskingConfigurations implementation of InstanaAPI interface
+func (api *instanaAPIImpl) IPMaskingConfigurations(appType EUMApplicationType) rest.RestResource[*IPMaskingConfiguration] {
+	return NewIPMaskingConfigurationRestResource(appType, api.client)
+}
+
+// EnablementToggle implementation of InstanaAPI interface
+func (api *instanaAPIImpl) EnablementToggle(resourcePath string) EnablementToggle {
+	return NewEnablementToggle(resourcePath, api.client)
+}
+
+// BaselineUpdater implementation of InstanaAPI interface
+func (api *instanaAPIImpl) BaselineUpdater(resourcePath string) BaselineUpdater {
+	return NewBaselineUpdater(resourcePath, api.client)
+}
+
+// AlertConfigVersionHistory implementation of InstanaAPI interface
+func (api *instanaAPIImpl) AlertConfigVersionHistory(resourcePath string) AlertConfigVersionHistory {
+	return NewAlertConfigVersionHistory(resourcePath, api.client)
+}
+
+// AlertConfigVersionPins implementation of InstanaAPI interface
+func (api *instanaAPIImpl) AlertConfigVersionPins() rest.RestResource[*AlertConfigVersionPin] {
+	return NewAlertConfigVersionPinRestResource(api.client)
+}
+
+// BuiltinEventStates implementation of InstanaAPI interface
+func (api *instanaAPIImpl) BuiltinEventStates() rest.RestResource[*BuiltinEventState] {
+	return NewBuiltinEventStateRestResource(api.client)
+}
+
+// BackendInfo implementation of InstanaAPI interface
+func (api *instanaAPIImpl) BackendInfo() BackendInfoReader {
+	return NewBackendInfoReader(api.baseURL, api.client)
+}
+
+// From returns the extended InstanaAPI of the given client.InstanaAPI. The provider always configures the
+// extended API, therefore this function panics when a plain client.InstanaAPI is provided.
+func From(api client.InstanaAPI) InstanaAPI {
+	extendedAPI, ok := api.(InstanaAPI)
+	if !ok {
+		panic("the configured Instana API does not support the extended endpoints of the provider")
+	}
+	return extendedAPI
+}
@@ -0,0 +1,55 @@
+package instanaapi
+
+import (
+	"fmt"
+
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/shared/rest"
+	tag "github.com/instana/instana-go-client/shared/tagfilter"
+)
+
+// ManualServiceConfigsResourcePath path to the manual service configurations of the Instana API
+const ManualServiceConfigsResourcePath = "/api/application-monitoring/settings/manual-service"
+
+// ManualServiceConfig is the representation of a manual service configuration in Instana. It maps the calls
+// matching the tag filter expression to an existing service or to a new unmonitored service.
+type ManualServiceConfig struct {
+	ID                     string         `json:"id,omitempty"`
+	Description            *string        `json:"description,omitempty"`
+	Enabled                bool           `json:"enabled"`
+	ExistingServiceID      *string        `json:"existingServiceId,omitempty"`
+	UnmonitoredServiceName *string        `json:"unmonitoredServiceName,omitempty"`
+	TagFilterExpression    *tag.TagFilter `json:"tagFilterExpression"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *ManualServiceConfig) GetIDForResourcePath() string {
+	return c.ID
+}
+
+// NewManualServiceConfigRestResource creates the rest.RestResource for manual service configurations. The
+// Instana API does not provide an endpoint to read a single manual service configuration, therefore GetOne
+// is resolved from the list of all manual service configurations.
+func NewManualServiceConfigRestResource(restClient RestClient) rest.RestResource[*ManualServiceConfig] {
+	return &manualServiceConfigRestResource{
+		RestResource: NewRestResource[*ManualServiceConfig](ManualServiceConfigsResourcePath, CreateModePOST, UpdateModePUT, restClient),
+	}
+}
+
+type manualServiceConfigRestResource struct {
+	rest.RestResource[*ManualServiceConfig]
+}
+
+// GetOne returns the manual service configuration with the given ID
+func (r *manualServiceConfigRestResource) GetOne(id string) (*ManualServiceConfig, error) {
+	configs, err := r.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range *configs {
+		if config.ID == id {
+			return config, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: manual service config with id %s", client.ErrEntityNotFound, id)
+}
@@ -0,0 +1,35 @@
+package instanaapi
+
+// ReleasesResourcePath path to the releases resource of the Instana API
+const ReleasesResourcePath = "/api/releases"
+
+// ReleaseApplicationScope the application scope of a release
+type ReleaseApplicationScope struct {
+	Name string `json:"name"`
+}
+
+// ReleaseServiceScopedTo limits a service scope of a release to the given applications
+type ReleaseServiceScopedTo struct {
+	Applications []ReleaseApplicationScope `json:"applications"`
+}
+
+// ReleaseServiceScope the service scope of a release
+type ReleaseServiceScope struct {
+	Name     string                  `json:"name"`
+	ScopedTo *ReleaseServiceScopedTo `json:"scopedTo,omitempty"`
+}
+
+// Release is the representation of a release marker in Instana
+type Release struct {
+	ID           string                    `json:"id,omitempty"`
+	Name         string                    `json:"name"`
+	Start        int64                     `json:"start"`
+	Applications []ReleaseApplicationScope `json:"applications,omitempty"`
+	Services     []ReleaseServiceScope     `json:"services,omitempty"`
+	LastUpdated  int64                     `json:"lastUpdated,omitempty"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (r *Release) GetIDForResourcePath() string {
+	return r.ID
+}
@@ -0,0 +1,174 @@
+package instanaapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/config"
+)
+
+const (
+	headerAuthorization = "Authorization"
+	headerContentType   = "Content-Type"
+	headerAccept        = "Accept"
+	headerUserAgent     = "User-Agent"
+	mediaTypeJSON       = "application/json; charset=utf-8"
+	acceptJSON          = "application/json"
+)
+
+// RestClient is a minimal REST client for the Instana API endpoints which are not (yet) covered by the
+// instana-go-client. It shares the connection settings (base URL, token, HTTP client and headers) of the
+// client configuration created by the provider.
+type RestClient interface {
+	// Get executes a HTTP GET request against the given resource path and returns the response body
+	Get(resourcePath string) ([]byte, error)
+	// GetByQuery executes a HTTP GET request against the given resource path with the given query parameters
+	GetByQuery(resourcePath string, queryParams map[string]string) ([]byte, error)
+	// Post executes a HTTP POST request with the JSON representation of the given data against the given resource path
+	Post(resourcePath string, data any) ([]byte, error)
+	// Put executes a HTTP PUT request with the JSON representation of the given data against the given resource path
+	Put(resourcePath string, data any) ([]byte, error)
+	// Delete executes a HTTP DELETE request against the given resource path
+	Delete(resourcePath string) error
+	// GetWithMediaType executes a HTTP GET request against the given resource path accepting the given media type
+	GetWithMediaType(resourcePath string, mediaType string) ([]byte, error)
+	// PutWithMediaType executes a HTTP PUT request with the given raw payload of the given media type against the
+	// given resource path
+	PutWithMediaType(resourcePath string, mediaType string, payload []byte) ([]byte, error)
+}
+
+// NewRestClient creates a new RestClient for the given client configuration
+func NewRestClient(clientConfig *config.ClientConfig) RestClient {
+	httpClient := clientConfig.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: clientConfig.Timeout.Request}
+	}
+	return &restClientImpl{
+		baseURL:    strings.TrimSuffix(clientConfig.BaseURL, "/"),
+		config:     clientConfig,
+		httpClient: httpClient,
+	}
+}
+
+type restClientImpl struct {
+	baseURL    string
+	config     *config.ClientConfig
+	httpClient *http.Client
+}
+
+func (c *restClientImpl) Get(resourcePath string) ([]byte, error) {
+	return c.execute(http.MethodGet, resourcePath, nil)
+}
+
+func (c *restClientImpl) GetByQuery(resourcePath string, queryParams map[string]string) ([]byte, error) {
+	if len(queryParams) == 0 {
+		return c.Get(resourcePath)
+	}
+	query := url.Values{}
+	for k, v := range queryParams {
+		query.Set(k, v)
+	}
+	return c.execute(http.MethodGet, resourcePath+"?"+query.Encode(), nil)
+}
+
+func (c *restClientImpl) Post(resourcePath string, data any) ([]byte, error) {
+	return c.executeWithBody(http.MethodPost, resourcePath, data)
+}
+
+func (c *restClientImpl) Put(resourcePath string, data any) ([]byte, error) {
+	return c.executeWithBody(http.MethodPut, resourcePath, data)
+}
+
+func (c *restClientImpl) Delete(resourcePath string) error {
+	_, err := c.execute(http.MethodDelete, resourcePath, nil)
+	return err
+}
+
+func (c *restClientImpl) GetWithMediaType(resourcePath string, mediaType string) ([]byte, error) {
+	return c.executeWithMediaType(http.MethodGet, resourcePath, nil, mediaType)
+}
+
+func (c *restClientImpl) PutWithMediaType(resourcePath string, mediaType string, payload []byte) ([]byte, error) {
+	return c.executeWithMediaType(http.MethodPut, resourcePath, payload, mediaType)
+}
+
+func (c *restClientImpl) executeWithBody(method string, resourcePath string, data any) ([]byte, error) {
+	if data == nil {
+		return c.execute(method, resourcePath, nil)
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request payload for %s %s; %w", method, resourcePath, err)
+	}
+	return c.execute(method, resourcePath, payload)
+}
+
+func (c *restClientImpl) execute(method string, resourcePath string, payload []byte) ([]byte, error) {
+	return c.executeWithMediaType(method, resourcePath, payload, "")
+}
+
+// executeWithMediaType executes the request using the given media type for the Accept and Content-Type header.
+// JSON is used when no media type is provided.
+func (c *restClientImpl) executeWithMediaType(method string, resourcePath string, payload []byte, mediaType string) ([]byte, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+resourcePath, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request for %s %s; %w", method, resourcePath, err)
+	}
+	c.applyHeaders(req, payload != nil, mediaType)
+
+	c.logDebug("Calling Instana API", "method", method, "path", resourcePath)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send %s request to %s; %w", method, resourcePath, err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response of %s %s; %w", method, resourcePath, err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s %s", client.ErrEntityNotFound, method, resourcePath)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("failed to execute %s request to %s; status code = %d; status message = %s; response = %s",
+			method, resourcePath, resp.StatusCode, http.StatusText(resp.StatusCode), string(responseBody))
+	}
+	return responseBody, nil
+}
+
+func (c *restClientImpl) applyHeaders(req *http.Request, hasBody bool, mediaType string) {
+	accept, contentType := acceptJSON, mediaTypeJSON
+	if mediaType != "" {
+		accept, contentType = mediaType, mediaType
+	}
+	req.Header.Set(headerAuthorization, "apiToken "+c.config.APIToken)
+	req.Header.Set(headerAccept, accept)
+	if hasBody {
+		req.Header.Set(headerContentType, contentType)
+	}
+	if c.config.UserAgent != "" {
+		req.Header.Set(headerUserAgent, c.config.UserAgent)
+	}
+	for k, v := range c.config.Headers.Custom {
+		req.Header.Set(k, v)
+	}
+}
+
+func (c *restClientImpl) logDebug(msg string, keysAndValues ...interface{}) {
+	if c.config.Logger != nil {
+		c.config.Logger.Debug(msg, keysAndValues...)
+	}
+}
@@ -0,0 +1,113 @@
+package instanaapi
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/instana/instana-go-client/shared/rest"
+)
+
+// CreateMode defines how a new object is sent to the Instana API
+type CreateMode int
+
+const (
+	// CreateModePOST creates new objects via POST on the resource base path; the ID is assigned by the backend
+	CreateModePOST CreateMode = iota
+	// CreateModePUT creates new objects via PUT on <resource base path>/<id>; the ID is provided by the client
+	CreateModePUT
+)
+
+// UpdateMode defines how an existing object is sent to the Instana API
+type UpdateMode int
+
+const (
+	// UpdateModePUT updates objects via PUT on <resource base path>/<id>
+	UpdateModePUT UpdateMode = iota
+	// UpdateModePOST updates objects via POST on <resource base path>/<id>
+	UpdateModePOST
+)
+
+// NewRestResource creates a new generic rest.RestResource for the given resource base path
+func NewRestResource[T rest.InstanaDataObject](resourcePath string, createMode CreateMode, updateMode UpdateMode, restClient RestClient) rest.RestResource[T] {
+	return &restResourceImpl[T]{
+		resourcePath: resourcePath,
+		createMode:   createMode,
+		updateMode:   updateMode,
+		client:       restClient,
+	}
+}
+
+type restResourceImpl[T rest.InstanaDataObject] struct {
+	resourcePath string
+	createMode   CreateMode
+	updateMode   UpdateMode
+	client       RestClient
+}
+
+func (r *restResourceImpl[T]) GetAll() (*[]T, error) {
+	data, err := r.client.Get(r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalList[T](data)
+}
+
+func (r *restResourceImpl[T]) GetOne(id string) (T, error) {
+	data, err := r.client.Get(r.objectPath(id))
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+	return unmarshalObject[T](data)
+}
+
+func (r *restResourceImpl[T]) Create(data T) (T, error) {
+	if r.createMode == CreateModePUT {
+		return r.send(r.client.Put, r.objectPath(data.GetIDForResourcePath()), data)
+	}
+	return r.send(r.client.Post, r.resourcePath, data)
+}
+
+func (r *restResourceImpl[T]) Update(data T) (T, error) {
+	if r.updateMode == UpdateModePOST {
+		return r.send(r.client.Post, r.objectPath(data.GetIDForResourcePath()), data)
+	}
+	return r.send(r.client.Put, r.objectPath(data.GetIDForResourcePath()), data)
+}
+
+func (r *restResourceImpl[T]) Delete(data T) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+func (r *restResourceImpl[T]) DeleteByID(id string) error {
+	return r.client.Delete(r.objectPath(id))
+}
+
+func (r *restResourceImpl[T]) send(operation func(string, any) ([]byte, error), resourcePath string, data T) (T, error) {
+	response, err := operation(resourcePath, data)
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+	return unmarshalObject[T](response)
+}
+
+func (r *restResourceImpl[T]) objectPath(id string) string {
+	return r.resourcePath + "/" + id
+}
+
+func unmarshalObject[T any](data []byte) (T, error) {
+	var target T
+	if err := json.Unmarshal(data, &target); err != nil {
+		return target, fmt.Errorf("failed to parse json; %w", err)
+	}
+	return target, nil
+}
+
+func unmarshalList[T any](data []byte) (*[]T, error) {
+	target := make([]T, 0)
+	if err := json.Unmarshal(data, &target); err != nil {
+		return nil, fmt.Errorf("failed to parse json; %w", err)
+	}
+	return &target, nil
+}
@@ -0,0 +1,206 @@
+package instanaapi_test
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/config"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testResourcePath = "/api/test-objects"
+	testObjectPath   = testResourcePath + "/{id}"
+	testAPIToken     = "test-token"
+)
+
+type testObject struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (o *testObject) GetIDForResourcePath() string {
+	return o.ID
+}
+
+func newTestClientConfig(server testutils.TestHTTPServer) *config.ClientConfig {
+	clientConfig := config.DefaultClientConfig()
+	clientConfig.BaseURL = fmt.Sprintf("https://localhost:%d", server.GetPort())
+	clientConfig.APIToken = testAPIToken
+	clientConfig.UserAgent = "Terraform/test"
+	clientConfig.Headers.Custom = map[string]string{"X-Correlation-ID": "correlation-id"}
+	clientConfig.HTTPClient = &http.Client{
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}, //nolint:gosec
+	}
+	return clientConfig
+}
+
+func writeObjectFromRequest(t *testing.T, server testutils.TestHTTPServer, w http.ResponseWriter, r *http.Request, id string) {
+	body, err := io.ReadAll(r.Body)
+	require.NoError(t, err)
+	obj := testObject{}
+	require.NoError(t, json.Unmarshal(body, &obj))
+	if id != "" {
+		obj.ID = id
+	}
+	data, err := json.Marshal(obj)
+	require.NoError(t, err)
+	server.WriteJSONResponse(w, data)
+}
+
+func TestShouldCreateObjectViaPOSTAndUseIDFromResponse(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodPost, testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "apiToken "+testAPIToken, r.Header.Get("Authorization"))
+		assert.Equal(t, "Terraform/test", r.Header.Get("User-Agent"))
+		assert.Equal(t, "correlation-id", r.Header.Get("X-Correlation-ID"))
+		assert.Contains(t, r.Header.Get("Content-Type"), "application/json")
+		writeObjectFromRequest(t, server, w, r, "generated-id")
+	})
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewRestResource[*testObject](testResourcePath, instanaapi.CreateModePOST, instanaapi.UpdateModePUT, instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	result, err := sut.Create(&testObject{Name: "name"})
+
+	require.NoError(t, err)
+	assert.Equal(t, &testObject{ID: "generated-id", Name: "name"}, result)
+	assert.Equal(t, 1, server.GetCallCount(http.MethodPost, testResourcePath))
+}
+
+func TestShouldCreateObjectViaPUTWhenCreateModeIsPUT(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodPut, testObjectPath, func(w http.ResponseWriter, r *http.Request) {
+		writeObjectFromRequest(t, server, w, r, "")
+	})
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewRestResource[*testObject](testResourcePath, instanaapi.CreateModePUT, instanaapi.UpdateModePUT, instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	result, err := sut.Create(&testObject{ID: "client-id", Name: "name"})
+
+	require.NoError(t, err)
+	assert.Equal(t, &testObject{ID: "client-id", Name: "name"}, result)
+	assert.Equal(t, 1, server.GetCallCount(http.MethodPut, testResourcePath+"/client-id"))
+}
+
+func TestShouldUpdateObjectViaConfiguredUpdateMode(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodPut, testObjectPath, func(w http.ResponseWriter, r *http.Request) {
+		writeObjectFromRequest(t, server, w, r, mux.Vars(r)["id"])
+	})
+	server.AddRoute(http.MethodPost, testObjectPath, func(w http.ResponseWriter, r *http.Request) {
+		writeObjectFromRequest(t, server, w, r, mux.Vars(r)["id"])
+	})
+	server.Start()
+	defer server.Close()
+	restClient := instanaapi.NewRestClient(newTestClientConfig(server))
+
+	_, err := instanaapi.NewRestResource[*testObject](testResourcePath, instanaapi.CreateModePOST, instanaapi.UpdateModePUT, restClient).Update(&testObject{ID: "id-1", Name: "name"})
+	require.NoError(t, err)
+	_, err = instanaapi.NewRestResource[*testObject](testResourcePath, instanaapi.CreateModePOST, instanaapi.UpdateModePOST, restClient).Update(&testObject{ID: "id-2", Name: "name"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, server.GetCallCount(http.MethodPut, testResourcePath+"/id-1"))
+	assert.Equal(t, 1, server.GetCallCount(http.MethodPost, testResourcePath+"/id-2"))
+}
+
+func TestShouldGetAllAndSingleObjects(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodGet, testResourcePath, func(w http.ResponseWriter, _ *http.Request) {
+		server.WriteJSONResponse(w, []byte(`[{"id":"id-1","name":"one"},{"id":"id-2","name":"two"}]`))
+	})
+	server.AddRoute(http.MethodGet, testObjectPath, func(w http.ResponseWriter, r *http.Request) {
+		server.WriteJSONResponse(w, []byte(fmt.Sprintf(`{"id":"%s","name":"one"}`, mux.Vars(r)["id"])))
+	})
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewRestResource[*testObject](testResourcePath, instanaapi.CreateModePOST, instanaapi.UpdateModePUT, instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	all, err := sut.GetAll()
+	require.NoError(t, err)
+	require.Len(t, *all, 2)
+	assert.Equal(t, "id-2", (*all)[1].ID)
+
+	one, err := sut.GetOne("id-1")
+	require.NoError(t, err)
+	assert.Equal(t, &testObject{ID: "id-1", Name: "one"}, one)
+}
+
+func TestShouldReturnErrEntityNotFoundWhenAPIRespondsWith404(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodGet, testObjectPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewRestResource[*testObject](testResourcePath, instanaapi.CreateModePOST, instanaapi.UpdateModePUT, instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	_, err := sut.GetOne("unknown")
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, client.ErrEntityNotFound))
+}
+
+func TestShouldReturnErrorWithResponseWhenAPIFails(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodDelete, testObjectPath, func(w http.ResponseWriter, _ *http.Request) {
+		server.WriteInternalServerError(w, errors.New("backend failure"))
+	})
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewRestResource[*testObject](testResourcePath, instanaapi.CreateModePOST, instanaapi.UpdateModePUT, instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	err := sut.DeleteByID("id-1")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status code = 500")
+	assert.Contains(t, err.Error(), "backend failure")
+	assert.False(t, errors.Is(err, client.ErrEntityNotFound))
+}
+
+func TestShouldReturnErrorWhenResponseIsNotValidJSON(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodGet, testResourcePath, func(w http.ResponseWriter, _ *http.Request) {
+		server.WriteJSONResponse(w, []byte(`invalid`))
+	})
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewRestResource[*testObject](testResourcePath, instanaapi.CreateModePOST, instanaapi.UpdateModePUT, instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	_, err := sut.GetAll()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse json")
+}
+
+func TestShouldSendQueryParameters(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodGet, testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "value", r.URL.Query().Get("key"))
+		server.WriteJSONResponse(w, []byte(`[]`))
+	})
+	server.Start()
+	defer server.Close()
+
+	data, err := instanaapi.NewRestClient(newTestClientConfig(server)).GetByQuery(testResourcePath, map[string]string{"key": "value"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "[]", string(data))
+}
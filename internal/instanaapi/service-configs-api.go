@@ -0,0 +1,170 @@
+package instanaapi
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/shared/rest"
+)
+
+const (
+	// ServiceConfigsResourcePath path to the custom service configurations of the Instana API
+	ServiceConfigsResourcePath = "/api/application-monitoring/settings/service"
+	// ServiceConfigsOrderResourcePath path to the order of the custom service configurations of the Instana API
+	ServiceConfigsOrderResourcePath = ServiceConfigsResourcePath + "/order"
+)
+
+// ServiceMatchingRule a single key/value rule of a custom service configuration
+type ServiceMatchingRule struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ServiceConfig is the representation of a custom service configuration (service naming rule) in Instana
+type ServiceConfig struct {
+	ID                 string                `json:"id,omitempty"`
+	Name               string                `json:"name"`
+	Comment            *string               `json:"comment,omitempty"`
+	Label              string                `json:"label"`
+	Enabled            bool                  `json:"enabled"`
+	MatchSpecification []ServiceMatchingRule `json:"matchSpecification"`
+
+	// Position is the zero based position of the configuration in the ordered list of all custom service
+	// configurations. It is not part of the payload but maintained via the order endpoint. When nil on
+	// create or update the order is not changed.
+	Position *int `json:"-"`
+	// IsLast is true when the configuration is the last one in the ordered list of all custom service
+	// configurations. It is not part of the payload.
+	IsLast bool `json:"-"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *ServiceConfig) GetIDForResourcePath() string {
+	return c.ID
+}
+
+// serviceConfigOrderMutex serializes the read-modify-write cycles of the order of the custom service
+// configurations, as terraform creates and updates resources concurrently
+var serviceConfigOrderMutex sync.Mutex
+
+// NewServiceConfigRestResource creates the rest.RestResource for custom service configurations. In
+// addition to the plain CRUD operations the resource maintains the position of a configuration in
+// the ordered list of all custom service configurations.
+func NewServiceConfigRestResource(restClient RestClient) rest.RestResource[*ServiceConfig] {
+	return &serviceConfigRestResource{
+		delegate: NewRestResource[*ServiceConfig](ServiceConfigsResourcePath, CreateModePOST, UpdateModePUT, restClient),
+		client:   restClient,
+	}
+}
+
+type serviceConfigRestResource struct {
+	delegate rest.RestResource[*ServiceConfig]
+	client   RestClient
+}
+
+// GetAll returns all custom service configurations in their configured order including their position
+func (r *serviceConfigRestResource) GetAll() (*[]*ServiceConfig, error) {
+	configs, err := r.delegate.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	for i, config := range *configs {
+		position := i
+		config.Position = &position
+		config.IsLast = i == len(*configs)-1
+	}
+	return configs, nil
+}
+
+// GetOne returns the custom service configuration with the given ID including its position
+func (r *serviceConfigRestResource) GetOne(id string) (*ServiceConfig, error) {
+	configs, err := r.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range *configs {
+		if config.ID == id {
+			return config, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: service config with id %s", client.ErrEntityNotFound, id)
+}
+
+// Create creates the given custom service configuration and moves it to the requested position
+func (r *serviceConfigRestResource) Create(data *ServiceConfig) (*ServiceConfig, error) {
+	serviceConfigOrderMutex.Lock()
+	defer serviceConfigOrderMutex.Unlock()
+
+	created, err := r.delegate.Create(data)
+	if err != nil {
+		return nil, err
+	}
+	return r.applyPosition(created, data.Position)
+}
+
+// Update updates the given custom service configuration and moves it to the requested position
+func (r *serviceConfigRestResource) Update(data *ServiceConfig) (*ServiceConfig, error) {
+	serviceConfigOrderMutex.Lock()
+	defer serviceConfigOrderMutex.Unlock()
+
+	updated, err := r.delegate.Update(data)
+	if err != nil {
+		return nil, err
+	}
+	return r.applyPosition(updated, data.Position)
+}
+
+// Delete deletes the given custom service configuration
+func (r *serviceConfigRestResource) Delete(data *ServiceConfig) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+// DeleteByID deletes the custom service configuration with the given ID
+func (r *serviceConfigRestResource) DeleteByID(id string) error {
+	serviceConfigOrderMutex.Lock()
+	defer serviceConfigOrderMutex.Unlock()
+
+	return r.delegate.DeleteByID(id)
+}
+
+// applyPosition moves the given configuration to the requested position by sending the IDs of all
+// custom service configurations in the new order to the order endpoint. Positions beyond the end of the
+// list move the configuration to the end.
+func (r *serviceConfigRestResource) applyPosition(config *ServiceConfig, position *int) (*ServiceConfig, error) {
+	configs, err := r.delegate.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(*configs))
+	current := -1
+	for _, c := range *configs {
+		if c.ID == config.ID {
+			current = len(ids)
+			continue
+		}
+		ids = append(ids, c.ID)
+	}
+
+	if position == nil {
+		if current >= 0 {
+			config.Position = &current
+			config.IsLast = current == len(ids)
+		}
+		return config, nil
+	}
+
+	last := len(ids)
+	target := min(max(*position, 0), last)
+	if target != current {
+		ids = append(ids[:target], append([]string{config.ID}, ids[target:]...)...)
+		if _, err := r.client.Put(ServiceConfigsOrderResourcePath, ids); err != nil {
+			return nil, fmt.Errorf("failed to update order of service configs; %w", err)
+		}
+	}
+
+	config.Position = &target
+	config.IsLast = target == last
+	return config, nil
+}
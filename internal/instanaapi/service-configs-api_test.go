@@ -0,0 +1,230 @@
+package instanaapi_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const serviceConfigsJSON = `[{"id":"id-1","name":"one","label":"one","enabled":true,"matchSpecification":[]},{"id":"id-2","name":"two","label":"two","enabled":true,"matchSpecification":[]},{"id":"id-3","name":"three","label":"three","enabled":true,"matchSpecification":[]}]`
+
+func newServiceConfigTestServer(t *testing.T, order *[]string) testutils.TestHTTPServer {
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodGet, instanaapi.ServiceConfigsResourcePath, func(w http.ResponseWriter, _ *http.Request) {
+		server.WriteJSONResponse(w, []byte(serviceConfigsJSON))
+	})
+	server.AddRoute(http.MethodPut, instanaapi.ServiceConfigsOrderResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, order))
+		w.WriteHeader(http.StatusOK)
+	})
+	server.AddRoute(http.MethodPut, instanaapi.ServiceConfigsResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		server.WriteJSONResponse(w, body)
+	})
+	return server
+}
+
+// newOrderedServiceConfigTestServer creates a test server which keeps the order of the custom service configurations
+// sent to the order endpoint, so that subsequent reads return the configurations in the updated order
+func newOrderedServiceConfigTestServer(t *testing.T) testutils.TestHTTPServer {
+	var configs []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(serviceConfigsJSON), &configs))
+	var mutex sync.Mutex
+
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodGet, instanaapi.ServiceConfigsResourcePath, func(w http.ResponseWriter, _ *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		data, err := json.Marshal(configs)
+		require.NoError(t, err)
+		server.WriteJSONResponse(w, data)
+	})
+	server.AddRoute(http.MethodPut, instanaapi.ServiceConfigsOrderResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		var order []string
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, &order))
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		ordered := make([]map[string]any, 0, len(configs))
+		for _, id := range order {
+			for _, config := range configs {
+				if config["id"] == id {
+					ordered = append(ordered, config)
+				}
+			}
+		}
+		configs = ordered
+		w.WriteHeader(http.StatusOK)
+	})
+	server.AddRoute(http.MethodPut, instanaapi.ServiceConfigsResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		server.WriteJSONResponse(w, body)
+	})
+	return server
+}
+
+func TestShouldReadServiceConfigWithPosition(t *testing.T) {
+	server := newServiceConfigTestServer(t, &[]string{})
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewServiceConfigRestResource(instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	result, err := sut.GetOne("id-2")
+
+	require.NoError(t, err)
+	assert.Equal(t, "two", result.Name)
+	require.NotNil(t, result.Position)
+	assert.Equal(t, 1, *result.Position)
+	assert.False(t, result.IsLast)
+
+	result, err = sut.GetOne("id-3")
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, *result.Position)
+	assert.True(t, result.IsLast)
+
+	_, err = sut.GetOne("unknown")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, client.ErrEntityNotFound))
+}
+
+func TestShouldMoveServiceConfigToRequestedPositionOnUpdate(t *testing.T) {
+	order := make([]string, 0)
+	server := newServiceConfigTestServer(t, &order)
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewServiceConfigRestResource(instanaapi.NewRestClient(newTestClientConfig(server)))
+	position := 0
+
+	result, err := sut.Update(&instanaapi.ServiceConfig{ID: "id-3", Name: "three", Label: "three", Position: &position})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id-3", "id-1", "id-2"}, order)
+	require.NotNil(t, result.Position)
+	assert.Equal(t, 0, *result.Position)
+}
+
+func TestShouldMoveServiceConfigToTheEndWhenPositionExceedsNumberOfConfigs(t *testing.T) {
+	order := make([]string, 0)
+	server := newServiceConfigTestServer(t, &order)
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewServiceConfigRestResource(instanaapi.NewRestClient(newTestClientConfig(server)))
+	position := 10
+
+	result, err := sut.Update(&instanaapi.ServiceConfig{ID: "id-1", Name: "one", Label: "one", Position: &position})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id-2", "id-3", "id-1"}, order)
+	assert.Equal(t, 2, *result.Position)
+	assert.True(t, result.IsLast)
+}
+
+func TestShouldNotChangeOrderOfServiceConfigsWhenPositionIsNotSetOrUnchanged(t *testing.T) {
+	server := newServiceConfigTestServer(t, &[]string{})
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewServiceConfigRestResource(instanaapi.NewRestClient(newTestClientConfig(server)))
+	position := 1
+
+	result, err := sut.Update(&instanaapi.ServiceConfig{ID: "id-2", Name: "two", Label: "two"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, *result.Position)
+
+	_, err = sut.Update(&instanaapi.ServiceConfig{ID: "id-2", Name: "two", Label: "two", Position: &position})
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, server.GetCallCount(http.MethodPut, instanaapi.ServiceConfigsOrderResourcePath))
+}
+
+func TestShouldKeepDistinctPositionsOfTwoPositionedServiceConfigsWhenAppliedInOrder(t *testing.T) {
+	server := newOrderedServiceConfigTestServer(t)
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewServiceConfigRestResource(instanaapi.NewRestClient(newTestClientConfig(server)))
+	first := 0
+	second := 1
+	apply := func() {
+		_, err := sut.Update(&instanaapi.ServiceConfig{ID: "id-3", Name: "three", Label: "three", Position: &first})
+		require.NoError(t, err)
+		_, err = sut.Update(&instanaapi.ServiceConfig{ID: "id-2", Name: "two", Label: "two", Position: &second})
+		require.NoError(t, err)
+	}
+
+	apply()
+
+	three, err := sut.GetOne("id-3")
+	require.NoError(t, err)
+	assert.Equal(t, first, *three.Position)
+	two, err := sut.GetOne("id-2")
+	require.NoError(t, err)
+	assert.Equal(t, second, *two.Position)
+	assert.Equal(t, 2, server.GetCallCount(http.MethodPut, instanaapi.ServiceConfigsOrderResourcePath))
+
+	apply()
+
+	assert.Equal(t, 2, server.GetCallCount(http.MethodPut, instanaapi.ServiceConfigsOrderResourcePath))
+}
+
+func TestShouldShiftPositionedServiceConfigWhenAnotherServiceConfigIsMovedToTheSamePosition(t *testing.T) {
+	server := newOrderedServiceConfigTestServer(t)
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewServiceConfigRestResource(instanaapi.NewRestClient(newTestClientConfig(server)))
+	position := 0
+
+	for i := 0; i < 2; i++ {
+		_, err := sut.Update(&instanaapi.ServiceConfig{ID: "id-3", Name: "three", Label: "three", Position: &position})
+		require.NoError(t, err)
+		_, err = sut.Update(&instanaapi.ServiceConfig{ID: "id-2", Name: "two", Label: "two", Position: &position})
+		require.NoError(t, err)
+
+		three, err := sut.GetOne("id-3")
+		require.NoError(t, err)
+		assert.Equal(t, 1, *three.Position)
+	}
+	assert.Equal(t, 4, server.GetCallCount(http.MethodPut, instanaapi.ServiceConfigsOrderResourcePath))
+}
+
+func TestShouldResolveSingleManualServiceConfigFromList(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodGet, instanaapi.ManualServiceConfigsResourcePath, func(w http.ResponseWriter, _ *http.Request) {
+		server.WriteJSONResponse(w, []byte(`[{"id":"id-1","enabled":true,"unmonitoredServiceName":"db"},{"id":"id-2","enabled":false,"existingServiceId":"service-id"}]`))
+	})
+	server.Start()
+	defer server.Close()
+
+	sut := instanaapi.NewManualServiceConfigRestResource(instanaapi.NewRestClient(newTestClientConfig(server)))
+
+	result, err := sut.GetOne("id-2")
+
+	require.NoError(t, err)
+	assert.False(t, result.Enabled)
+	require.NotNil(t, result.ExistingServiceID)
+	assert.Equal(t, "service-id", *result.ExistingServiceID)
+
+	_, err = sut.GetOne("unknown")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, client.ErrEntityNotFound))
+}
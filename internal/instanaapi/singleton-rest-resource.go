@@ -0,0 +1,41 @@
+package instanaapi
+
+import (
+	"github.com/instana/instana-go-client/shared/rest"
+)
+
+// NewSingletonRestResource creates a new generic rest.SingletonRestResource for the given resource path. The
+// object is written via PUT on the resource path. As the Instana API does not consistently return the stored
+// object on PUT, the object is read again after it was written.
+func NewSingletonRestResource[T any](resourcePath string, restClient RestClient) rest.SingletonRestResource[T] {
+	return &singletonRestResourceImpl[T]{
+		resourcePath: resourcePath,
+		client:       restClient,
+	}
+}
+
+type singletonRestResourceImpl[T any] struct {
+	resourcePath string
+	client       RestClient
+}
+
+func (r *singletonRestResourceImpl[T]) Get() (T, error) {
+	data, err := r.client.Get(r.resourcePath)
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+	return unmarshalObject[T](data)
+}
+
+func (r *singletonRestResourceImpl[T]) Upsert(data T) (T, error) {
+	if _, err := r.client.Put(r.resourcePath, data); err != nil {
+		var empty T
+		return empty, err
+	}
+	return r.Get()
+}
+
+func (r *singletonRestResourceImpl[T]) Delete() error {
+	return r.client.Delete(r.resourcePath)
+}
@@ -0,0 +1,133 @@
+package instanaapi
+
+import (
+	"fmt"
+
+	tag "github.com/instana/instana-go-client/shared/tagfilter"
+	"github.com/instana/instana-go-client/shared/types"
+)
+
+// SyntheticCallsSettingsResourcePath path to the synthetic calls settings of the Instana API
+const SyntheticCallsSettingsResourcePath = "/api/settings/synthetic-calls"
+
+// MatchExpressionType the type of a node of a MatchExpression
+type MatchExpressionType string
+
+const (
+	// MatchExpressionTypeBinaryOperator type of the nodes combining two match expressions with a conjunction
+	MatchExpressionTypeBinaryOperator MatchExpressionType = "BINARY_OP"
+	// MatchExpressionTypeLeaf type of the nodes matching a single tag
+	MatchExpressionTypeLeaf MatchExpressionType = "LEAF"
+)
+
+// MatchExpression is the binary tree representation of a tag based match expression of the Instana API. Binary
+// operator nodes combine the left and right expression with the conjunction AND or OR, leaf nodes match a single
+// tag of the given entity.
+type MatchExpression struct {
+	Type        MatchExpressionType        `json:"type"`
+	Conjunction *types.LogicalOperatorType `json:"conjunction,omitempty"`
+	Left        *MatchExpression           `json:"left,omitempty"`
+	Right       *MatchExpression           `json:"right,omitempty"`
+	Entity      *tag.TagFilterEntity       `json:"entity,omitempty"`
+	Key         *string                    `json:"key,omitempty"`
+	Operator    *types.ExpressionOperator  `json:"operator,omitempty"`
+	Value       *string                    `json:"value,omitempty"`
+}
+
+// SyntheticCallRule a single rule to recognize synthetic calls
+type SyntheticCallRule struct {
+	Name               string           `json:"name"`
+	Description        *string          `json:"description,omitempty"`
+	Enabled            bool             `json:"enabled"`
+	MatchSpecification *MatchExpression `json:"matchSpecification"`
+}
+
+// SyntheticCallsSettings is the representation of the synthetic calls settings in Instana. The default rules are
+// provided by Instana and cannot be changed, they can only be enabled or disabled as a whole.
+type SyntheticCallsSettings struct {
+	CustomRules         []SyntheticCallRule `json:"customRules"`
+	DefaultRules        []SyntheticCallRule `json:"defaultRules,omitempty"`
+	DefaultRulesEnabled bool                `json:"defaultRulesEnabled"`
+}
+
+// NewMatchExpressionFromTagFilter converts the given tag filter expression into a MatchExpression. Logical
+// expressions with more than two elements are converted into a left-deep tree of binary operators.
+func NewMatchExpressionFromTagFilter(filter *tag.TagFilter) (*MatchExpression, error) {
+	if filter.GetType() == tag.TagFilterExpressionType {
+		if filter.LogicalOperator == nil || len(filter.Elements) == 0 {
+			return nil, fmt.Errorf("invalid logical expression without operator or elements")
+		}
+		result, err := NewMatchExpressionFromTagFilter(filter.Elements[0])
+		if err != nil {
+			return nil, err
+		}
+		for _, element := range filter.Elements[1:] {
+			right, err := NewMatchExpressionFromTagFilter(element)
+			if err != nil {
+				return nil, err
+			}
+			conjunction := *filter.LogicalOperator
+			result = &MatchExpression{Type: MatchExpressionTypeBinaryOperator, Conjunction: &conjunction, Left: result, Right: right}
+		}
+		return result, nil
+	}
+
+	if filter.Name == nil || filter.Entity == nil || filter.Operator == nil {
+		return nil, fmt.Errorf("invalid tag filter without tag name, entity or operator")
+	}
+	if filter.Key != nil {
+		return nil, fmt.Errorf("tag %s: tag keys are not supported by match expressions", *filter.Name)
+	}
+
+	leaf := &MatchExpression{Type: MatchExpressionTypeLeaf, Entity: filter.Entity, Key: filter.Name, Operator: filter.Operator}
+	if filter.StringValue != nil {
+		leaf.Value = filter.StringValue
+	} else if filter.NumberValue != nil {
+		value := fmt.Sprintf("%d", *filter.NumberValue)
+		leaf.Value = &value
+	} else if filter.BooleanValue != nil {
+		value := fmt.Sprintf("%t", *filter.BooleanValue)
+		leaf.Value = &value
+	}
+	return leaf, nil
+}
+
+// ToTagFilter converts the MatchExpression into a tag filter expression. Nested binary operators with the same
+// conjunction are flattened into a single logical expression.
+func (m *MatchExpression) ToTagFilter() (*tag.TagFilter, error) {
+	switch m.Type {
+	case MatchExpressionTypeLeaf:
+		if m.Entity == nil || m.Key == nil || m.Operator == nil {
+			return nil, fmt.Errorf("invalid match expression leaf without entity, key or operator")
+		}
+		if types.SupportedUnaryExpressionOperators.IsSupported(*m.Operator) {
+			return tag.NewUnaryTagFilter(*m.Entity, *m.Key, *m.Operator), nil
+		}
+		value := ""
+		if m.Value != nil {
+			value = *m.Value
+		}
+		return tag.NewStringTagFilter(*m.Entity, *m.Key, *m.Operator, value), nil
+	case MatchExpressionTypeBinaryOperator:
+		if m.Conjunction == nil || m.Left == nil || m.Right == nil {
+			return nil, fmt.Errorf("invalid binary match expression without conjunction, left or right expression")
+		}
+		elements := make([]*tag.TagFilter, 0)
+		for _, operand := range []*MatchExpression{m.Left, m.Right} {
+			element, err := operand.ToTagFilter()
+			if err != nil {
+				return nil, err
+			}
+			if element.GetType() == tag.TagFilterExpressionType && *element.LogicalOperator == *m.Conjunction {
+				elements = append(elements, element.Elements...)
+			} else {
+				elements = append(elements, element)
+			}
+		}
+		if *m.Conjunction == types.LogicalOr {
+			return tag.NewLogicalOrTagFilter(elements), nil
+		}
+		return tag.NewLogicalAndTagFilter(elements), nil
+	}
+	return nil, fmt.Errorf("unsupported match expression type %s", m.Type)
+}
@@ -0,0 +1,61 @@
+package instanaapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	tag "github.com/instana/instana-go-client/shared/tagfilter"
+	"github.com/instana/instana-go-client/shared/types"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const matchExpressionJSON = `{"type":"BINARY_OP","conjunction":"AND","left":{"type":"BINARY_OP","conjunction":"AND","left":{"type":"LEAF","entity":"DESTINATION","key":"call.http.path","operator":"EQUALS","value":"/health"},"right":{"type":"LEAF","entity":"NOT_APPLICABLE","key":"call.http.status","operator":"EQUALS","value":"200"}},"right":{"type":"LEAF","entity":"SOURCE","key":"call.http.header","operator":"IS_EMPTY"}}`
+
+func TestShouldConvertTagFilterIntoLeftDeepMatchExpression(t *testing.T) {
+	filter := tag.NewLogicalAndTagFilter([]*tag.TagFilter{
+		tag.NewStringTagFilter(tag.TagFilterEntityDestination, "call.http.path", types.EqualsOperator, "/health"),
+		tag.NewNumberTagFilter(tag.TagFilterEntityNotApplicable, "call.http.status", types.EqualsOperator, 200),
+		tag.NewUnaryTagFilter(tag.TagFilterEntitySource, "call.http.header", types.IsEmptyOperator),
+	})
+
+	result, err := instanaapi.NewMatchExpressionFromTagFilter(filter)
+
+	require.NoError(t, err)
+	serialized, err := json.Marshal(result)
+	require.NoError(t, err)
+	assert.JSONEq(t, matchExpressionJSON, string(serialized))
+}
+
+func TestShouldFailToConvertTagFilterWithTagKeyIntoMatchExpression(t *testing.T) {
+	filter := tag.NewTagTagFilter(tag.TagFilterEntityNotApplicable, "agent.tag", types.EqualsOperator, "key", "value")
+
+	_, err := instanaapi.NewMatchExpressionFromTagFilter(filter)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "tag keys are not supported")
+}
+
+func TestShouldConvertMatchExpressionIntoFlattenedTagFilter(t *testing.T) {
+	expression := instanaapi.MatchExpression{}
+	require.NoError(t, json.Unmarshal([]byte(matchExpressionJSON), &expression))
+
+	result, err := expression.ToTagFilter()
+
+	require.NoError(t, err)
+	assert.Equal(t, tag.NewLogicalAndTagFilter([]*tag.TagFilter{
+		tag.NewStringTagFilter(tag.TagFilterEntityDestination, "call.http.path", types.EqualsOperator, "/health"),
+		tag.NewStringTagFilter(tag.TagFilterEntityNotApplicable, "call.http.status", types.EqualsOperator, "200"),
+		tag.NewUnaryTagFilter(tag.TagFilterEntitySource, "call.http.header", types.IsEmptyOperator),
+	}), result)
+}
+
+func TestShouldFailToConvertMatchExpressionOfUnsupportedType(t *testing.T) {
+	expression := instanaapi.MatchExpression{Type: "UNKNOWN"}
+
+	_, err := expression.ToTagFilter()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported match expression type UNKNOWN")
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Environment variables of the API token sources
+const (
+	envAPIToken        = "INSTANA_API_TOKEN"
+	envAPITokenFile    = "INSTANA_API_TOKEN_FILE"
+	envAPITokenCommand = "INSTANA_API_TOKEN_COMMAND"
+)
+
+// apiTokenCommandTimeout the max execution time of the api_token_command
+const apiTokenCommandTimeout = 30 * time.Second
+
+// apiTokenPrecedence describes the precedence of the API token sources for diagnostics and documentation
+const apiTokenPrecedence = "The API token is taken from the first of the following sources which is set: " +
+	"the api_token, api_token_file or api_token_command attribute (only one of them may be configured), " +
+	"the INSTANA_API_TOKEN, INSTANA_API_TOKEN_FILE and INSTANA_API_TOKEN_COMMAND environment variables."
+
+// apiTokenSource a source of the API token
+type apiTokenSource struct {
+	attribute string
+	envVar    string
+	value     string
+	read      func(ctx context.Context, value string) (string, error)
+}
+
+// name returns the name of the source for diagnostics and logs
+func (s apiTokenSource) name() string {
+	if s.isEnvVar() {
+		return "environment variable " + s.envVar
+	}
+	return "attribute " + s.attribute
+}
+
+// isEnvVar returns true when the source is an environment variable
+func (s apiTokenSource) isEnvVar() bool {
+	return s.envVar != ""
+}
+
+// resolveAPIToken reads the API token from the first configured source according to apiTokenPrecedence. A warning is
+// reported when further sources of the configuration or further environment variables are set which are ignored.
+// Environment variables which are overridden by the configuration are ignored silently. The token is registered as sensitive value, so it is
+// redacted from all logs.
+func resolveAPIToken(ctx context.Context, providerConfig InstanaProviderModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var sources []apiTokenSource
+	for _, source := range []apiTokenSource{
+		{attribute: SchemaFieldAPIToken, value: providerConfig.APIToken.ValueString(), read: readPlainAPIToken},
+		{attribute: SchemaFieldAPITokenFile, value: providerConfig.APITokenFile.ValueString(), read: readAPITokenFile},
+		{attribute: SchemaFieldAPITokenCommand, value: providerConfig.APITokenCommand.ValueString(), read: readAPITokenFromCommand},
+		{attribute: SchemaFieldAPIToken, envVar: envAPIToken, value: os.Getenv(envAPIToken), read: readPlainAPIToken},
+		{attribute: SchemaFieldAPITokenFile, envVar: envAPITokenFile, value: os.Getenv(envAPITokenFile), read: readAPITokenFile},
+		{attribute: SchemaFieldAPITokenCommand, envVar: envAPITokenCommand, value: os.Getenv(envAPITokenCommand), read: readAPITokenFromCommand},
+	} {
+		if strings.TrimSpace(source.value) != "" {
+			sources = append(sources, source)
+		}
+	}
+
+	if len(sources) == 0 {
+		diags.AddAttributeError(
+			path.Root(SchemaFieldAPIToken),
+			"Missing Instana API Token",
+			"The provider cannot create the Instana API client as there is a missing or empty value for the Instana API token. "+
+				"Set one of the api_token, api_token_file or api_token_command values in the configuration or use the "+
+				"INSTANA_API_TOKEN, INSTANA_API_TOKEN_FILE or INSTANA_API_TOKEN_COMMAND environment variable. "+
+				"If either is already set, ensure the value is not empty. "+apiTokenPrecedence,
+		)
+		return "", diags
+	}
+
+	source := sources[0]
+	ignored := make([]string, 0, len(sources)-1)
+	for _, s := range sources[1:] {
+		if s.isEnvVar() == source.isEnvVar() {
+			ignored = append(ignored, s.name())
+		}
+	}
+	if len(ignored) > 0 {
+		diags.AddAttributeWarning(
+			path.Root(source.attribute),
+			"Multiple Instana API Token Sources",
+			fmt.Sprintf("The API token is read from the %s; the %s are ignored. %s", source.name(), strings.Join(ignored, ", "), apiTokenPrecedence),
+		)
+	}
+
+	token, err := source.read(ctx, strings.TrimSpace(source.value))
+	if err == nil && token == "" {
+		err = errors.New("the API token is empty")
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(source.attribute),
+			"Failed to read Instana API Token",
+			fmt.Sprintf("Failed to read the API token from the %s: %s", source.name(), err),
+		)
+		return "", diags
+	}
+
+	registerSensitiveValue(token)
+	return token, diags
+}
+
+func readPlainAPIToken(_ context.Context, value string) (string, error) {
+	return value, nil
+}
+
+// readAPITokenFile reads the API token from the given file. Leading and trailing whitespace is removed.
+func readAPITokenFile(_ context.Context, file string) (string, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read file %s; %w", file, err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// readAPITokenFromCommand executes the given command with the shell of the operating system and reads the API token
+// from the first line of stdout, like git credential helpers. Stderr is passed to the error message when the
+// command fails.
+func readAPITokenFromCommand(ctx context.Context, command string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, apiTokenCommandTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return "", fmt.Errorf("command %q did not finish within %s", command, apiTokenCommandTimeout)
+		}
+		return "", fmt.Errorf("command %q failed; %w; stderr = %s", command, err, sanitizeSensitiveData(strings.TrimSpace(stderr.String())))
+	}
+
+	token, _, _ := strings.Cut(stdout.String(), "\n")
+	return strings.TrimSpace(token), nil
+}
+
+// sensitiveValues the secrets which are known by the provider at runtime (e.g. the resolved API token). They are
+// redacted by sanitizeSensitiveData regardless of the context they appear in.
+var (
+	sensitiveValues      []string
+	sensitiveValuesMutex sync.RWMutex
+)
+
+func registerSensitiveValue(value string) {
+	if value == "" {
+		return
+	}
+	sensitiveValuesMutex.Lock()
+	defer sensitiveValuesMutex.Unlock()
+	for _, existing := range sensitiveValues {
+		if existing == value {
+			return
+		}
+	}
+	sensitiveValues = append(sensitiveValues, value)
+}
+
+func redactSensitiveValues(msg string) string {
+	sensitiveValuesMutex.RLock()
+	defer sensitiveValuesMutex.RUnlock()
+	for _, value := range sensitiveValues {
+		msg = strings.ReplaceAll(msg, value, "[REDACTED]")
+	}
+	return msg
+}
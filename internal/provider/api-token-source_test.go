@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func clearAPITokenEnv(t *testing.T) {
+	for _, envVar := range []string{envAPIToken, envAPITokenFile, envAPITokenCommand} {
+		t.Setenv(envVar, "")
+	}
+}
+
+func writeTokenFile(t *testing.T, content string) string {
+	file := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+	return file
+}
+
+func TestResolveAPIToken(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("commands are executed with sh in this test")
+	}
+	ctx := context.Background()
+
+	t.Run("should read token from attribute", func(t *testing.T) {
+		clearAPITokenEnv(t)
+
+		token, diags := resolveAPIToken(ctx, InstanaProviderModel{APIToken: types.StringValue(" attribute-token-4711 ")})
+
+		require.False(t, diags.HasError())
+		assert.Empty(t, diags.Warnings())
+		assert.Equal(t, "attribute-token-4711", token)
+	})
+
+	t.Run("should read token from file", func(t *testing.T) {
+		clearAPITokenEnv(t)
+		file := writeTokenFile(t, "\nfile-token-4711\n")
+
+		token, diags := resolveAPIToken(ctx, InstanaProviderModel{APITokenFile: types.StringValue(file)})
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, "file-token-4711", token)
+	})
+
+	t.Run("should read first line of command output", func(t *testing.T) {
+		clearAPITokenEnv(t)
+
+		token, diags := resolveAPIToken(ctx, InstanaProviderModel{APITokenCommand: types.StringValue("printf 'command-token-4711\\nignored'")})
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, "command-token-4711", token)
+	})
+
+	t.Run("should read token from environment variables", func(t *testing.T) {
+		clearAPITokenEnv(t)
+		t.Setenv(envAPITokenFile, writeTokenFile(t, "env-file-token-4711"))
+
+		token, diags := resolveAPIToken(ctx, InstanaProviderModel{})
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, "env-file-token-4711", token)
+	})
+
+	t.Run("should prefer configuration over environment variables without warning", func(t *testing.T) {
+		clearAPITokenEnv(t)
+		t.Setenv(envAPIToken, "env-token-4711")
+		t.Setenv(envAPITokenCommand, "echo env-command-token-4711")
+
+		token, diags := resolveAPIToken(ctx, InstanaProviderModel{APITokenFile: types.StringValue(writeTokenFile(t, "file-token-4712"))})
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, "file-token-4712", token)
+		assert.Empty(t, diags.Warnings())
+	})
+
+	t.Run("should warn about ignored sources of the configuration", func(t *testing.T) {
+		clearAPITokenEnv(t)
+		t.Setenv(envAPIToken, "env-token-4711")
+
+		token, diags := resolveAPIToken(ctx, InstanaProviderModel{
+			APIToken:        types.StringValue("attribute-token-4712"),
+			APITokenCommand: types.StringValue("echo command-token-4712"),
+		})
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, "attribute-token-4712", token)
+		require.Len(t, diags.Warnings(), 1)
+		assert.Equal(t, "Multiple Instana API Token Sources", diags.Warnings()[0].Summary())
+		assert.Contains(t, diags.Warnings()[0].Detail(), "read from the attribute api_token; the attribute api_token_command are ignored")
+	})
+
+	t.Run("should prefer INSTANA_API_TOKEN over other environment variables", func(t *testing.T) {
+		clearAPITokenEnv(t)
+		t.Setenv(envAPIToken, "env-token-4712")
+		t.Setenv(envAPITokenFile, "/does/not/exist")
+
+		token, diags := resolveAPIToken(ctx, InstanaProviderModel{})
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, "env-token-4712", token)
+		require.Len(t, diags.Warnings(), 1)
+		assert.Contains(t, diags.Warnings()[0].Detail(), "read from the environment variable INSTANA_API_TOKEN; the environment variable INSTANA_API_TOKEN_FILE are ignored")
+	})
+
+	t.Run("should fail when no source is set", func(t *testing.T) {
+		clearAPITokenEnv(t)
+
+		_, diags := resolveAPIToken(ctx, InstanaProviderModel{})
+
+		require.Equal(t, 1, diags.ErrorsCount())
+		assert.Equal(t, "Missing Instana API Token", diags.Errors()[0].Summary())
+		assert.Contains(t, diags.Errors()[0].Detail(), apiTokenPrecedence)
+	})
+
+	t.Run("should fail when file does not exist", func(t *testing.T) {
+		clearAPITokenEnv(t)
+
+		_, diags := resolveAPIToken(ctx, InstanaProviderModel{APITokenFile: types.StringValue("/does/not/exist")})
+
+		require.Equal(t, 1, diags.ErrorsCount())
+		assert.Contains(t, diags.Errors()[0].Detail(), "attribute api_token_file")
+	})
+
+	t.Run("should fail when command fails or prints no token", func(t *testing.T) {
+		clearAPITokenEnv(t)
+
+		_, diags := resolveAPIToken(ctx, InstanaProviderModel{APITokenCommand: types.StringValue("echo 'vault sealed' >&2; exit 3")})
+
+		require.Equal(t, 1, diags.ErrorsCount())
+		assert.Equal(t, "Failed to read Instana API Token", diags.Errors()[0].Summary())
+		assert.Contains(t, diags.Errors()[0].Detail(), "vault sealed")
+
+		_, diags = resolveAPIToken(ctx, InstanaProviderModel{APITokenCommand: types.StringValue("true")})
+
+		require.Equal(t, 1, diags.ErrorsCount())
+		assert.Contains(t, diags.Errors()[0].Detail(), "the API token is empty")
+	})
+}
+
+func TestShouldRedactResolvedAPITokenFromLogs(t *testing.T) {
+	clearAPITokenEnv(t)
+
+	token, diags := resolveAPIToken(context.Background(), InstanaProviderModel{APITokenFile: types.StringValue(writeTokenFile(t, "vault-token-0815"))})
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, "request failed for header value [REDACTED]", sanitizeSensitiveData("request failed for header value "+token))
+	assert.Equal(t, map[string]interface{}{"header": "[REDACTED]"}, sanitizeLogFields(map[string]interface{}{"header": token}))
+}
+
+func TestAPITokenAttributesShouldConflict(t *testing.T) {
+	resp := &provider.SchemaResponse{}
+	New("test")().Schema(context.Background(), provider.SchemaRequest{}, resp)
+
+	apiToken := resp.Schema.Attributes[SchemaFieldAPIToken].(schema.StringAttribute)
+	apiTokenFile := resp.Schema.Attributes[SchemaFieldAPITokenFile].(schema.StringAttribute)
+	assert.True(t, apiToken.Sensitive)
+	assert.Len(t, apiToken.Validators, 1)
+	assert.Len(t, apiTokenFile.Validators, 1)
+	assert.True(t, resp.Schema.Attributes[SchemaFieldAPITokenCommand].IsOptional())
+}
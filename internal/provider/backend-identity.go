@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+)
+
+// Environment variables of the expected backend identity
+const (
+	envExpectedTenant = "INSTANA_EXPECTED_TENANT"
+	envExpectedUnit   = "INSTANA_EXPECTED_UNIT"
+)
+
+// expectedBackendIdentity the tenant and unit the provider is expected to be connected to. Empty values are not checked.
+type expectedBackendIdentity struct {
+	Tenant string
+	Unit   string
+}
+
+// isSet returns true when at least one of the tenant or unit is expected
+func (e expectedBackendIdentity) isSet() bool {
+	return e.Tenant != "" || e.Unit != ""
+}
+
+// resolveExpectedBackendIdentity reads the expected tenant and unit from the provider configuration or the
+// environment variables
+func resolveExpectedBackendIdentity(providerConfig InstanaProviderModel) expectedBackendIdentity {
+	return expectedBackendIdentity{
+		Tenant: resolveString(providerConfig.ExpectedTenant, envExpectedTenant),
+		Unit:   resolveString(providerConfig.ExpectedUnit, envExpectedUnit),
+	}
+}
+
+// verifyBackendIdentity reads the backend information with the configured API token and compares the tenant and unit
+// of the backend with the expected ones, so a misconfigured endpoint fails before any resource is touched. The Instana
+// API does not expose the tenant and unit, so they are taken from the host name of the endpoint and the API token is
+// only verified by the authenticated version request. Endpoints not following the naming scheme produce a warning.
+func verifyBackendIdentity(reader instanaapi.BackendInfoReader, expected expectedBackendIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+	info, err := reader.Read()
+	if err != nil {
+		diags.AddError(
+			"Failed to verify Instana Backend Identity",
+			fmt.Sprintf("The provider could not read the version information of the Instana backend to verify the expected tenant and unit. "+
+				"Ensure the API token is valid for the tenant unit of the endpoint. Cause: %s", err),
+		)
+		return diags
+	}
+	checkBackendIdentityValue(&diags, SchemaFieldExpectedTenant, "tenant", expected.Tenant, info.Tenant, info.Endpoint)
+	checkBackendIdentityValue(&diags, SchemaFieldExpectedUnit, "unit", expected.Unit, info.Unit, info.Endpoint)
+	return diags
+}
+
+func checkBackendIdentityValue(diags *diag.Diagnostics, attribute string, kind string, expected string, actual string, endpoint string) {
+	if expected == "" || strings.EqualFold(expected, actual) {
+		return
+	}
+	if actual == "" {
+		diags.AddAttributeWarning(
+			path.Root(attribute),
+			"Unknown Instana Backend Identity",
+			fmt.Sprintf("The %s of the endpoint %s cannot be determined, so the expected %s %s is not verified. "+
+				"The Instana API does not provide the tenant and unit of the backend, so they are only determined from endpoints "+
+				"following the naming scheme <unit>-<tenant>.<domain>. The API token was accepted by the endpoint.", kind, endpoint, kind, expected),
+		)
+		return
+	}
+	diags.AddAttributeError(
+		path.Root(attribute),
+		"Unexpected Instana Backend",
+		fmt.Sprintf("The provider is configured for %s %s but the endpoint %s belongs to %s %s. "+
+			"Check the endpoint and API token of the provider configuration.", kind, expected, endpoint, kind, actual),
+	)
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBackendEndpoint = "http://prod-acme.instana.test"
+
+// newTestBackendProxy creates a proxy which answers the version requests of the given host name, so the tenant unit of
+// the host name can be verified without DNS resolution
+func newTestBackendProxy(t *testing.T, statusCode int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != instanaapi.InstanaVersionResourcePath || r.Host != "prod-acme.instana.test" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(`{"branch":"release-301","commit":"abc123","imageTag":"3.301.123-0"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func configureTestProviderWithExpectedIdentity(t *testing.T, proxyURL string, tenant string, unit string) []string {
+	t.Setenv(envExpectedTenant, "")
+	t.Setenv(envExpectedUnit, "")
+	resp := configureTestProviderWithAttributes(t, map[string]string{
+		SchemaFieldEndpoint:       testBackendEndpoint,
+		SchemaFieldProxyURL:       proxyURL,
+		SchemaFieldExpectedTenant: tenant,
+		SchemaFieldExpectedUnit:   unit,
+	})
+	summaries := make([]string, 0, resp.Diagnostics.ErrorsCount())
+	for _, d := range resp.Diagnostics.Errors() {
+		summaries = append(summaries, d.Summary())
+	}
+	return summaries
+}
+
+func TestShouldConfigureProviderWhenBackendIdentityMatches(t *testing.T) {
+	proxy := newTestBackendProxy(t, http.StatusOK)
+
+	assert.Empty(t, configureTestProviderWithExpectedIdentity(t, proxy.URL, "ACME", "prod"))
+	assert.Empty(t, configureTestProviderWithExpectedIdentity(t, proxy.URL, "acme", ""))
+}
+
+func TestShouldFailToConfigureProviderWhenBackendIdentityDiffers(t *testing.T) {
+	proxy := newTestBackendProxy(t, http.StatusOK)
+
+	errors := configureTestProviderWithExpectedIdentity(t, proxy.URL, "acme", "test")
+
+	assert.Equal(t, []string{"Unexpected Instana Backend"}, errors)
+}
+
+func TestShouldFailToConfigureProviderWhenAPITokenIsRejectedByBackend(t *testing.T) {
+	proxy := newTestBackendProxy(t, http.StatusUnauthorized)
+
+	errors := configureTestProviderWithExpectedIdentity(t, proxy.URL, "acme", "prod")
+
+	assert.Equal(t, []string{"Failed to verify Instana Backend Identity"}, errors)
+}
+
+func TestShouldWarnWhenBackendIdentityIsUnknown(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"imageTag":"3.301.123-0"}`))
+	}))
+	defer server.Close()
+	t.Setenv(envExpectedTenant, "acme")
+
+	resp := configureTestProvider(t, server.URL)
+
+	require.False(t, resp.Diagnostics.HasError())
+	require.Equal(t, 1, resp.Diagnostics.WarningsCount())
+	assert.Equal(t, "Unknown Instana Backend Identity", resp.Diagnostics.Warnings()[0].Summary())
+}
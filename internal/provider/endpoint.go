@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// normalizeEndpoint converts the configured endpoint into the base URL of the Instana API. Bare host names (with
+// optional port and path) are called via HTTPS; full URLs are used as they are without trailing slash.
+//
+// Examples:
+//
+//	normalizeEndpoint("tenant-org.instana.io") → "https://tenant-org.instana.io"
+//	normalizeEndpoint("http://localhost:8080/instana/") → "http://localhost:8080/instana"
+func normalizeEndpoint(endpoint string) (string, error) {
+	endpoint = strings.TrimSpace(endpoint)
+	if endpoint == "" {
+		return "", fmt.Errorf("the endpoint must not be empty")
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("the endpoint %s is not a valid URL; %w", endpoint, err)
+	}
+	if endpointURL.Scheme != "https" && endpointURL.Scheme != "http" {
+		return "", fmt.Errorf("the endpoint %s uses the unsupported scheme %s; supported schemes are https and http", endpoint, endpointURL.Scheme)
+	}
+	if endpointURL.Hostname() == "" {
+		return "", fmt.Errorf("the endpoint %s has no host name", endpoint)
+	}
+	if port := endpointURL.Port(); port == "" && strings.HasSuffix(endpointURL.Host, ":") {
+		return "", fmt.Errorf("the endpoint %s has an empty port", endpoint)
+	}
+	if endpointURL.User != nil {
+		return "", fmt.Errorf("the endpoint %s must not contain credentials; use api_token instead", endpoint)
+	}
+	if endpointURL.RawQuery != "" || endpointURL.Fragment != "" {
+		return "", fmt.Errorf("the endpoint %s must not contain a query or fragment", endpoint)
+	}
+
+	endpointURL.Path = strings.TrimRight(endpointURL.Path, "/")
+	endpointURL.RawPath = ""
+	return endpointURL.String(), nil
+}
+
+// endpointValidator validates the endpoint of the provider configuration at plan time
+type endpointValidator struct{}
+
+// Description implementation of validator.String
+func (v endpointValidator) Description(_ context.Context) string {
+	return "value must be a host name or an http(s) URL with optional port and path"
+}
+
+// MarkdownDescription implementation of validator.String
+func (v endpointValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implementation of validator.String. Empty values are accepted as the endpoint can also be set via
+// the environment variable INSTANA_ENDPOINT.
+func (v endpointValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || strings.TrimSpace(req.ConfigValue.ValueString()) == "" {
+		return
+	}
+	if _, err := normalizeEndpoint(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Instana Endpoint", err.Error())
+	}
+}
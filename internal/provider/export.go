@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/instana/terraform-provider-instana/internal/exporter"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+)
+
+// ExportResourceTypes returns the resource types of the provider which support the export of all objects of a tenant
+// unit
+func ExportResourceTypes() []exporter.ResourceType {
+	var resourceTypes []exporter.ResourceType
+	for _, registration := range resourceHandleRegistrations() {
+		if registration.exportResourceType != nil {
+			resourceTypes = append(resourceTypes, registration.exportResourceType())
+		}
+	}
+	return resourceTypes
+}
+
+// ConfigureFromEnvironment configures the provider without terraform configuration so that all settings are taken
+// from the environment variables (INSTANA_ENDPOINT, INSTANA_API_TOKEN, ...). This allows tools like the export command
+// to use the same Instana API client as the provider.
+func ConfigureFromEnvironment(ctx context.Context, version string) (*shared.ProviderMeta, diag.Diagnostics) {
+	p := &InstanaProvider{version: version}
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		return nil, schemaResp.Diagnostics
+	}
+
+	configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(configType.AttributeTypes))
+	for name, attributeType := range configType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(configType, values)},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		return nil, resp.Diagnostics
+	}
+	return resp.ResourceData.(*shared.ProviderMeta), resp.Diagnostics
+}
@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/config"
+)
+
+// Environment variables of the HTTP client settings
+const (
+	envMaxRetries            = "INSTANA_MAX_RETRIES"
+	envRetryMinBackoff       = "INSTANA_RETRY_MIN_BACKOFF"
+	envRetryMaxBackoff       = "INSTANA_RETRY_MAX_BACKOFF"
+	envRespectRetryAfter     = "INSTANA_RESPECT_RETRY_AFTER"
+	envRequestTimeout        = "INSTANA_REQUEST_TIMEOUT"
+	envMaxConcurrentRequests = "INSTANA_MAX_CONCURRENT_REQUESTS"
+	envCACertificate         = "INSTANA_CA_CERTIFICATE"
+	envClientCertificate     = "INSTANA_CLIENT_CERTIFICATE"
+	envClientKey             = "INSTANA_CLIENT_KEY"
+	envProxyURL              = "INSTANA_PROXY_URL"
+)
+
+// pemBlockPrefix the prefix of PEM encoded data which distinguishes PEM content from file paths
+const pemBlockPrefix = "-----BEGIN"
+
+// Default values of the HTTP client settings
+const (
+	defaultMaxRetries        = 3
+	defaultRetryMinBackoff   = 1 * time.Second
+	defaultRetryMaxBackoff   = 30 * time.Second
+	defaultRespectRetryAfter = true
+)
+
+// httpClientSettings the settings of the HTTP client used for all calls to the Instana API
+type httpClientSettings struct {
+	TLSSkipVerify         bool
+	MaxRetries            int
+	RetryMinBackoff       time.Duration
+	RetryMaxBackoff       time.Duration
+	RespectRetryAfter     bool
+	RequestTimeout        time.Duration
+	MaxConcurrentRequests int
+	CACertificate         string
+	ClientCertificate     string
+	ClientKey             string
+	ProxyURL              string
+}
+
+// resolveHTTPClientSettings resolves the HTTP client settings from the provider configuration. Attributes which are
+// not set in the configuration default to the corresponding environment variable and then to the default value.
+func resolveHTTPClientSettings(providerConfig InstanaProviderModel, clientConfig *config.ClientConfig) (*httpClientSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	settings := &httpClientSettings{
+		TLSSkipVerify:         !providerConfig.TLSSkipVerify.IsNull() && providerConfig.TLSSkipVerify.ValueBool(),
+		MaxRetries:            resolveInt(providerConfig.MaxRetries, SchemaFieldMaxRetries, envMaxRetries, defaultMaxRetries, &diags),
+		RetryMinBackoff:       resolveDuration(providerConfig.RetryMinBackoff, SchemaFieldRetryMinBackoff, envRetryMinBackoff, defaultRetryMinBackoff, &diags),
+		RetryMaxBackoff:       resolveDuration(providerConfig.RetryMaxBackoff, SchemaFieldRetryMaxBackoff, envRetryMaxBackoff, defaultRetryMaxBackoff, &diags),
+		RespectRetryAfter:     resolveBool(providerConfig.RespectRetryAfter, SchemaFieldRespectRetryAfter, envRespectRetryAfter, defaultRespectRetryAfter, &diags),
+		RequestTimeout:        resolveDuration(providerConfig.RequestTimeout, SchemaFieldRequestTimeout, envRequestTimeout, clientConfig.Timeout.Request, &diags),
+		MaxConcurrentRequests: resolveInt(providerConfig.MaxConcurrentRequests, SchemaFieldMaxConcurrentRequests, envMaxConcurrentRequests, 0, &diags),
+		CACertificate:         resolveString(providerConfig.CACertificate, envCACertificate),
+		ClientCertificate:     resolveString(providerConfig.ClientCertificate, envClientCertificate),
+		ClientKey:             resolveString(providerConfig.ClientKey, envClientKey),
+		ProxyURL:              resolveString(providerConfig.ProxyURL, envProxyURL),
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if settings.RetryMinBackoff > settings.RetryMaxBackoff {
+		diags.AddAttributeError(
+			path.Root(SchemaFieldRetryMinBackoff),
+			"Invalid retry backoff",
+			fmt.Sprintf("%s (%s) must not be greater than %s (%s)", SchemaFieldRetryMinBackoff, settings.RetryMinBackoff, SchemaFieldRetryMaxBackoff, settings.RetryMaxBackoff),
+		)
+	}
+	if (settings.ClientCertificate == "") != (settings.ClientKey == "") {
+		diags.AddAttributeError(
+			path.Root(SchemaFieldClientCertificate),
+			"Incomplete client certificate",
+			fmt.Sprintf("%s and %s must be configured together to authenticate with a client certificate", SchemaFieldClientCertificate, SchemaFieldClientKey),
+		)
+	}
+	return settings, diags
+}
+
+func resolveString(value types.String, envVar string) string {
+	if result := strings.TrimSpace(value.ValueString()); result != "" {
+		return result
+	}
+	return strings.TrimSpace(os.Getenv(envVar))
+}
+
+func resolveInt(value types.Int64, attribute string, envVar string, defaultValue int, diags *diag.Diagnostics) int {
+	if !value.IsNull() && !value.IsUnknown() {
+		return validateNotNegative(int(value.ValueInt64()), attribute, diags)
+	}
+	envValue := strings.TrimSpace(os.Getenv(envVar))
+	if envValue == "" {
+		return defaultValue
+	}
+	result, err := strconv.Atoi(envValue)
+	if err != nil {
+		addInvalidEnvValueError(attribute, envVar, envValue, "an integer", diags)
+		return defaultValue
+	}
+	return validateNotNegative(result, attribute, diags)
+}
+
+func resolveDuration(value types.String, attribute string, envVar string, defaultValue time.Duration, diags *diag.Diagnostics) time.Duration {
+	rawValue, source := strings.TrimSpace(value.ValueString()), "the configuration"
+	if rawValue == "" {
+		rawValue, source = strings.TrimSpace(os.Getenv(envVar)), "the environment variable "+envVar
+	}
+	if rawValue == "" {
+		return defaultValue
+	}
+	result, err := time.ParseDuration(rawValue)
+	if err != nil || result < 0 {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid duration",
+			fmt.Sprintf("The value %q of %s in %s is not a valid non-negative duration (e.g. 500ms, 10s, 1m)", rawValue, attribute, source),
+		)
+		return defaultValue
+	}
+	return result
+}
+
+func resolveBool(value types.Bool, attribute string, envVar string, defaultValue bool, diags *diag.Diagnostics) bool {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueBool()
+	}
+	envValue := strings.TrimSpace(os.Getenv(envVar))
+	if envValue == "" {
+		return defaultValue
+	}
+	result, err := strconv.ParseBool(envValue)
+	if err != nil {
+		addInvalidEnvValueError(attribute, envVar, envValue, "a boolean", diags)
+		return defaultValue
+	}
+	return result
+}
+
+func validateNotNegative(value int, attribute string, diags *diag.Diagnostics) int {
+	if value < 0 {
+		diags.AddAttributeError(path.Root(attribute), "Invalid value", fmt.Sprintf("%s must not be negative", attribute))
+	}
+	return value
+}
+
+func addInvalidEnvValueError(attribute string, envVar string, envValue string, expected string, diags *diag.Diagnostics) {
+	diags.AddAttributeError(
+		path.Root(attribute),
+		"Invalid environment variable",
+		fmt.Sprintf("The value %q of the environment variable %s is not %s", envValue, envVar, expected),
+	)
+}
+
+// newHTTPClient creates the HTTP client for the Instana API with the given settings. The timeout is applied per
+// attempt by the retry transport, so the client itself has no overall timeout.
+func newHTTPClient(clientConfig *config.ClientConfig, settings *httpClientSettings) (*http.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	transport := &http.Transport{
+		MaxIdleConns:        clientConfig.ConnectionPool.MaxIdleConnections,
+		MaxIdleConnsPerHost: clientConfig.ConnectionPool.MaxConnectionsPerHost,
+		IdleConnTimeout:     clientConfig.Timeout.IdleConnection,
+		DisableKeepAlives:   false,
+	}
+
+	tlsConfig, tlsDiags := newTLSConfig(settings)
+	diags.Append(tlsDiags...)
+	transport.TLSClientConfig = tlsConfig
+
+	if settings.ProxyURL != "" {
+		proxyURL, err := parseProxyURL(settings.ProxyURL)
+		if err != nil {
+			diags.AddAttributeError(path.Root(SchemaFieldProxyURL), "Invalid proxy URL", err.Error())
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &http.Client{
+		Transport: newRetryTransport(transport, settings),
+	}, diags
+}
+
+// newTLSConfig creates the TLS configuration for the Instana API. Custom CA certificates are trusted in addition to
+// the CA certificates of the system.
+func newTLSConfig(settings *httpClientSettings) (*tls.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: settings.TLSSkipVerify, //nolint:gosec
+	}
+
+	if settings.CACertificate != "" {
+		caCertificate, err := readPEM(settings.CACertificate)
+		if err == nil {
+			tlsConfig.RootCAs, err = newCertPool(caCertificate)
+		}
+		if err != nil {
+			diags.AddAttributeError(path.Root(SchemaFieldCACertificate), "Invalid CA certificate", err.Error())
+		}
+	}
+
+	if settings.ClientCertificate != "" && settings.ClientKey != "" {
+		clientCertificate, err := readPEM(settings.ClientCertificate)
+		if err != nil {
+			diags.AddAttributeError(path.Root(SchemaFieldClientCertificate), "Invalid client certificate", err.Error())
+		}
+		clientKey, err := readPEM(settings.ClientKey)
+		if err != nil {
+			diags.AddAttributeError(path.Root(SchemaFieldClientKey), "Invalid client key", err.Error())
+		}
+		if diags.HasError() {
+			return nil, diags
+		}
+		certificate, err := tls.X509KeyPair(clientCertificate, clientKey)
+		if err != nil {
+			diags.AddAttributeError(path.Root(SchemaFieldClientCertificate), "Invalid client certificate", fmt.Sprintf("failed to load client certificate and key; %s", err))
+			return nil, diags
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsConfig, diags
+}
+
+// readPEM returns the given value when it is PEM encoded; otherwise the value is the path of the PEM file to read
+func readPEM(value string) ([]byte, error) {
+	if strings.HasPrefix(value, pemBlockPrefix) {
+		return []byte(value), nil
+	}
+	data, err := os.ReadFile(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read PEM file %s; %w", value, err)
+	}
+	return data, nil
+}
+
+func newCertPool(caCertificate []byte) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(caCertificate) {
+		return nil, fmt.Errorf("no valid PEM encoded certificate found")
+	}
+	return pool, nil
+}
+
+func parseProxyURL(value string) (*url.URL, error) {
+	proxyURL, err := url.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse proxy URL %s; %w", value, err)
+	}
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("unsupported scheme of proxy URL %s; supported schemes are http, https and socks5", value)
+	}
+	if proxyURL.Host == "" {
+		return nil, fmt.Errorf("proxy URL %s has no host", value)
+	}
+	return proxyURL, nil
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/config"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveHTTPClientSettings(t *testing.T) {
+	clientConfig := config.DefaultClientConfig()
+	clientConfig.Timeout.Request = 45 * time.Second
+
+	t.Run("should use defaults", func(t *testing.T) {
+		settings, diags := resolveHTTPClientSettings(InstanaProviderModel{}, clientConfig)
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, &httpClientSettings{
+			MaxRetries:        defaultMaxRetries,
+			RetryMinBackoff:   defaultRetryMinBackoff,
+			RetryMaxBackoff:   defaultRetryMaxBackoff,
+			RespectRetryAfter: defaultRespectRetryAfter,
+			RequestTimeout:    45 * time.Second,
+		}, settings)
+	})
+
+	t.Run("should use environment variables", func(t *testing.T) {
+		t.Setenv(envMaxRetries, "5")
+		t.Setenv(envRetryMinBackoff, "200ms")
+		t.Setenv(envRetryMaxBackoff, "1m")
+		t.Setenv(envRespectRetryAfter, "false")
+		t.Setenv(envRequestTimeout, "2m")
+		t.Setenv(envMaxConcurrentRequests, "4")
+
+		settings, diags := resolveHTTPClientSettings(InstanaProviderModel{}, clientConfig)
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, &httpClientSettings{
+			MaxRetries:            5,
+			RetryMinBackoff:       200 * time.Millisecond,
+			RetryMaxBackoff:       time.Minute,
+			RespectRetryAfter:     false,
+			RequestTimeout:        2 * time.Minute,
+			MaxConcurrentRequests: 4,
+		}, settings)
+	})
+
+	t.Run("should prefer configuration over environment variables", func(t *testing.T) {
+		t.Setenv(envMaxRetries, "5")
+		t.Setenv(envRetryMinBackoff, "200ms")
+
+		settings, diags := resolveHTTPClientSettings(newTestProviderModel(func(m *InstanaProviderModel) {
+			m.MaxRetries = types.Int64Value(1)
+			m.RetryMinBackoff = types.StringValue("2s")
+		}), clientConfig)
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, 1, settings.MaxRetries)
+		assert.Equal(t, 2*time.Second, settings.RetryMinBackoff)
+	})
+
+	t.Run("should fail for invalid values", func(t *testing.T) {
+		t.Setenv(envMaxConcurrentRequests, "many")
+
+		_, diags := resolveHTTPClientSettings(newTestProviderModel(func(m *InstanaProviderModel) {
+			m.RequestTimeout = types.StringValue("30")
+		}), clientConfig)
+
+		require.Equal(t, 2, diags.ErrorsCount())
+		assert.Contains(t, diags.Errors()[0].Detail(), SchemaFieldRequestTimeout)
+		assert.Contains(t, diags.Errors()[1].Detail(), envMaxConcurrentRequests)
+	})
+
+	t.Run("should fail when min backoff is greater than max backoff", func(t *testing.T) {
+		_, diags := resolveHTTPClientSettings(newTestProviderModel(func(m *InstanaProviderModel) {
+			m.RetryMinBackoff = types.StringValue("1m")
+			m.RetryMaxBackoff = types.StringValue("10s")
+		}), clientConfig)
+
+		require.Equal(t, 1, diags.ErrorsCount())
+		assert.Equal(t, "Invalid retry backoff", diags.Errors()[0].Summary())
+	})
+}
+
+func newTestProviderModel(modifier func(m *InstanaProviderModel)) InstanaProviderModel {
+	model := InstanaProviderModel{}
+	modifier(&model)
+	return model
+}
+
+func testServerCertificatePEM(server *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+}
+
+func testClientCertificatePaths(t *testing.T) (string, string) {
+	rootFolder, err := testutils.GetRootFolder()
+	require.NoError(t, err)
+	return filepath.Join(rootFolder, "testutils", "test-server.pem"), filepath.Join(rootFolder, "testutils", "test-server.key")
+}
+
+func getWithSettings(t *testing.T, baseURL string, settings *httpClientSettings) ([]byte, error) {
+	clientConfig := config.DefaultClientConfig()
+	clientConfig.BaseURL = baseURL
+	httpClient, diags := newHTTPClient(clientConfig, settings)
+	require.False(t, diags.HasError(), "%v", diags)
+	clientConfig.HTTPClient = httpClient
+	return instanaapi.NewRestClient(clientConfig).Get("/api/test")
+}
+
+func newTestTLSServer() *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+}
+
+func TestShouldTrustCustomCACertificate(t *testing.T) {
+	server := newTestTLSServer()
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte(testServerCertificatePEM(server)), 0o600))
+
+	_, err := getWithSettings(t, server.URL, &httpClientSettings{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "certificate")
+
+	_, err = getWithSettings(t, server.URL, &httpClientSettings{CACertificate: caFile})
+	require.NoError(t, err)
+
+	_, err = getWithSettings(t, server.URL, &httpClientSettings{CACertificate: testServerCertificatePEM(server)})
+	require.NoError(t, err)
+}
+
+func TestShouldAuthenticateWithClientCertificate(t *testing.T) {
+	certFile, keyFile := testClientCertificatePaths(t)
+	clientCA, err := os.ReadFile(certFile)
+	require.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM(clientCA))
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"client":%q}`, r.TLS.PeerCertificates[0].Subject.CommonName)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+
+	_, err = getWithSettings(t, server.URL, &httpClientSettings{CACertificate: testServerCertificatePEM(server)})
+	require.Error(t, err)
+
+	response, err := getWithSettings(t, server.URL, &httpClientSettings{
+		CACertificate:     testServerCertificatePEM(server),
+		ClientCertificate: certFile,
+		ClientKey:         keyFile,
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"client":"test-server"}`, string(response))
+}
+
+func TestShouldSendRequestsViaProxy(t *testing.T) {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"host":%q,"path":%q}`, r.Host, r.URL.Path)
+	}))
+	defer proxy.Close()
+
+	response, err := getWithSettings(t, "http://instana.example.com", &httpClientSettings{ProxyURL: proxy.URL})
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"host":"instana.example.com","path":"/api/test"}`, string(response))
+}
+
+func TestShouldFailForInvalidTLSAndProxySettings(t *testing.T) {
+	certFile, _ := testClientCertificatePaths(t)
+	tests := []struct {
+		name      string
+		settings  httpClientSettings
+		attribute string
+	}{
+		{name: "missing CA file", settings: httpClientSettings{CACertificate: "/does/not/exist.pem"}, attribute: SchemaFieldCACertificate},
+		{name: "CA without certificate", settings: httpClientSettings{CACertificate: "-----BEGIN CERTIFICATE-----\ninvalid\n-----END CERTIFICATE-----"}, attribute: SchemaFieldCACertificate},
+		{name: "missing client key file", settings: httpClientSettings{ClientCertificate: certFile, ClientKey: "/does/not/exist.key"}, attribute: SchemaFieldClientKey},
+		{name: "key not matching certificate", settings: httpClientSettings{ClientCertificate: certFile, ClientKey: certFile}, attribute: SchemaFieldClientCertificate},
+		{name: "unsupported proxy scheme", settings: httpClientSettings{ProxyURL: "ftp://proxy.example.com"}, attribute: SchemaFieldProxyURL},
+		{name: "proxy without host", settings: httpClientSettings{ProxyURL: "http://"}, attribute: SchemaFieldProxyURL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, diags := newHTTPClient(config.DefaultClientConfig(), &tt.settings)
+
+			require.Equal(t, 1, diags.ErrorsCount())
+			assert.Equal(t, path.Root(tt.attribute), diags.Errors()[0].(diag.DiagnosticWithPath).Path())
+		})
+	}
+}
+
+func TestShouldFailWhenClientCertificateIsConfiguredWithoutKey(t *testing.T) {
+	_, diags := resolveHTTPClientSettings(newTestProviderModel(func(m *InstanaProviderModel) {
+		m.ClientCertificate = types.StringValue("/path/to/cert.pem")
+	}), config.DefaultClientConfig())
+
+	require.Equal(t, 1, diags.ErrorsCount())
+	assert.Equal(t, "Incomplete client certificate", diags.Errors()[0].Summary())
+}
@@ -0,0 +1,1586 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+)
+
+const (
+	testAccWebsiteID     = "website-id"
+	testAccMobileAppID   = "mobile-app-id"
+	testAccBuiltinID     = "builtin-event-id"
+	testAccAlertConfigID = "alert-config-id"
+
+	// testAccSyntheticCredentialsResourcePath the path of the synthetic credentials which are identified by their name
+	testAccSyntheticCredentialsResourcePath = "/api/synthetics/settings/credentials"
+)
+
+// testAccAlertingChannelConfig the configuration of an alerting channel which is referenced by the alert configurations
+const testAccAlertingChannelConfig = `
+resource "instana_alerting_channel" "channel" {
+  name = "email"
+  email = {
+    emails = ["sre@example.com"]
+  }
+}
+`
+
+// acceptanceTestCases returns the acceptance test cases of all resources of the provider by resource type
+func acceptanceTestCases() map[string]acceptanceTestCase {
+	return map[string]acceptanceTestCase{
+		"instana_alert_config_version_pin": {
+			fixtures: func(backend testutils.FakeInstanaBackend) {
+				alertConfigPath := instanaapi.ApplicationAlertConfigsResourcePath + "/" + testAccAlertConfigID
+				backend.SetDocument(alertConfigPath, map[string]any{"id": testAccAlertConfigID, "name": "alert"})
+				backend.SetDocument(alertConfigPath+"/versions", []map[string]any{
+					{"id": testAccAlertConfigID, "created": 1700000003000},
+					{"id": testAccAlertConfigID, "created": 1700000002000},
+					{"id": testAccAlertConfigID, "created": 1700000001000},
+				})
+			},
+			config: `
+resource "instana_alert_config_version_pin" "test" {
+  alert_config_type = "application"
+  alert_config_id   = "alert-config-id"
+  version           = 1700000001000
+}
+`,
+			checks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_alert_config_version_pin.test", "id", "application/alert-config-id"),
+				resource.TestCheckResourceAttr("instana_alert_config_version_pin.test", "restored_version", "1700000003000"),
+			},
+			updatedConfig: `
+resource "instana_alert_config_version_pin" "test" {
+  alert_config_type = "application"
+  alert_config_id   = "alert-config-id"
+  version           = 1700000002000
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_alert_config_version_pin.test", "version", "1700000002000"),
+			},
+			importStateVerifyIgnore: []string{"version"},
+		},
+		"instana_alerting_channel": {
+			config: `
+resource "instana_alerting_channel" "test" {
+  name = "email"
+  email = {
+    emails = ["sre@example.com"]
+  }
+}
+`,
+			checks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_alerting_channel.test", "email.emails.#", "1"),
+			},
+			updatedConfig: `
+resource "instana_alerting_channel" "test" {
+  name = "email-updated"
+  email = {
+    emails = ["sre@example.com", "oncall@example.com"]
+  }
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_alerting_channel.test", "email.emails.#", "2"),
+			},
+		},
+		"instana_alerting_config": {
+			config: testAccAlertingChannelConfig + `
+resource "instana_alerting_config" "test" {
+  alert_name               = "alerting"
+  integration_ids          = [instana_alerting_channel.channel.id]
+  event_filter_query       = "entity.type:host"
+  event_filter_event_types = ["incident", "critical"]
+}
+`,
+			updatedConfig: testAccAlertingChannelConfig + `
+resource "instana_alerting_config" "test" {
+  alert_name               = "alerting"
+  integration_ids          = [instana_alerting_channel.channel.id]
+  event_filter_query       = "entity.type:host"
+  event_filter_event_types = ["incident", "critical", "warning"]
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_alerting_config.test", "event_filter_event_types.#", "3"),
+			},
+		},
+		"instana_apdex_config": {
+			config: `
+resource "instana_apdex_config" "test" {
+  apdex_name = "apdex"
+  apdex_entity = {
+    application = {
+      entity_id      = "application-id"
+      threshold      = 500
+      boundary_scope = "ALL"
+    }
+  }
+  tags = ["production"]
+}
+`,
+			updatedConfig: `
+resource "instana_apdex_config" "test" {
+  apdex_name = "apdex"
+  apdex_entity = {
+    application = {
+      entity_id      = "application-id"
+      threshold      = 1000
+      boundary_scope = "ALL"
+    }
+  }
+  tags = ["production"]
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_apdex_config.test", "apdex_entity.application.threshold", "1000"),
+			},
+		},
+		"instana_api_token": {
+			config: `
+resource "instana_api_token" "test" {
+  name                       = "token"
+  can_configure_applications = true
+}
+`,
+			checks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttrSet("instana_api_token.test", "internal_id"),
+				resource.TestCheckResourceAttrSet("instana_api_token.test", "access_granting_token"),
+			},
+			updatedConfig: `
+resource "instana_api_token" "test" {
+  name                       = "token-updated"
+  can_configure_applications = true
+  can_configure_releases     = true
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_api_token.test", "can_configure_releases", "true"),
+			},
+		},
+		"instana_application_alert_config": {
+			config: testAccAlertingChannelConfig + `
+resource "instana_application_alert_config" "test" {
+  name            = "slow calls - $${severity}"
+  description     = "calls are slow"
+  boundary_scope  = "INBOUND"
+  evaluation_type = "PER_AP"
+  granularity     = 600000
+  triggering      = false
+  alert_channels = {
+    WARNING = [instana_alerting_channel.channel.id]
+  }
+  application = [
+    {
+      application_id = "application-id"
+      inclusive      = true
+    },
+  ]
+  rules = [
+    {
+      rule = {
+        slowness = {
+          aggregation = "P90"
+          metric_name = "latency"
+        }
+      }
+      threshold = {
+        warning = {
+          static = {
+            value = 5
+          }
+        }
+      }
+      threshold_operator = ">="
+    },
+  ]
+  time_threshold = {
+    violations_in_sequence = {
+      time_window = 600000
+    }
+  }
+}
+`,
+			updatedConfig: testAccAlertingChannelConfig + `
+resource "instana_application_alert_config" "test" {
+  name            = "slow calls - $${severity}"
+  description     = "calls are slower than usual"
+  boundary_scope  = "INBOUND"
+  evaluation_type = "PER_AP"
+  granularity     = 600000
+  triggering      = false
+  alert_channels = {
+    WARNING = [instana_alerting_channel.channel.id]
+  }
+  application = [
+    {
+      application_id = "application-id"
+      inclusive      = true
+    },
+  ]
+  rules = [
+    {
+      rule = {
+        slowness = {
+          aggregation = "P90"
+          metric_name = "latency"
+        }
+      }
+      threshold = {
+        warning = {
+          static = {
+            value = 5
+          }
+        }
+      }
+      threshold_operator = ">="
+    },
+  ]
+  time_threshold = {
+    violations_in_sequence = {
+      time_window = 600000
+    }
+  }
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_application_alert_config.test", "description", "calls are slower than usual"),
+			},
+		},
+		"instana_application_config": {
+			config: `
+resource "instana_application_config" "test" {
+  label          = "application"
+  scope          = "INCLUDE_NO_DOWNSTREAM"
+  boundary_scope = "INBOUND"
+  tag_filter     = "service.name@dest EQUALS 'cart'"
+  access_rules = [
+    {
+      access_type   = "READ_WRITE"
+      relation_type = "GLOBAL"
+    },
+  ]
+}
+`,
+			updatedConfig: `
+resource "instana_application_config" "test" {
+  label          = "application-updated"
+  scope          = "INCLUDE_NO_DOWNSTREAM"
+  boundary_scope = "INBOUND"
+  tag_filter     = "service.name@dest EQUALS 'cart'"
+  access_rules = [
+    {
+      access_type   = "READ_WRITE"
+      relation_type = "GLOBAL"
+    },
+  ]
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_application_config.test", "label", "application-updated"),
+			},
+		},
+		"instana_automation_action": {
+			config: `
+resource "instana_automation_action" "test" {
+  name        = "restart"
+  description = "restarts the service"
+  tags        = ["ops"]
+  script = {
+    content     = "c3lzdGVtY3RsIHJlc3RhcnQgYXBw"
+    interpreter = "bash"
+    timeout     = "30"
+  }
+}
+`,
+			updatedConfig: `
+resource "instana_automation_action" "test" {
+  name        = "restart"
+  description = "restarts the service after a failure"
+  tags        = ["ops", "restart"]
+  script = {
+    content     = "c3lzdGVtY3RsIHJlc3RhcnQgYXBw"
+    interpreter = "bash"
+    timeout     = "60"
+  }
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_automation_action.test", "script.timeout", "60"),
+			},
+		},
+		"instana_automation_policy": {
+			config: `
+resource "instana_automation_action" "action" {
+  name        = "restart"
+  description = "restarts the service"
+  script = {
+    content = "c3lzdGVtY3RsIHJlc3RhcnQgYXBw"
+  }
+}
+
+resource "instana_automation_policy" "test" {
+  name        = "policy"
+  description = "restarts the service on failures"
+  trigger = {
+    id   = "custom-event-id"
+    name = "custom event"
+    type = "customEvent"
+  }
+  type_configuration = [
+    {
+      name = "manual"
+      action = [
+        {
+          action = {
+            id   = instana_automation_action.action.id
+            name = instana_automation_action.action.name
+            script = {
+              content = instana_automation_action.action.script.content
+            }
+          }
+        },
+      ]
+    },
+  ]
+}
+`,
+			updatedConfig: `
+resource "instana_automation_action" "action" {
+  name        = "restart"
+  description = "restarts the service"
+  script = {
+    content = "c3lzdGVtY3RsIHJlc3RhcnQgYXBw"
+  }
+}
+
+resource "instana_automation_policy" "test" {
+  name        = "policy"
+  description = "restarts the service on every failure"
+  trigger = {
+    id   = "custom-event-id"
+    name = "custom event"
+    type = "customEvent"
+  }
+  type_configuration = [
+    {
+      name = "manual"
+      action = [
+        {
+          action = {
+            id   = instana_automation_action.action.id
+            name = instana_automation_action.action.name
+            script = {
+              content = instana_automation_action.action.script.content
+            }
+          }
+        },
+      ]
+    },
+  ]
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_automation_policy.test", "description", "restarts the service on every failure"),
+			},
+		},
+		"instana_builtin_event_state": {
+			fixtures: func(backend testutils.FakeInstanaBackend) {
+				backend.SetDocument(instanaapi.BuiltinEventSpecificationsResourcePath+"/"+testAccBuiltinID, map[string]any{
+					"id":      testAccBuiltinID,
+					"name":    "builtin event",
+					"enabled": true,
+				})
+			},
+			config: `
+resource "instana_builtin_event_state" "test" {
+  builtin_event_id = "builtin-event-id"
+  enabled          = false
+}
+`,
+			checks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_builtin_event_state.test", "id", testAccBuiltinID),
+				resource.TestCheckResourceAttr("instana_builtin_event_state.test", "original_enabled", "true"),
+			},
+			updatedConfig: `
+resource "instana_builtin_event_state" "test" {
+  builtin_event_id = "builtin-event-id"
+  enabled          = true
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_builtin_event_state.test", "enabled", "true"),
+			},
+			importStateVerifyIgnore: []string{"original_enabled"},
+		},
+		"instana_custom_dashboard": {
+			config: `
+resource "instana_custom_dashboard" "test" {
+  title = "dashboard"
+  access_rule = [
+    {
+      access_type   = "READ_WRITE"
+      relation_type = "GLOBAL"
+    },
+  ]
+  widgets = jsonencode([{
+    id     = "widget-id"
+    title  = "Widget"
+    type   = "bigNumber"
+    width  = 1
+    height = 1
+    x      = 0
+    y      = 0
+    config = {}
+  }])
+}
+`,
+			updatedConfig: `
+resource "instana_custom_dashboard" "test" {
+  title = "dashboard-updated"
+  access_rule = [
+    {
+      access_type   = "READ_WRITE"
+      relation_type = "GLOBAL"
+    },
+  ]
+  widgets = jsonencode([{
+    id     = "widget-id"
+    title  = "Widget"
+    type   = "bigNumber"
+    width  = 1
+    height = 1
+    x      = 0
+    y      = 0
+    config = {}
+  }])
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_custom_dashboard.test", "title", "dashboard-updated"),
+			},
+		},
+		"instana_custom_event_specification": {
+			config: `
+resource "instana_custom_event_specification" "test" {
+  name            = "agent count"
+  description     = "too many agents"
+  entity_type     = "instanaAgent"
+  enabled         = true
+  triggering      = false
+  expiration_time = 600000
+  rules = {
+    entity_count = {
+      severity           = "warning"
+      condition_operator = ">"
+      condition_value    = 100
+    }
+  }
+}
+`,
+			updatedConfig: `
+resource "instana_custom_event_specification" "test" {
+  name            = "agent count"
+  description     = "more than 100 agents"
+  entity_type     = "instanaAgent"
+  enabled         = true
+  triggering      = false
+  expiration_time = 600000
+  rules = {
+    entity_count = {
+      severity           = "warning"
+      condition_operator = ">"
+      condition_value    = 100
+    }
+  }
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_custom_event_specification.test", "description", "more than 100 agents"),
+			},
+		},
+		"instana_global_application_alert_config": {
+			config: testAccAlertingChannelConfig + `
+resource "instana_global_application_alert_config" "test" {
+  name            = "slow calls - $${severity}"
+  description     = "calls are slow"
+  boundary_scope  = "INBOUND"
+  evaluation_type = "PER_AP"
+  granularity     = 600000
+  triggering      = false
+  alert_channels = {
+    WARNING = [instana_alerting_channel.channel.id]
+  }
+  application = [
+    {
+      application_id = "application-id"
+      inclusive      = true
+    },
+  ]
+  rules = [
+    {
+      rule = {
+        slowness = {
+          aggregation = "P90"
+          metric_name = "latency"
+        }
+      }
+      threshold = {
+        warning = {
+          static = {
+            value = 5
+          }
+        }
+      }
+      threshold_operator = ">="
+    },
+  ]
+  time_threshold = {
+    violations_in_sequence = {
+      time_window = 600000
+    }
+  }
+}
+`,
+			updatedConfig: testAccAlertingChannelConfig + `
+resource "instana_global_application_alert_config" "test" {
+  name            = "slow calls - $${severity}"
+  description     = "calls are slower than usual"
+  boundary_scope  = "INBOUND"
+  evaluation_type = "PER_AP"
+  granularity     = 600000
+  triggering      = false
+  alert_channels = {
+    WARNING = [instana_alerting_channel.channel.id]
+  }
+  application = [
+    {
+      application_id = "application-id"
+      inclusive      = true
+    },
+  ]
+  rules = [
+    {
+      rule = {
+        slowness = {
+          aggregation = "P90"
+          metric_name = "latency"
+        }
+      }
+      threshold = {
+        warning = {
+          static = {
+            value = 5
+          }
+        }
+      }
+      threshold_operator = ">="
+    },
+  ]
+  time_threshold = {
+    violations_in_sequence = {
+      time_window = 600000
+    }
+  }
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_global_application_alert_config.test", "description", "calls are slower than usual"),
+			},
+		},
+		"instana_global_custom_payload_config": {
+			config: `
+resource "instana_global_custom_payload_config" "test" {
+  custom_payload_field = [
+    {
+      key   = "team"
+      value = "sre"
+    },
+  ]
+}
+`,
+			updatedConfig: `
+resource "instana_global_custom_payload_config" "test" {
+  custom_payload_field = [
+    {
+      key   = "team"
+      value = "platform"
+    },
+    {
+      key = "host"
+      dynamic_value = {
+        tag_name = "host.name"
+      }
+    },
+  ]
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_global_custom_payload_config.test", "custom_payload_field.#", "2"),
+				resource.TestCheckResourceAttr("instana_global_custom_payload_config.test", "custom_payload_field.1.dynamic_value.tag_name", "host.name"),
+			},
+			singletonIdentifierAttribute: "custom_payload_field.#",
+		},
+		"instana_http_endpoint_config": {
+			fixtures: func(backend testutils.FakeInstanaBackend) {
+				backend.SetIDField(instanaapi.HTTPEndpointConfigsResourcePath, "serviceId")
+			},
+			config: `
+resource "instana_http_endpoint_config" "test" {
+  service_id = "service-id"
+  rules = [
+    {
+      path_segments = [
+        {
+          type = "FIXED"
+          name = "api"
+        },
+        {
+          type = "PARAMETER"
+          name = "id"
+        },
+      ]
+      test_cases = ["/api/1"]
+    },
+  ]
+}
+`,
+			checks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_http_endpoint_config.test", "id", "service-id"),
+			},
+			updatedConfig: `
+resource "instana_http_endpoint_config" "test" {
+  service_id                                       = "service-id"
+  endpoint_name_by_first_path_segment_rule_enabled = true
+  rules = [
+    {
+      enabled = false
+      path_segments = [
+        {
+          type = "FIXED"
+          name = "api"
+        },
+        {
+          type = "MATCH_ALL"
+        },
+      ]
+    },
+  ]
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_http_endpoint_config.test", "rules.0.path_segments.1.type", "MATCH_ALL"),
+			},
+		},
+		"instana_infra_alert_config": {
+			config: testAccAlertingChannelConfig + `
+resource "instana_infra_alert_config" "test" {
+  name            = "cpu usage - $${severity}"
+  description     = "cpu usage is high"
+  evaluation_type = "CUSTOM"
+  granularity     = 600000
+  alert_channels = {
+    warning = [instana_alerting_channel.channel.id]
+  }
+  rules = {
+    generic_rule = {
+      aggregation              = "MIN"
+      cross_series_aggregation = "MIN"
+      entity_type              = "kubernetesPod"
+      metric_name              = "cpuUsageToLimitRatio"
+      threshold = {
+        warning = {
+          static = {
+            value = 1
+          }
+        }
+      }
+      threshold_operator = ">="
+    }
+  }
+  time_threshold = {
+    violations_in_sequence = {
+      time_window = 600000
+    }
+  }
+}
+`,
+			updatedConfig: testAccAlertingChannelConfig + `
+resource "instana_infra_alert_config" "test" {
+  name            = "cpu usage - $${severity}"
+  description     = "cpu usage is above the limit"
+  evaluation_type = "CUSTOM"
+  granularity     = 600000
+  alert_channels = {
+    warning = [instana_alerting_channel.channel.id]
+  }
+  rules = {
+    generic_rule = {
+      aggregation              = "MIN"
+      cross_series_aggregation = "MIN"
+      entity_type              = "kubernetesPod"
+      metric_name              = "cpuUsageToLimitRatio"
+      threshold = {
+        warning = {
+          static = {
+            value = 1
+          }
+        }
+      }
+      threshold_operator = ">="
+    }
+  }
+  time_threshold = {
+    violations_in_sequence = {
+      time_window = 600000
+    }
+  }
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_infra_alert_config.test", "description", "cpu usage is above the limit"),
+			},
+		},
+		"instana_log_alert_config": {
+			config: testAccAlertingChannelConfig + `
+resource "instana_log_alert_config" "test" {
+  name        = "error logs - $${severity}"
+  description = "too many error logs"
+  granularity = 600000
+  tag_filter  = "log.level@na EQUALS 'ERROR'"
+  alert_channels = {
+    warning = [instana_alerting_channel.channel.id]
+  }
+  rules = {
+    alert_type  = "log.count"
+    metric_name = "logCount"
+    threshold = {
+      warning = {
+        static = {
+          value = 10
+        }
+      }
+    }
+    threshold_operator = ">="
+  }
+  time_threshold = {
+    violations_in_sequence = {
+      time_window = 600000
+    }
+  }
+}
+`,
+			updatedConfig: testAccAlertingChannelConfig + `
+resource "instana_log_alert_config" "test" {
+  name        = "error logs - $${severity}"
+  description = "more than 10 error logs"
+  granularity = 600000
+  tag_filter  = "log.level@na EQUALS 'ERROR'"
+  alert_channels = {
+    warning = [instana_alerting_channel.channel.id]
+  }
+  rules = {
+    alert_type  = "log.count"
+    metric_name = "logCount"
+    threshold = {
+      warning = {
+        static = {
+          value = 10
+        }
+      }
+    }
+    threshold_operator = ">="
+  }
+  time_threshold = {
+    violations_in_sequence = {
+      time_window = 600000
+    }
+  }
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_log_alert_config.test", "description", "more than 10 error logs"),
+			},
+		},
+		"instana_maintenance_window_config": {
+			config: `
+resource "instana_maintenance_window_config" "test" {
+  name  = "deployment"
+  query = "entity.zone:production"
+  scheduling = {
+    start = 2088055029000
+    type  = "ONE_TIME"
+    duration = {
+      amount = 2
+      unit   = "HOURS"
+    }
+  }
+}
+`,
+			updatedConfig: `
+resource "instana_maintenance_window_config" "test" {
+  name  = "deployment-updated"
+  query = "entity.zone:production"
+  scheduling = {
+    start = 2088055029000
+    type  = "ONE_TIME"
+    duration = {
+      amount = 2
+      unit   = "HOURS"
+    }
+  }
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_maintenance_window_config.test", "name", "deployment-updated"),
+			},
+		},
+		"instana_manual_service": {
+			config: `
+resource "instana_manual_service" "test" {
+  tag_filter               = "call.http.host@dest EQUALS 'example.com'"
+  unmonitored_service_name = "example"
+}
+`,
+			updatedConfig: `
+resource "instana_manual_service" "test" {
+  tag_filter               = "call.http.host@dest EQUALS 'example.com'"
+  unmonitored_service_name = "example"
+  description              = "calls to example.com"
+  enabled                  = false
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_manual_service.test", "enabled", "false"),
+			},
+		},
+		"instana_mobile_alert_config": {
+			config: testAccAlertingChannelConfig + `
+resource "instana_mobile_alert_config" "test" {
+  name          = "slow requests - $${severity}"
+  description   = "requests are slow"
+  mobile_app_id = "mobile-app-id"
+  granularity   = 600000
+  triggering    = false
+  alert_channels = {
+    WARNING = [instana_alerting_channel.channel.id]
+  }
+  rules = [{
+    rule = {
+      alert_type  = "slowness"
+      metric_name = "httpLatency"
+      aggregation = "P90"
+    }
+    threshold_operator = ">="
+    threshold = {
+      warning = {
+        static = {
+          value = 50
+        }
+      }
+    }
+  }]
+  time_threshold = {
+    violations_in_sequence = {
+      time_window = 600000
+    }
+  }
+}
+`,
+			updatedConfig: testAccAlertingChannelConfig + `
+resource "instana_mobile_alert_config" "test" {
+  name          = "slow requests - $${severity}"
+  description   = "requests are slower than usual"
+  mobile_app_id = "mobile-app-id"
+  granularity   = 600000
+  triggering    = false
+  alert_channels = {
+    WARNING = [instana_alerting_channel.channel.id]
+  }
+  rules = [{
+    rule = {
+      alert_type  = "slowness"
+      metric_name = "httpLatency"
+      aggregation = "P90"
+    }
+    threshold_operator = ">="
+    threshold = {
+      warning = {
+        static = {
+          value = 50
+        }
+      }
+    }
+  }]
+  time_threshold = {
+    violations_in_sequence = {
+      time_window = 600000
+    }
+  }
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_mobile_alert_config.test", "description", "requests are slower than usual"),
+			},
+		},
+		"instana_mobile_app_config": {
+			config: `
+resource "instana_mobile_app_config" "test" {
+  name = "mobile-app"
+}
+`,
+			updatedConfig: `
+resource "instana_mobile_app_config" "test" {
+  name = "mobile-app-updated"
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_mobile_app_config.test", "name", "mobile-app-updated"),
+			},
+		},
+		"instana_mobile_app_geo_location_config": {
+			fixtures: eumConfigSettingsFixtures(instanaapi.MobileAppMonitoringConfigResourcePath, testAccMobileAppID),
+			config: `
+resource "instana_mobile_app_geo_location_config" "test" {
+  mobile_app_id      = "mobile-app-id"
+  geo_detail_removal = "REMOVE_COORDINATES"
+}
+`,
+			updatedConfig: `
+resource "instana_mobile_app_geo_location_config" "test" {
+  mobile_app_id      = "mobile-app-id"
+  geo_detail_removal = "REMOVE_CITY"
+}
+`,
+		},
+		"instana_mobile_app_geo_mapping_rules": {
+			fixtures: eumConfigSettingsFixtures(instanaapi.MobileAppMonitoringConfigResourcePath, testAccMobileAppID),
+			config: `
+resource "instana_mobile_app_geo_mapping_rules" "test" {
+  mobile_app_id = "mobile-app-id"
+  rules_csv     = "10.0.0.0/8,EU,DE,Berlin,Berlin,52.52,13.40"
+}
+`,
+			updatedConfig: `
+resource "instana_mobile_app_geo_mapping_rules" "test" {
+  mobile_app_id = "mobile-app-id"
+  rules_csv     = "10.0.0.0/8,EU,DE,Bavaria,Munich,48.14,11.58"
+}
+`,
+		},
+		"instana_mobile_app_ip_masking_config": {
+			fixtures: eumConfigSettingsFixtures(instanaapi.MobileAppMonitoringConfigResourcePath, testAccMobileAppID),
+			config: `
+resource "instana_mobile_app_ip_masking_config" "test" {
+  mobile_app_id = "mobile-app-id"
+  ip_masking    = "STRICT"
+}
+`,
+			updatedConfig: `
+resource "instana_mobile_app_ip_masking_config" "test" {
+  mobile_app_id = "mobile-app-id"
+  ip_masking    = "REMOVE_ALL_DETAILS"
+}
+`,
+		},
+		"instana_rbac_group": {
+			config: `
+resource "instana_rbac_group" "test" {
+  name = "group"
+  permission_set = {
+    permissions = ["CAN_CONFIGURE_APPLICATIONS"]
+  }
+}
+`,
+			updatedConfig: `
+resource "instana_rbac_group" "test" {
+  name = "group-updated"
+  member = [
+    {
+      user_id = "user-id"
+      email   = "user@example.com"
+    },
+  ]
+  permission_set = {
+    permissions     = ["CAN_CONFIGURE_APPLICATIONS", "CAN_CONFIGURE_TEAMS"]
+    application_ids = ["application-id"]
+  }
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_rbac_group.test", "member.#", "1"),
+			},
+		},
+		"instana_rbac_group_mapping": {
+			config: `
+resource "instana_rbac_group_mapping" "test" {
+  key      = "department"
+  value    = "engineering"
+  group_id = "group-id"
+}
+`,
+			updatedConfig: `
+resource "instana_rbac_group_mapping" "test" {
+  key      = "department"
+  value    = "platform"
+  group_id = "group-id"
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_rbac_group_mapping.test", "value", "platform"),
+			},
+		},
+		"instana_rbac_role": {
+			config: `
+resource "instana_rbac_role" "test" {
+  name        = "role"
+  permissions = ["CAN_CONFIGURE_APPLICATIONS"]
+}
+`,
+			updatedConfig: `
+resource "instana_rbac_role" "test" {
+  name        = "role"
+  permissions = ["CAN_CONFIGURE_APPLICATIONS", "CAN_VIEW_LOGS"]
+  member = [
+    {
+      user_id = "user-id"
+    },
+  ]
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_rbac_role.test", "permissions.#", "2"),
+			},
+		},
+		"instana_rbac_team": {
+			config: `
+resource "instana_rbac_team" "test" {
+  tag = "team"
+}
+`,
+			updatedConfig: `
+resource "instana_rbac_team" "test" {
+  tag = "team"
+  info = {
+    description = "the platform team"
+  }
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_rbac_team.test", "info.description", "the platform team"),
+			},
+		},
+		"instana_release": {
+			config: `
+resource "instana_release" "test" {
+  name         = "release"
+  start        = 1700000000000
+  applications = ["application"]
+}
+`,
+			updatedConfig: `
+resource "instana_release" "test" {
+  name  = "release-updated"
+  start = 1700000000000
+  services = [
+    {
+      name         = "service"
+      applications = ["application"]
+    },
+  ]
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_release.test", "name", "release-updated"),
+				resource.TestCheckResourceAttr("instana_release.test", "services.#", "1"),
+			},
+		},
+		"instana_service_config": {
+			config: `
+resource "instana_service_config" "test" {
+  name  = "service-config"
+  label = "{kubernetes.container.name}"
+  match_specification = [
+    {
+      key   = "kubernetes.namespace.name"
+      value = "*"
+    },
+  ]
+}
+`,
+			checks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_service_config.test", "enabled", "true"),
+			},
+			updatedConfig: `
+resource "instana_service_config" "test" {
+  name    = "service-config"
+  label   = "{kubernetes.namespace.name}-{kubernetes.container.name}"
+  comment  = "per namespace"
+  enabled  = false
+  position = 10
+  match_specification = [
+    {
+      key   = "kubernetes.namespace.name"
+      value = "*"
+    },
+  ]
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_service_config.test", "enabled", "false"),
+				resource.TestCheckResourceAttr("instana_service_config.test", "position", "10"),
+			},
+		},
+		"instana_session_settings": {
+			config: `
+resource "instana_session_settings" "test" {
+  idle_time_in_millis       = 3600000
+  token_life_time_in_millis = 86400000
+}
+`,
+			updatedConfig: `
+resource "instana_session_settings" "test" {
+  idle_time_in_millis       = 7200000
+  token_life_time_in_millis = 86400000
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_session_settings.test", "idle_time_in_millis", "7200000"),
+			},
+			singletonIdentifierAttribute: "token_life_time_in_millis",
+		},
+		"instana_sli_config": {
+			config: `
+resource "instana_sli_config" "test" {
+  name                         = "latency"
+  initial_evaluation_timestamp = 0
+  metric_configuration = {
+    metric_name = "latency"
+    aggregation = "P95"
+    threshold   = 500
+  }
+  sli_entity = {
+    application_time_based = {
+      application_id = "application-id"
+      boundary_scope = "INBOUND"
+    }
+  }
+}
+`,
+			updatedConfig: `
+resource "instana_sli_config" "test" {
+  name                         = "latency"
+  initial_evaluation_timestamp = 0
+  metric_configuration = {
+    metric_name = "latency"
+    aggregation = "P95"
+    threshold   = 1000
+  }
+  sli_entity = {
+    application_time_based = {
+      application_id = "application-id"
+      boundary_scope = "INBOUND"
+    }
+  }
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_sli_config.test", "metric_configuration.threshold", "1000"),
+			},
+		},
+		"instana_slo_alert_config": {
+			config: testAccAlertingChannelConfig + `
+resource "instana_slo_alert_config" "test" {
+  name              = "slo status"
+  description       = "slo status is low"
+  severity          = 10
+  alert_type        = "status"
+  slo_ids           = ["slo-id"]
+  alert_channel_ids = [instana_alerting_channel.channel.id]
+  threshold = {
+    operator = ">"
+    value    = 0.7
+  }
+  time_threshold = {
+    warm_up   = 60000
+    cool_down = 60000
+  }
+}
+`,
+			updatedConfig: testAccAlertingChannelConfig + `
+resource "instana_slo_alert_config" "test" {
+  name              = "slo status"
+  description       = "slo status is below 70%"
+  severity          = 10
+  alert_type        = "status"
+  slo_ids           = ["slo-id"]
+  alert_channel_ids = [instana_alerting_channel.channel.id]
+  threshold = {
+    operator = ">"
+    value    = 0.7
+  }
+  time_threshold = {
+    warm_up   = 60000
+    cool_down = 60000
+  }
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_slo_alert_config.test", "description", "slo status is below 70%"),
+			},
+		},
+		"instana_slo_config": {
+			config: `
+resource "instana_slo_config" "test" {
+  name   = "latency"
+  target = 0.99
+  entity = {
+    application = {
+      application_id    = "application-id"
+      boundary_scope    = "ALL"
+      include_internal  = false
+      include_synthetic = false
+    }
+  }
+  indicator = {
+    time_based_latency = {
+      aggregation = "MEAN"
+      threshold   = 100
+    }
+  }
+  time_window = {
+    rolling = {
+      duration      = 1
+      duration_unit = "week"
+      timezone      = "UTC"
+    }
+  }
+}
+`,
+			updatedConfig: `
+resource "instana_slo_config" "test" {
+  name   = "latency"
+  target = 0.95
+  entity = {
+    application = {
+      application_id    = "application-id"
+      boundary_scope    = "ALL"
+      include_internal  = false
+      include_synthetic = false
+    }
+  }
+  indicator = {
+    time_based_latency = {
+      aggregation = "MEAN"
+      threshold   = 100
+    }
+  }
+  time_window = {
+    rolling = {
+      duration      = 1
+      duration_unit = "week"
+      timezone      = "UTC"
+    }
+  }
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_slo_config.test", "target", "0.95"),
+			},
+		},
+		"instana_slo_correction_config": {
+			config: `
+resource "instana_slo_correction_config" "test" {
+  name        = "maintenance"
+  description = "database upgrade"
+  active      = true
+  slo_ids     = ["slo-id"]
+  scheduling = {
+    start_time    = 1749709800000
+    duration      = 2
+    duration_unit = "hour"
+  }
+}
+`,
+			updatedConfig: `
+resource "instana_slo_correction_config" "test" {
+  name        = "maintenance"
+  description = "database and cache upgrade"
+  active      = true
+  slo_ids     = ["slo-id"]
+  scheduling = {
+    start_time    = 1749709800000
+    duration      = 2
+    duration_unit = "hour"
+  }
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_slo_correction_config.test", "description", "database and cache upgrade"),
+			},
+		},
+		"instana_synthetic_alert_config": {
+			config: testAccAlertingChannelConfig + `
+resource "instana_synthetic_alert_config" "test" {
+  name               = "synthetic test failures - $${severity}"
+  description        = "synthetic test fails"
+  synthetic_test_ids = ["synthetic-test-id"]
+  severity           = 5
+  alert_channel_ids  = [instana_alerting_channel.channel.id]
+  rule = {
+    alert_type  = "failure"
+    metric_name = "status"
+    aggregation = "SUM"
+  }
+  time_threshold = {
+    type             = "violationsInSequence"
+    violations_count = 2
+  }
+}
+`,
+			updatedConfig: testAccAlertingChannelConfig + `
+resource "instana_synthetic_alert_config" "test" {
+  name               = "synthetic test failures - $${severity}"
+  description        = "synthetic test fails twice"
+  synthetic_test_ids = ["synthetic-test-id"]
+  severity           = 5
+  alert_channel_ids  = [instana_alerting_channel.channel.id]
+  rule = {
+    alert_type  = "failure"
+    metric_name = "status"
+    aggregation = "SUM"
+  }
+  time_threshold = {
+    type             = "violationsInSequence"
+    violations_count = 2
+  }
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_synthetic_alert_config.test", "description", "synthetic test fails twice"),
+			},
+		},
+		"instana_synthetic_calls_settings": {
+			config: `
+resource "instana_synthetic_calls_settings" "test" {
+  default_rules_enabled = true
+}
+`,
+			updatedConfig: `
+resource "instana_synthetic_calls_settings" "test" {
+  default_rules_enabled = false
+  custom_rules = [
+    {
+      name                = "health checks"
+      match_specification = "call.http.path EQUALS '/health'"
+    },
+  ]
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_synthetic_calls_settings.test", "custom_rules.#", "1"),
+			},
+			singletonIdentifierAttribute: "default_rules_enabled",
+		},
+		"instana_synthetic_credential": {
+			fixtures: func(backend testutils.FakeInstanaBackend) {
+				backend.SetIDField(testAccSyntheticCredentialsResourcePath, "credentialName")
+			},
+			config: `
+resource "instana_synthetic_credential" "test" {
+  credential_name  = "token"
+  credential_value = "secret"
+}
+`,
+			updatedConfig: `
+resource "instana_synthetic_credential" "test" {
+  credential_name  = "token"
+  credential_value = "secret"
+  applications     = ["application-id"]
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_synthetic_credential.test", "applications.#", "1"),
+			},
+			idAttribute:             "credential_name",
+			importStateVerifyIgnore: []string{"credential_value"},
+		},
+		"instana_synthetic_test": {
+			config: `
+resource "instana_synthetic_test" "test" {
+  label          = "http"
+  description    = "checks the website"
+  active         = true
+  locations      = ["location-id"]
+  test_frequency = 15
+  http_action = {
+    url           = "https://example.com"
+    operation     = "GET"
+    expect_status = 200
+  }
+}
+`,
+			updatedConfig: `
+resource "instana_synthetic_test" "test" {
+  label          = "http"
+  description    = "checks the availability of the website"
+  active         = true
+  locations      = ["location-id"]
+  test_frequency = 15
+  http_action = {
+    url           = "https://example.com"
+    operation     = "GET"
+    expect_status = 200
+  }
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_synthetic_test.test", "description", "checks the availability of the website"),
+			},
+		},
+		"instana_website_alert_config": {
+			config: testAccAlertingChannelConfig + `
+resource "instana_website_alert_config" "test" {
+  name              = "page load time - $${severity}"
+  description       = "pages load slowly"
+  website_id        = "website-id"
+  triggering        = false
+  granularity       = 600000
+  alert_channel_ids = [instana_alerting_channel.channel.id]
+  rules = [
+    {
+      operator = ">="
+      rule = {
+        slowness = {
+          aggregation = "P90"
+          metric_name = "onLoadTime"
+        }
+      }
+      threshold = {
+        warning = {
+          static = {
+            value = 5
+          }
+        }
+      }
+    },
+  ]
+  time_threshold = {
+    violations_in_sequence = {
+      time_window = 600000
+    }
+  }
+}
+`,
+			updatedConfig: testAccAlertingChannelConfig + `
+resource "instana_website_alert_config" "test" {
+  name              = "page load time - $${severity}"
+  description       = "pages load slower than usual"
+  website_id        = "website-id"
+  triggering        = false
+  granularity       = 600000
+  alert_channel_ids = [instana_alerting_channel.channel.id]
+  rules = [
+    {
+      operator = ">="
+      rule = {
+        slowness = {
+          aggregation = "P90"
+          metric_name = "onLoadTime"
+        }
+      }
+      threshold = {
+        warning = {
+          static = {
+            value = 5
+          }
+        }
+      }
+    },
+  ]
+  time_threshold = {
+    violations_in_sequence = {
+      time_window = 600000
+    }
+  }
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_website_alert_config.test", "description", "pages load slower than usual"),
+			},
+		},
+		"instana_website_geo_location_config": {
+			fixtures: eumConfigSettingsFixtures(instanaapi.WebsiteMonitoringConfigResourcePath, testAccWebsiteID),
+			config: `
+resource "instana_website_geo_location_config" "test" {
+  website_id         = "website-id"
+  geo_detail_removal = "REMOVE_COORDINATES"
+}
+`,
+			updatedConfig: `
+resource "instana_website_geo_location_config" "test" {
+  website_id         = "website-id"
+  geo_detail_removal = "REMOVE_CITY"
+}
+`,
+		},
+		"instana_website_geo_mapping_rules": {
+			fixtures: eumConfigSettingsFixtures(instanaapi.WebsiteMonitoringConfigResourcePath, testAccWebsiteID),
+			config: `
+resource "instana_website_geo_mapping_rules" "test" {
+  website_id = "website-id"
+  rules_csv  = "10.0.0.0/8,EU,DE,Berlin,Berlin,52.52,13.40"
+}
+`,
+			updatedConfig: `
+resource "instana_website_geo_mapping_rules" "test" {
+  website_id = "website-id"
+  rules_csv  = "10.0.0.0/8,EU,DE,Bavaria,Munich,48.14,11.58"
+}
+`,
+		},
+		"instana_website_ip_masking_config": {
+			fixtures: eumConfigSettingsFixtures(instanaapi.WebsiteMonitoringConfigResourcePath, testAccWebsiteID),
+			config: `
+resource "instana_website_ip_masking_config" "test" {
+  website_id = "website-id"
+  ip_masking = "STRICT"
+}
+`,
+			updatedConfig: `
+resource "instana_website_ip_masking_config" "test" {
+  website_id = "website-id"
+  ip_masking = "REMOVE_ALL_DETAILS"
+}
+`,
+		},
+		"instana_website_monitoring_config": {
+			config: `
+resource "instana_website_monitoring_config" "test" {
+  name = "website"
+}
+`,
+			updatedConfig: `
+resource "instana_website_monitoring_config" "test" {
+  name = "website-updated"
+}
+`,
+			updatedChecks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("instana_website_monitoring_config.test", "name", "website-updated"),
+			},
+		},
+	}
+}
+
+// eumConfigSettingsFixtures stores the website or mobile app with the given ID and its default settings
+func eumConfigSettingsFixtures(resourcePath string, id string) func(backend testutils.FakeInstanaBackend) {
+	return func(backend testutils.FakeInstanaBackend) {
+		configPath := resourcePath + "/" + id
+		backend.SetDocument(configPath, map[string]any{"id": id, "name": id})
+		backend.SetDocument(configPath+"/"+instanaapi.GeoLocationConfigurationSubPath, map[string]any{"geoDetailRemoval": "NO_REMOVAL"})
+		backend.SetRawDocument(configPath+"/"+instanaapi.GeoMappingRulesSubPath, "text/csv", []byte{})
+		backend.SetDocument(configPath+"/"+instanaapi.IPMaskingConfigurationSubPath, map[string]any{"ipMasking": "DEFAULT"})
+	}
+}
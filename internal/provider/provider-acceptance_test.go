@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+// The acceptance tests run real plan and apply cycles of Terraform against the testutils.FakeInstanaBackend. They
+// do not need an Instana tenant, but like all acceptance tests they only run when TF_ACC is set and require a
+// terraform binary in the PATH or in TF_ACC_TERRAFORM_PATH:
+//
+//	TF_ACC=1 go test ./internal/provider/ -run TestAcc
+
+// testAccResourceName the name of the resource under test in the configurations of the acceptance tests
+const testAccResourceName = "test"
+
+// testAccProtoV6ProviderFactories the provider factories of the acceptance tests
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"instana": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// acceptanceTestCase an offline acceptance test of a single resource type. The test creates the resource from config,
+// imports it, updates it from updatedConfig and finally destroys it. The configurations contain the resource under
+// test with the name testAccResourceName and optionally further resources it depends on.
+type acceptanceTestCase struct {
+	// fixtures stores the objects in the backend which are required but not managed by the configurations
+	fixtures func(backend testutils.FakeInstanaBackend)
+	// config the configuration creating the resource
+	config string
+	// checks the additional checks of the created resource
+	checks []resource.TestCheckFunc
+	// updatedConfig the configuration updating the resource in place
+	updatedConfig string
+	// updatedChecks the additional checks of the updated resource
+	updatedChecks []resource.TestCheckFunc
+	// idAttribute the attribute holding the ID of resources without an id attribute
+	idAttribute string
+	// singletonIdentifierAttribute the attribute matching the imported and the managed state of singletons, which
+	// have no ID. Must only be set for singletons.
+	singletonIdentifierAttribute string
+	// importStateVerifyIgnore the attributes which cannot be imported, e.g. sensitive and write-only values
+	importStateVerifyIgnore []string
+}
+
+// newAcceptanceTestBackend starts a new FakeInstanaBackend which is stopped when the test is finished
+func newAcceptanceTestBackend(t *testing.T) testutils.FakeInstanaBackend {
+	backend := testutils.NewFakeInstanaBackend()
+	t.Cleanup(backend.Close)
+	return backend
+}
+
+// testAccProviderConfig returns the configuration of the provider using the given backend
+func testAccProviderConfig(backend testutils.FakeInstanaBackend) string {
+	return fmt.Sprintf(`
+provider "instana" {
+  endpoint    = %q
+  api_token   = %q
+  max_retries = 0
+}
+`, backend.URL(), testutils.FakeInstanaBackendAPIToken)
+}
+
+// testAccResourceAddress returns the address of the resource under test of the given resource type
+func testAccResourceAddress(resourceType string) string {
+	return resourceType + "." + testAccResourceName
+}
+
+// runAcceptanceTestCase runs the create, import, update and destroy cycle of the given test case
+func runAcceptanceTestCase(t *testing.T, resourceType string, testCase acceptanceTestCase) {
+	backend := newAcceptanceTestBackend(t)
+	if testCase.fixtures != nil {
+		testCase.fixtures(backend)
+	}
+	address := testAccResourceAddress(resourceType)
+
+	createChecks := testCase.checks
+	importStep := resource.TestStep{
+		ResourceName:            address,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: testCase.importStateVerifyIgnore,
+	}
+	switch {
+	case testCase.singletonIdentifierAttribute != "":
+		importStep.ImportStateId = resourceType
+		importStep.ImportStateVerifyIdentifierAttribute = testCase.singletonIdentifierAttribute
+	case testCase.idAttribute != "":
+		importStep.ImportStateIdFunc = func(state *terraform.State) (string, error) {
+			return state.RootModule().Resources[address].Primary.Attributes[testCase.idAttribute], nil
+		}
+		importStep.ImportStateVerifyIdentifierAttribute = testCase.idAttribute
+		fallthrough
+	default:
+		createChecks = append([]resource.TestCheckFunc{testAccCheckObjectExists(backend, address, testCase.idAttribute)}, createChecks...)
+	}
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccProviderConfig(backend) + testCase.config,
+			Check:  resource.ComposeAggregateTestCheckFunc(createChecks...),
+		},
+		importStep,
+	}
+	if testCase.updatedConfig != "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccProviderConfig(backend) + testCase.updatedConfig,
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction(address, plancheck.ResourceActionUpdate),
+				},
+			},
+			Check: resource.ComposeAggregateTestCheckFunc(testCase.updatedChecks...),
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+		CheckDestroy:             testAccCheckAllObjectsDestroyed(backend),
+	})
+}
+
+// testAccCheckObjectExists verifies that the backend stores an object with the ID of the given resource, which is read
+// from the given attribute or the id attribute if empty. Composite IDs like <type>/<id> are matched by their last
+// segment.
+func testAccCheckObjectExists(backend testutils.FakeInstanaBackend, address string, idAttribute string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		resourceState, ok := state.RootModule().Resources[address]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", address)
+		}
+		id := resourceState.Primary.ID
+		if idAttribute != "" {
+			id = resourceState.Primary.Attributes[idAttribute]
+		}
+		if id == "" {
+			return fmt.Errorf("resource %s has no ID", address)
+		}
+		if len(backend.FindDocumentPaths(path.Base(id))) == 0 {
+			return fmt.Errorf("no object with ID %s of resource %s found in backend", id, address)
+		}
+		return nil
+	}
+}
+
+// testAccCheckAllObjectsDestroyed verifies that all objects created through the API have been deleted
+func testAccCheckAllObjectsDestroyed(backend testutils.FakeInstanaBackend) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if paths := backend.CreatedDocumentPaths(); len(paths) > 0 {
+			return fmt.Errorf("objects not destroyed: %s", strings.Join(paths, ", "))
+		}
+		return nil
+	}
+}
+
+func TestAccResources(t *testing.T) {
+	testCases := acceptanceTestCases()
+	resourceTypes := make([]string, 0, len(testCases))
+	for resourceType := range testCases {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	for _, resourceType := range resourceTypes {
+		t.Run(resourceType, func(t *testing.T) {
+			runAcceptanceTestCase(t, resourceType, testCases[resourceType])
+		})
+	}
+}
+
+func TestAcceptanceTestCasesShouldCoverAllResourcesOfTheProvider(t *testing.T) {
+	testCases := acceptanceTestCases()
+	instanaProvider := New("test")()
+	for _, resourceFunc := range instanaProvider.Resources(context.Background()) {
+		resp := &fwresource.MetadataResponse{}
+		resourceFunc().Metadata(context.Background(), fwresource.MetadataRequest{ProviderTypeName: "instana"}, resp)
+
+		assert.Contains(t, testCases, resp.TypeName, "no acceptance test case for resource %s", resp.TypeName)
+	}
+}
+
+func TestAccResourceShouldBeRecreatedWhenDeletedOutsideOfTerraform(t *testing.T) {
+	backend := newAcceptanceTestBackend(t)
+	address := testAccResourceAddress("instana_release")
+	config := testAccProviderConfig(backend) + `
+resource "instana_release" "test" {
+  name  = "release"
+  start = 1700000000000
+}
+`
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(state *terraform.State) error {
+					id = state.RootModule().Resources[address].Primary.ID
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					backend.DeleteDocument(instanaapi.ReleasesResourcePath + "/" + id)
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(address, plancheck.ResourceActionCreate),
+					},
+				},
+				Check: testAccCheckObjectExists(backend, address, ""),
+			},
+		},
+		CheckDestroy: testAccCheckAllObjectsDestroyed(backend),
+	})
+}
+
+func TestAccResourceShouldFailWhenBackendRejectsPayload(t *testing.T) {
+	backend := newAcceptanceTestBackend(t)
+	backend.AddValidator(instanaapi.ReleasesResourcePath, func(_ string, _ string, payload []byte) error {
+		if strings.Contains(string(payload), "invalid") {
+			return errors.New("the name of the release is invalid")
+		}
+		return nil
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(backend) + `
+resource "instana_release" "test" {
+  name  = "invalid"
+  start = 1700000000000
+}
+`,
+				ExpectError: regexp.MustCompile(`the name of the release is invalid`),
+			},
+		},
+		CheckDestroy: testAccCheckAllObjectsDestroyed(backend),
+	})
+}
+
+func TestAccReleaseShouldKeepEmptyScopes(t *testing.T) {
+	backend := newAcceptanceTestBackend(t)
+	address := testAccResourceAddress("instana_release")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(backend) + `
+resource "instana_release" "test" {
+  name         = "release"
+  start        = 1700000000000
+  applications = []
+  services     = []
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(address, "applications.#", "0"),
+					resource.TestCheckResourceAttr(address, "services.#", "0"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckAllObjectsDestroyed(backend),
+	})
+}
+
+func TestAccTagFilterExpressionShouldNotPlanUpdateForFormattingOnlyChanges(t *testing.T) {
+	testCases := map[string]struct {
+		config    string
+		attribute string
+	}{
+		"instana_rbac_team": {
+			config: `
+resource "instana_rbac_team" "test" {
+  tag = "team"
+  scope = {
+    restricted_application_filter = {
+      label                 = "hosts"
+      tag_filter_expression = %q
+    }
+  }
+}
+`,
+			attribute: "scope.restricted_application_filter.tag_filter_expression",
+		},
+		"instana_manual_service": {
+			config: `
+resource "instana_manual_service" "test" {
+  tag_filter               = %q
+  unmonitored_service_name = "example"
+}
+`,
+			attribute: "tag_filter",
+		},
+	}
+
+	for resourceType, testCase := range testCases {
+		t.Run(resourceType, func(t *testing.T) {
+			backend := newAcceptanceTestBackend(t)
+			address := testAccResourceAddress(resourceType)
+			config := func(tagFilterExpression string) string {
+				return testAccProviderConfig(backend) + fmt.Sprintf(testCase.config, tagFilterExpression)
+			}
+
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: config("entity.type EQUALS 'host' AND entity.zone NOT_EMPTY"),
+					},
+					{
+						Config: config("(entity.type  equals 'host') and entity.zone NOT_EMPTY"),
+						ConfigPlanChecks: resource.ConfigPlanChecks{
+							PreApply: []plancheck.PlanCheck{
+								plancheck.ExpectEmptyPlan(),
+							},
+						},
+						Check: resource.TestCheckResourceAttr(address, testCase.attribute, "entity.type EQUALS 'host' AND entity.zone NOT_EMPTY"),
+					},
+					{
+						Config: config("entity.type EQUALS 'container'"),
+						ConfigPlanChecks: resource.ConfigPlanChecks{
+							PreApply: []plancheck.PlanCheck{
+								plancheck.ExpectResourceAction(address, plancheck.ResourceActionUpdate),
+							},
+						},
+						Check: resource.TestCheckResourceAttr(address, testCase.attribute, "entity.type EQUALS 'container'"),
+					},
+				},
+				CheckDestroy: testAccCheckAllObjectsDestroyed(backend),
+			})
+		})
+	}
+}
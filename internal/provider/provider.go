@@ -2,26 +2,33 @@ package provider
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
-	"net/http"
 	"os"
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/instana/instana-go-client/client"
 
 	"github.com/instana/instana-go-client/config"
 	"github.com/instana/terraform-provider-instana/internal/datasources"
+	"github.com/instana/terraform-provider-instana/internal/exporter"
+	"github.com/instana/terraform-provider-instana/internal/functions"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
 	"github.com/instana/terraform-provider-instana/internal/util"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/alertconfigversionpin"
 	"github.com/instana/terraform-provider-instana/internal/resources/alertingchannel"
 	"github.com/instana/terraform-provider-instana/internal/resources/alertingconfig"
 	"github.com/instana/terraform-provider-instana/internal/resources/apdexconfig"
@@ -30,16 +37,23 @@ import (
 	"github.com/instana/terraform-provider-instana/internal/resources/applicationconfig"
 	"github.com/instana/terraform-provider-instana/internal/resources/automationaction"
 	"github.com/instana/terraform-provider-instana/internal/resources/automationpolicy"
+	"github.com/instana/terraform-provider-instana/internal/resources/builtineventstate"
 	"github.com/instana/terraform-provider-instana/internal/resources/customdashboard"
+	"github.com/instana/terraform-provider-instana/internal/resources/custompayloadconfig"
 	"github.com/instana/terraform-provider-instana/internal/resources/customeventspec"
+	"github.com/instana/terraform-provider-instana/internal/resources/eumconfigsettings"
 	"github.com/instana/terraform-provider-instana/internal/resources/group"
 	"github.com/instana/terraform-provider-instana/internal/resources/groupmapping"
+	"github.com/instana/terraform-provider-instana/internal/resources/httpendpointconfig"
 	"github.com/instana/terraform-provider-instana/internal/resources/infralertconfig"
 	"github.com/instana/terraform-provider-instana/internal/resources/logalertconfig"
 	"github.com/instana/terraform-provider-instana/internal/resources/maintenancewindowconfig"
+	"github.com/instana/terraform-provider-instana/internal/resources/manualservice"
 	"github.com/instana/terraform-provider-instana/internal/resources/mobilealertconfig"
 	"github.com/instana/terraform-provider-instana/internal/resources/mobileappconfig"
+	"github.com/instana/terraform-provider-instana/internal/resources/release"
 	"github.com/instana/terraform-provider-instana/internal/resources/roles"
+	"github.com/instana/terraform-provider-instana/internal/resources/serviceconfig"
 	"github.com/instana/terraform-provider-instana/internal/resources/sliconfig"
 	"github.com/instana/terraform-provider-instana/internal/resources/sloalertconfig"
 	"github.com/instana/terraform-provider-instana/internal/resources/sloconfig"
@@ -47,6 +61,7 @@ import (
 	"github.com/instana/terraform-provider-instana/internal/resources/syntheticalertconfig"
 	"github.com/instana/terraform-provider-instana/internal/resources/syntheticcredential"
 	"github.com/instana/terraform-provider-instana/internal/resources/sessionsettings"
+	"github.com/instana/terraform-provider-instana/internal/resources/syntheticcallssettings"
 	"github.com/instana/terraform-provider-instana/internal/resources/synthetictest"
 	"github.com/instana/terraform-provider-instana/internal/resources/team"
 	"github.com/instana/terraform-provider-instana/internal/resources/websitealertconfig"
@@ -56,7 +71,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ provider.Provider = &InstanaProvider{}
+	_ provider.Provider                       = &InstanaProvider{}
+	_ provider.ProviderWithEphemeralResources = &InstanaProvider{}
+	_ provider.ProviderWithFunctions          = &InstanaProvider{}
 )
 
 // Pre-compiled regex patterns for sensitive data sanitization
@@ -71,20 +88,79 @@ var (
 // SchemaFieldAPIToken the name of the provider configuration option for the api token
 const SchemaFieldAPIToken = "api_token"
 
+// SchemaFieldAPITokenFile the name of the provider configuration option for the file containing the api token
+const SchemaFieldAPITokenFile = "api_token_file"
+
+// SchemaFieldAPITokenCommand the name of the provider configuration option for the command printing the api token
+const SchemaFieldAPITokenCommand = "api_token_command"
+
 // SchemaFieldEndpoint the name of the provider configuration option for the instana endpoint
 const SchemaFieldEndpoint = "endpoint"
 
 // SchemaFieldTlsSkipVerify flag to deactivate skip tls verification
 const SchemaFieldTlsSkipVerify = "tls_skip_verify"
 
+// SchemaFieldMaxRetries the name of the provider configuration option for the max number of retries of a request
+const SchemaFieldMaxRetries = "max_retries"
+
+// SchemaFieldRetryMinBackoff the name of the provider configuration option for the initial wait time between retries
+const SchemaFieldRetryMinBackoff = "retry_min_backoff"
+
+// SchemaFieldRetryMaxBackoff the name of the provider configuration option for the max wait time between retries
+const SchemaFieldRetryMaxBackoff = "retry_max_backoff"
+
+// SchemaFieldRespectRetryAfter flag to wait for the time of the Retry-After header before retrying a request
+const SchemaFieldRespectRetryAfter = "respect_retry_after"
+
+// SchemaFieldRequestTimeout the name of the provider configuration option for the timeout of a single request
+const SchemaFieldRequestTimeout = "request_timeout"
+
+// SchemaFieldMaxConcurrentRequests the name of the provider configuration option for the max number of concurrent requests
+const SchemaFieldMaxConcurrentRequests = "max_concurrent_requests"
+
+// SchemaFieldCACertificate the name of the provider configuration option for the custom CA certificate
+const SchemaFieldCACertificate = "ca_certificate"
+
+// SchemaFieldClientCertificate the name of the provider configuration option for the client certificate of mutual TLS
+const SchemaFieldClientCertificate = "client_certificate"
+
+// SchemaFieldClientKey the name of the provider configuration option for the private key of the client certificate
+const SchemaFieldClientKey = "client_key"
+
+// SchemaFieldProxyURL the name of the provider configuration option for the HTTP proxy
+const SchemaFieldProxyURL = "proxy_url"
+
+// SchemaFieldExpectedTenant the name of the provider configuration option for the tenant the endpoint must belong to
+const SchemaFieldExpectedTenant = "expected_tenant"
+
+// SchemaFieldExpectedUnit the name of the provider configuration option for the unit the endpoint must belong to
+const SchemaFieldExpectedUnit = "expected_unit"
+
 // CorrelationIDHeader is the HTTP header name for correlation ID
 const CorrelationIDHeader = "X-Correlation-ID"
 
 // InstanaProviderModel describes the provider data model
 type InstanaProviderModel struct {
-	APIToken      types.String `tfsdk:"api_token"`
+	APIToken        types.String `tfsdk:"api_token"`
+	APITokenFile    types.String `tfsdk:"api_token_file"`
+	APITokenCommand types.String `tfsdk:"api_token_command"`
 	Endpoint      types.String `tfsdk:"endpoint"`
 	TLSSkipVerify types.Bool   `tfsdk:"tls_skip_verify"`
+
+	MaxRetries            types.Int64  `tfsdk:"max_retries"`
+	RetryMinBackoff       types.String `tfsdk:"retry_min_backoff"`
+	RetryMaxBackoff       types.String `tfsdk:"retry_max_backoff"`
+	RespectRetryAfter     types.Bool   `tfsdk:"respect_retry_after"`
+	RequestTimeout        types.String `tfsdk:"request_timeout"`
+	MaxConcurrentRequests types.Int64  `tfsdk:"max_concurrent_requests"`
+
+	CACertificate     types.String `tfsdk:"ca_certificate"`
+	ClientCertificate types.String `tfsdk:"client_certificate"`
+	ClientKey         types.String `tfsdk:"client_key"`
+	ProxyURL          types.String `tfsdk:"proxy_url"`
+
+	ExpectedTenant types.String `tfsdk:"expected_tenant"`
+	ExpectedUnit   types.String `tfsdk:"expected_unit"`
 }
 
 // InstanaProvider is the provider implementation
@@ -119,15 +195,87 @@ func (p *InstanaProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Description: "API token used to authenticate with the Instana Backend. Can also be set via INSTANA_API_TOKEN environment variable.",
 				Optional:    true,
 				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot(SchemaFieldAPITokenFile), path.MatchRoot(SchemaFieldAPITokenCommand)),
+				},
+			},
+			SchemaFieldAPITokenFile: schema.StringAttribute{
+				Description: "Path to a file containing the API token, e.g. written by a vault agent. Leading and trailing whitespace is ignored. Conflicts with api_token and api_token_command. Can also be set via INSTANA_API_TOKEN_FILE environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot(SchemaFieldAPITokenCommand)),
+				},
+			},
+			SchemaFieldAPITokenCommand: schema.StringAttribute{
+				Description: "Command which is executed by the shell of the operating system to print the API token to stdout, like git credential helpers. The first line of the output is used. Conflicts with api_token and api_token_file. Can also be set via INSTANA_API_TOKEN_COMMAND environment variable.",
+				Optional:    true,
 			},
 			SchemaFieldEndpoint: schema.StringAttribute{
-				Description: "The DNS Name of the Instana Endpoint (eg. saas-eu-west-1.instana.io). Can also be set via INSTANA_ENDPOINT environment variable.",
+				Description: "The DNS Name of the Instana Endpoint (eg. saas-eu-west-1.instana.io) or the full URL including scheme, port and path prefix (eg. https://instana.example.com:8443/instana). Bare DNS names are called via HTTPS. Can also be set via INSTANA_ENDPOINT environment variable.",
 				Optional:    true,
+				Validators: []validator.String{
+					endpointValidator{},
+				},
 			},
 			SchemaFieldTlsSkipVerify: schema.BoolAttribute{
 				Description: "If set to true, TLS verification will be skipped when calling Instana API",
 				Optional:    true,
 			},
+			SchemaFieldMaxRetries: schema.Int64Attribute{
+				Description: "The maximum number of retries of a request which was throttled (HTTP 429) or failed temporarily (HTTP 502, 503, 504 or network error). 0 disables retries. Default 3. Can also be set via INSTANA_MAX_RETRIES environment variable.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			SchemaFieldRetryMinBackoff: schema.StringAttribute{
+				Description: "The wait time before the first retry as duration (e.g. 500ms, 2s). The wait time doubles with every retry. Default 1s. Can also be set via INSTANA_RETRY_MIN_BACKOFF environment variable.",
+				Optional:    true,
+			},
+			SchemaFieldRetryMaxBackoff: schema.StringAttribute{
+				Description: "The maximum wait time between retries as duration (e.g. 30s, 1m). Default 30s. Can also be set via INSTANA_RETRY_MAX_BACKOFF environment variable.",
+				Optional:    true,
+			},
+			SchemaFieldRespectRetryAfter: schema.BoolAttribute{
+				Description: "If set to true, the provider waits for the time requested by the Retry-After header of throttled responses instead of the backoff. Default true. Can also be set via INSTANA_RESPECT_RETRY_AFTER environment variable.",
+				Optional:    true,
+			},
+			SchemaFieldRequestTimeout: schema.StringAttribute{
+				Description: "The timeout of a single request to the Instana API as duration (e.g. 30s, 2m). Retries are not included. Can also be set via INSTANA_REQUEST_TIMEOUT environment variable.",
+				Optional:    true,
+			},
+			SchemaFieldMaxConcurrentRequests: schema.Int64Attribute{
+				Description: "The maximum number of concurrent requests to the Instana API. 0 means unlimited. Default 0. Can also be set via INSTANA_MAX_CONCURRENT_REQUESTS environment variable.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			SchemaFieldCACertificate: schema.StringAttribute{
+				Description: "PEM encoded CA certificate(s) or the path to a PEM file used to verify the certificate of the Instana backend in addition to the CA certificates of the system. Can also be set via INSTANA_CA_CERTIFICATE environment variable.",
+				Optional:    true,
+			},
+			SchemaFieldClientCertificate: schema.StringAttribute{
+				Description: "PEM encoded client certificate or the path to a PEM file used for mutual TLS authentication. Requires client_key. Can also be set via INSTANA_CLIENT_CERTIFICATE environment variable.",
+				Optional:    true,
+			},
+			SchemaFieldClientKey: schema.StringAttribute{
+				Description: "PEM encoded private key of the client certificate or the path to a PEM file. Requires client_certificate. Can also be set via INSTANA_CLIENT_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			SchemaFieldProxyURL: schema.StringAttribute{
+				Description: "The URL of the proxy used to call the Instana API (e.g. http://proxy.example.com:3128). Supported schemes are http, https and socks5. Can also be set via INSTANA_PROXY_URL environment variable.",
+				Optional:    true,
+			},
+			SchemaFieldExpectedTenant: schema.StringAttribute{
+				Description: "The tenant the endpoint must belong to. When set, the provider verifies the tenant and the API token against the backend during configuration and fails before any resource is read or changed. The Instana API does not provide the tenant, so it is determined from the host name of endpoints following the naming scheme <unit>-<tenant>.<domain>; for other endpoints only the API token is verified. Can also be set via INSTANA_EXPECTED_TENANT environment variable.",
+				Optional:    true,
+			},
+			SchemaFieldExpectedUnit: schema.StringAttribute{
+				Description: "The tenant unit the endpoint must belong to. When set, the provider verifies the unit and the API token against the backend during configuration and fails before any resource is read or changed. The Instana API does not provide the unit, so it is determined from the host name of endpoints following the naming scheme <unit>-<tenant>.<domain>; for other endpoints only the API token is verified. Can also be set via INSTANA_EXPECTED_UNIT environment variable.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -143,10 +291,8 @@ func (p *InstanaProvider) Configure(ctx context.Context, req provider.ConfigureR
 	}
 
 	// Default values to environment variables, but override with Terraform configuration value if set
-	apiToken := strings.TrimSpace(providerConfig.APIToken.ValueString())
-	if apiToken == "" {
-		apiToken = os.Getenv("INSTANA_API_TOKEN")
-	}
+	apiToken, diags := resolveAPIToken(ctx, providerConfig)
+	resp.Diagnostics.Append(diags...)
 
 	endpoint := strings.TrimSpace(providerConfig.Endpoint.ValueString())
 	if endpoint == "" {
@@ -154,16 +300,6 @@ func (p *InstanaProvider) Configure(ctx context.Context, req provider.ConfigureR
 	}
 
 	// Validate that required values are present
-	if apiToken == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("api_token"),
-			"Missing Instana API Token",
-			"The provider cannot create the Instana API client as there is a missing or empty value for the Instana API token. "+
-				"Set the api_token value in the configuration or use the INSTANA_API_TOKEN environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
-	}
-
 	if endpoint == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("endpoint"),
@@ -177,12 +313,18 @@ func (p *InstanaProvider) Configure(ctx context.Context, req provider.ConfigureR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	baseURL, err := normalizeEndpoint(endpoint)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("endpoint"), "Invalid Instana Endpoint", err.Error())
+		return
+	}
 	// Create a new Instana client using the configuration values
 	// Pass the provider version as user agent for tracking
 	userAgent := fmt.Sprintf("Terraform/%s", p.version)
 	clientConfig := config.DefaultClientConfig()
 	clientConfig.APIToken = apiToken
-	clientConfig.BaseURL = "https://" + endpoint
+	clientConfig.BaseURL = baseURL
 	clientConfig.UserAgent = userAgent
 	clientConfig.Logger = newTerraformLogger(ctx)
 	if clientConfig.Headers.Custom == nil {
@@ -190,24 +332,35 @@ func (p *InstanaProvider) Configure(ctx context.Context, req provider.ConfigureR
 	}
 	clientConfig.Headers.Custom[CorrelationIDHeader] = util.GenerateCorrelationID()
 
-	// Handle TLS skip verify by creating a custom HTTP client with appropriate transport
-	if !providerConfig.TLSSkipVerify.IsNull() && providerConfig.TLSSkipVerify.ValueBool() {
-		// Create HTTP client with TLS skip verify enabled
-		transport := &http.Transport{
-			MaxIdleConns:        clientConfig.ConnectionPool.MaxIdleConnections,
-			MaxIdleConnsPerHost: clientConfig.ConnectionPool.MaxConnectionsPerHost,
-			IdleConnTimeout:     clientConfig.Timeout.IdleConnection,
-			DisableKeepAlives:   false,
-			TLSClientConfig:     &tls.Config{InsecureSkipVerify: true}, //nolint:gosec
-		}
-		clientConfig.HTTPClient = &http.Client{
-			Transport: transport,
-			Timeout:   clientConfig.Timeout.Request,
-		}
+	// Retries, timeouts, concurrency, TLS and proxy settings are applied by a custom HTTP client which is shared
+	// by the instana-go-client and the REST client of the provider
+	httpSettings, diags := resolveHTTPClientSettings(providerConfig, clientConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clientConfig.Timeout.Request = httpSettings.RequestTimeout
+	clientConfig.HTTPClient, diags = newHTTPClient(clientConfig, httpSettings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if httpSettings.TLSSkipVerify {
 		tflog.Warn(ctx, "TLS certificate verification is disabled - this should only be used in development/testing environments")
 	}
-
-	instanaAPI, err := client.NewInstanaAPIWithConfig(clientConfig)
+	tflog.Debug(ctx, "Configured HTTP client for Instana API", map[string]interface{}{
+		"max_retries":             httpSettings.MaxRetries,
+		"retry_min_backoff":       httpSettings.RetryMinBackoff.String(),
+		"retry_max_backoff":       httpSettings.RetryMaxBackoff.String(),
+		"respect_retry_after":     httpSettings.RespectRetryAfter,
+		"request_timeout":         httpSettings.RequestTimeout.String(),
+		"max_concurrent_requests": httpSettings.MaxConcurrentRequests,
+		"custom_ca_certificate":   httpSettings.CACertificate != "",
+		"client_certificate":      httpSettings.ClientCertificate != "",
+		"proxy":                   httpSettings.ProxyURL != "",
+	})
+
+	goClientAPI, err := client.NewInstanaAPIWithConfig(clientConfig)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to create Instana API client",
@@ -215,8 +368,18 @@ func (p *InstanaProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 		return
 	}
+	// Extend the client with the endpoints which are not yet covered by the instana-go-client
+	instanaAPI := instanaapi.NewInstanaAPI(goClientAPI, clientConfig)
+
+	// Fail fast when the endpoint does not belong to the expected tenant unit
+	if expectedIdentity := resolveExpectedBackendIdentity(providerConfig); expectedIdentity.isSet() {
+		resp.Diagnostics.Append(verifyBackendIdentity(instanaAPI.BackendInfo(), expectedIdentity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
-	// Make the Instana client available during DataSource and Resource Configure methods
+	// Make the Instana client available during DataSource, Resource and EphemeralResource Configure methods
 	resp.DataSourceData = &shared.ProviderMeta{
 		InstanaAPI:   instanaAPI,
 		ClientConfig: clientConfig,
@@ -225,6 +388,10 @@ func (p *InstanaProvider) Configure(ctx context.Context, req provider.ConfigureR
 		InstanaAPI:   instanaAPI,
 		ClientConfig: clientConfig,
 	}
+	resp.EphemeralResourceData = &shared.ProviderMeta{
+		InstanaAPI:   instanaAPI,
+		ClientConfig: clientConfig,
+	}
 }
 
 type terraformLogger struct {
@@ -287,9 +454,10 @@ func toTerraformLogFields(keysAndValues []interface{}) map[string]interface{} {
 //	sanitizeSensitiveData("password: secret") → "password: [REDACTED]"
 //
 // The function is case-insensitive and matches common variations of sensitive keywords.
-// Uses pre-compiled regex patterns for optimal performance.
+// Uses pre-compiled regex patterns for optimal performance. In addition, the secrets known at runtime
+// (e.g. the API token read from a file or command) are redacted wherever they appear.
 func sanitizeSensitiveData(msg string) string {
-	sanitized := msg
+	sanitized := redactSensitiveValues(msg)
 	for _, re := range sensitiveDataPatterns {
 		sanitized = re.ReplaceAllString(sanitized, "$1: [REDACTED]")
 	}
@@ -338,23 +506,73 @@ func sanitizeLogFields(fields map[string]interface{}) map[string]interface{} {
 
 // DataSources defines the data sources implemented in the provider
 func (p *InstanaProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{
+	dataSources := []func() datasource.DataSource{
 		// Add data sources here when implemented
+		datasources.NewAlertConfigVersionsDataSource,
 		datasources.NewAlertingChannelDataSource,
 		datasources.NewAutomationActionDataSource,
+		datasources.NewBackendInfoDataSource,
 		datasources.NewBuiltinEventDataSource,
 		datasources.NewCustomEventSpecificationDataSource,
+		datasources.NewCustomPayloadTagCatalogDataSource,
 		datasources.NewHostAgentsDataSource,
 		datasources.NewSyntheticLocationDataSource,
 		datasources.NewUserDataSource,
 		datasources.NewRbacRoleDataSource,
 		datasources.NewRbacTeamDataSource,
 	}
+	for _, registration := range resourceHandleRegistrations() {
+		if registration.listDataSource != nil {
+			dataSources = append(dataSources, registration.listDataSource)
+		}
+	}
+	return dataSources
 }
 
 // Resources defines the resources implemented in the provider
 func (p *InstanaProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	var resources []func() resource.Resource
+	for _, registration := range resourceHandleRegistrations() {
+		resources = append(resources, registration.resource)
+	}
+	return append(resources,
+		addSingletonResourceHandle(sessionsettings.NewSessionSettingsResourceHandle),
+		addSingletonResourceHandle(custompayloadconfig.NewGlobalCustomPayloadConfigResourceHandle),
+		addSingletonResourceHandle(syntheticcallssettings.NewSyntheticCallsSettingsResourceHandle),
+	)
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider
+func (p *InstanaProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		apitoken.NewAPITokenEphemeralResource,
+	}
+}
+
+// Functions defines the provider functions implemented in the provider
+func (p *InstanaProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewNormalizeTagFilterFunction,
+		functions.NewTagFilterAndFunction,
+		functions.NewTagFilterOrFunction,
+		functions.NewTagFilterComparisonFunction,
+		functions.NewSeverityFromAPIFunction,
+		functions.NewSeverityToAPIFunction,
+		functions.NewDurationMsFunction,
+	}
+}
+
+// resourceHandleRegistration the resource, the optional plural list data source and the optional export resource type
+// of a ResourceHandle
+type resourceHandleRegistration struct {
+	resource           func() resource.Resource
+	listDataSource     func() datasource.DataSource
+	exportResourceType func() exporter.ResourceType
+}
+
+// resourceHandleRegistrations returns the registrations of all ResourceHandles of the provider
+func resourceHandleRegistrations() []resourceHandleRegistration {
+	return []resourceHandleRegistration{
 		// Add resources here -
 		addResouceHandle(alertingconfig.NewAlertingConfigResourceHandle),
 		addResouceHandle(logalertconfig.NewLogAlertConfigResourceHandle),
@@ -364,6 +582,9 @@ func (p *InstanaProvider) Resources(_ context.Context) []func() resource.Resourc
 		addResouceHandle(applicationalertconfig.NewApplicationAlertConfigResourceHandle),
 		addResouceHandle(applicationalertconfig.NewGlobalApplicationAlertConfigResourceHandle),
 		addResouceHandle(applicationconfig.NewApplicationConfigResourceHandle),
+		addResouceHandle(httpendpointconfig.NewHTTPEndpointConfigResourceHandle),
+		addResouceHandle(serviceconfig.NewServiceConfigResourceHandle),
+		addResouceHandle(manualservice.NewManualServiceResourceHandle),
 		addResouceHandle(automationaction.NewAutomationActionResourceHandle),
 		addResouceHandle(automationpolicy.NewAutomationPolicyResourceHandle),
 		addResouceHandle(customdashboard.NewCustomDashboardResourceHandle),
@@ -373,6 +594,7 @@ func (p *InstanaProvider) Resources(_ context.Context) []func() resource.Resourc
 		addResouceHandle(mobilealertconfig.NewMobileAlertConfigResourceHandle),
 		addResouceHandle(maintenancewindowconfig.NewMaintenanceWindowConfigResourceHandle),
 		addResouceHandle(group.NewGroupResourceHandle),
+		addResouceHandle(release.NewReleaseResourceHandle),
 		addResouceHandle(groupmapping.NewGroupMappingResourceHandle),
 		addResouceHandle(team.NewTeamResourceHandle),
 		addResouceHandle(roles.NewRoleResourceHandle),
@@ -383,17 +605,36 @@ func (p *InstanaProvider) Resources(_ context.Context) []func() resource.Resourc
 		addResouceHandle(syntheticcredential.NewSyntheticCredentialResourceHandle),
 		addResouceHandle(synthetictest.NewSyntheticTestResourceHandle),
 		addResouceHandle(websitealertconfig.NewWebsiteAlertConfigResourceHandle),
+		addResouceHandle(alertconfigversionpin.NewAlertConfigVersionPinResourceHandle),
+		addResouceHandle(builtineventstate.NewBuiltinEventStateResourceHandle),
 		addResouceHandle(websitemonitoringconfig.NewWebsiteMonitoringConfigResourceHandle),
+		addResouceHandle(eumconfigsettings.NewWebsiteGeoLocationConfigResourceHandle),
+		addResouceHandle(eumconfigsettings.NewWebsiteGeoMappingRulesResourceHandle),
+		addResouceHandle(eumconfigsettings.NewWebsiteIPMaskingConfigResourceHandle),
+		addResouceHandle(eumconfigsettings.NewMobileAppGeoLocationConfigResourceHandle),
+		addResouceHandle(eumconfigsettings.NewMobileAppGeoMappingRulesResourceHandle),
+		addResouceHandle(eumconfigsettings.NewMobileAppIPMaskingConfigResourceHandle),
 		addResouceHandle(sloconfig.NewSloConfigResourceHandle),
-		addSingletonResourceHandle(sessionsettings.NewSessionSettingsResourceHandle),
 	}
 }
 
-// addResouceHandle wraps a ResourceHandle constructor for use in the Resources list.
-func addResouceHandle[T client.InstanaDataObject](handleFunc func() resourcehandle.ResourceHandle[T]) func() resource.Resource {
-	return func() resource.Resource {
-		return NewTerraformResource(handleFunc())
+// addResouceHandle wraps a ResourceHandle constructor for use in the Resources and DataSources lists. Each handle
+// provides a resource and, unless disabled in the metadata, a plural list data source and an export resource type.
+func addResouceHandle[T client.InstanaDataObject](handleFunc func() resourcehandle.ResourceHandle[T]) resourceHandleRegistration {
+	registration := resourceHandleRegistration{
+		resource: func() resource.Resource {
+			return NewTerraformResource(handleFunc())
+		},
+	}
+	if !handleFunc().MetaData().DisableListDataSource {
+		registration.listDataSource = func() datasource.DataSource {
+			return NewTerraformListDataSource(handleFunc())
+		}
+		registration.exportResourceType = func() exporter.ResourceType {
+			return exporter.NewResourceType(handleFunc())
+		}
 	}
+	return registration
 }
 
 // addSingletonResourceHandle wraps a SingletonResourceHandle constructor for use in the Resources list.
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// retryTransport is a http.RoundTripper which limits the number of concurrent requests to the Instana API and
+// retries throttled (429) and temporarily failing requests with exponential backoff. It is used by the
+// instana-go-client and the REST client of the provider, so all calls of a provider instance share the same limits.
+//
+// Throttled requests and requests answered with 503 Service Unavailable are retried for all HTTP methods as they
+// were not processed by the backend. Other transient failures (502, 504 and network errors) are only retried for
+// idempotent methods to avoid creating duplicate objects.
+type retryTransport struct {
+	next      http.RoundTripper
+	settings  *httpClientSettings
+	semaphore chan struct{}
+	sleep     func(ctx context.Context, d time.Duration) error
+}
+
+func newRetryTransport(next http.RoundTripper, settings *httpClientSettings) *retryTransport {
+	var semaphore chan struct{}
+	if settings.MaxConcurrentRequests > 0 {
+		semaphore = make(chan struct{}, settings.MaxConcurrentRequests)
+	}
+	return &retryTransport{
+		next:      next,
+		settings:  settings,
+		semaphore: semaphore,
+		sleep:     sleepWithContext,
+	}
+}
+
+// RoundTrip implementation of http.RoundTripper
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.roundTripOnce(req)
+		if attempt >= t.settings.MaxRetries || !t.isRetryable(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		if err := t.sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+		if req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+func (t *retryTransport) roundTripOnce(req *http.Request) (*http.Response, error) {
+	if t.semaphore != nil {
+		select {
+		case t.semaphore <- struct{}{}:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		defer func() { <-t.semaphore }()
+	}
+
+	if t.settings.RequestTimeout <= 0 {
+		return t.next.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), t.settings.RequestTimeout)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// the timeout covers reading the response body, so the context is cancelled when the body is closed
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (t *retryTransport) isRetryable(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+	if err != nil {
+		return isIdempotentMethod(req.Method)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return isIdempotentMethod(req.Method)
+	default:
+		return false
+	}
+}
+
+// backoff returns the time to wait before the next attempt. The Retry-After header of the response takes precedence
+// when enabled; otherwise the wait time doubles with every attempt within the configured bounds, with jitter.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if t.settings.RespectRetryAfter && resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return retryAfter
+		}
+	}
+	wait := t.settings.RetryMinBackoff << attempt
+	if wait > t.settings.RetryMaxBackoff || wait <= 0 {
+		wait = t.settings.RetryMaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+	return wait/2 + rand.N(wait/2+1) //nolint:gosec
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/instana/instana-go-client/config"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testThrottledPath = "/api/test/throttled"
+
+func newTestHTTPSettings() *httpClientSettings {
+	return &httpClientSettings{
+		TLSSkipVerify:     true,
+		MaxRetries:        3,
+		RetryMinBackoff:   time.Millisecond,
+		RetryMaxBackoff:   4 * time.Millisecond,
+		RespectRetryAfter: true,
+	}
+}
+
+// newTestRetryClient creates the HTTP client for the test server and records the wait times instead of sleeping
+func newTestRetryClient(server testutils.TestHTTPServer, settings *httpClientSettings) (*config.ClientConfig, *[]time.Duration) {
+	clientConfig := config.DefaultClientConfig()
+	clientConfig.BaseURL = fmt.Sprintf("https://localhost:%d", server.GetPort())
+	clientConfig.HTTPClient, _ = newHTTPClient(clientConfig, settings)
+
+	waits := &[]time.Duration{}
+	clientConfig.HTTPClient.Transport.(*retryTransport).sleep = func(_ context.Context, d time.Duration) error {
+		*waits = append(*waits, d)
+		return nil
+	}
+	return clientConfig, waits
+}
+
+// addThrottledRoute adds a route which answers the first calls with the given status code and then with 200
+func addThrottledRoute(server testutils.TestHTTPServer, method string, failures int, statusCode int, retryAfter string) {
+	server.AddRoute(method, testThrottledPath, func(w http.ResponseWriter, r *http.Request) {
+		if server.GetCallCount(method, testThrottledPath) <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(statusCode)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		server.WriteJSONResponse(w, []byte(fmt.Sprintf(`{"body":%q}`, string(body))))
+	})
+}
+
+func TestShouldRetryThrottledRequestsHonoringRetryAfter(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	addThrottledRoute(server, http.MethodGet, 2, http.StatusTooManyRequests, "7")
+	server.Start()
+	defer server.Close()
+
+	clientConfig, waits := newTestRetryClient(server, newTestHTTPSettings())
+
+	response, err := instanaapi.NewRestClient(clientConfig).Get(testThrottledPath)
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"body":""}`, string(response))
+	assert.Equal(t, 3, server.GetCallCount(http.MethodGet, testThrottledPath))
+	assert.Equal(t, []time.Duration{7 * time.Second, 7 * time.Second}, *waits)
+}
+
+func TestShouldUseExponentialBackoffWhenRetryAfterIsNotRespected(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	addThrottledRoute(server, http.MethodGet, 3, http.StatusTooManyRequests, "7")
+	server.Start()
+	defer server.Close()
+
+	settings := newTestHTTPSettings()
+	settings.RespectRetryAfter = false
+	clientConfig, waits := newTestRetryClient(server, settings)
+
+	_, err := instanaapi.NewRestClient(clientConfig).Get(testThrottledPath)
+
+	require.NoError(t, err)
+	require.Len(t, *waits, 3)
+	for i, maxWait := range []time.Duration{time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond} {
+		assert.GreaterOrEqual(t, (*waits)[i], maxWait/2)
+		assert.LessOrEqual(t, (*waits)[i], maxWait)
+	}
+}
+
+func TestShouldReturnLastResponseWhenRetriesAreExhausted(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	addThrottledRoute(server, http.MethodGet, 10, http.StatusServiceUnavailable, "")
+	server.Start()
+	defer server.Close()
+
+	settings := newTestHTTPSettings()
+	settings.MaxRetries = 2
+	clientConfig, _ := newTestRetryClient(server, settings)
+
+	_, err := instanaapi.NewRestClient(clientConfig).Get(testThrottledPath)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status code = 503")
+	assert.Equal(t, 3, server.GetCallCount(http.MethodGet, testThrottledPath))
+}
+
+func TestShouldNotRetryWhenRetriesAreDisabled(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	addThrottledRoute(server, http.MethodGet, 1, http.StatusTooManyRequests, "")
+	server.Start()
+	defer server.Close()
+
+	settings := newTestHTTPSettings()
+	settings.MaxRetries = 0
+	clientConfig, _ := newTestRetryClient(server, settings)
+
+	_, err := instanaapi.NewRestClient(clientConfig).Get(testThrottledPath)
+
+	require.Error(t, err)
+	assert.Equal(t, 1, server.GetCallCount(http.MethodGet, testThrottledPath))
+}
+
+func TestShouldResendBodyWhenThrottledPostRequestIsRetried(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	addThrottledRoute(server, http.MethodPost, 1, http.StatusTooManyRequests, "")
+	server.Start()
+	defer server.Close()
+
+	clientConfig, _ := newTestRetryClient(server, newTestHTTPSettings())
+
+	response, err := instanaapi.NewRestClient(clientConfig).Post(testThrottledPath, map[string]string{"name": "test"})
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"body":"{\"name\":\"test\"}"}`, string(response))
+	assert.Equal(t, 2, server.GetCallCount(http.MethodPost, testThrottledPath))
+}
+
+func TestShouldNotRetryNonIdempotentRequestsOnBadGateway(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	addThrottledRoute(server, http.MethodPost, 1, http.StatusBadGateway, "")
+	addThrottledRoute(server, http.MethodPut, 1, http.StatusBadGateway, "")
+	server.Start()
+	defer server.Close()
+
+	clientConfig, _ := newTestRetryClient(server, newTestHTTPSettings())
+	restClient := instanaapi.NewRestClient(clientConfig)
+
+	_, err := restClient.Post(testThrottledPath, map[string]string{"name": "test"})
+	require.Error(t, err)
+	assert.Equal(t, 1, server.GetCallCount(http.MethodPost, testThrottledPath))
+
+	_, err = restClient.Put(testThrottledPath, map[string]string{"name": "test"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, server.GetCallCount(http.MethodPut, testThrottledPath))
+}
+
+func TestShouldRetryRequestsWhichExceedTheRequestTimeout(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodGet, testThrottledPath, func(w http.ResponseWriter, _ *http.Request) {
+		if server.GetCallCount(http.MethodGet, testThrottledPath) == 1 {
+			time.Sleep(500 * time.Millisecond)
+		}
+		server.WriteJSONResponse(w, []byte(`{}`))
+	})
+	server.Start()
+	defer server.Close()
+
+	settings := newTestHTTPSettings()
+	settings.RequestTimeout = 100 * time.Millisecond
+	clientConfig, _ := newTestRetryClient(server, settings)
+
+	_, err := instanaapi.NewRestClient(clientConfig).Get(testThrottledPath)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, server.GetCallCount(http.MethodGet, testThrottledPath))
+}
+
+func TestShouldLimitConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodGet, testThrottledPath, func(w http.ResponseWriter, _ *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			observed := maxInFlight.Load()
+			if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		server.WriteJSONResponse(w, []byte(`{}`))
+	})
+	server.Start()
+	defer server.Close()
+
+	settings := newTestHTTPSettings()
+	settings.MaxConcurrentRequests = 2
+	clientConfig, _ := newTestRetryClient(server, settings)
+	restClient := instanaapi.NewRestClient(clientConfig)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := restClient.Get(testThrottledPath)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 8, server.GetCallCount(http.MethodGet, testThrottledPath))
+	assert.Equal(t, int32(2), maxInFlight.Load())
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	wait, ok := parseRetryAfter("12")
+	assert.True(t, ok)
+	assert.Equal(t, 12*time.Second, wait)
+
+	wait, ok = parseRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.InDelta(t, time.Hour.Seconds(), wait.Seconds(), 2)
+
+	wait, ok = parseRetryAfter(time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), wait)
+
+	for _, value := range []string{"", "-1", "soon"} {
+		_, ok = parseRetryAfter(value)
+		assert.False(t, ok, value)
+	}
+}
@@ -0,0 +1,354 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+)
+
+// Attributes of the list data sources
+const (
+	// ListDataSourceFieldID the id of the list data source
+	ListDataSourceFieldID = "id"
+	// ListDataSourceFieldNameRegex the regular expression the name of the listed objects must match
+	ListDataSourceFieldNameRegex = "name_regex"
+	// ListDataSourceFieldLabel the label the listed objects must have
+	ListDataSourceFieldLabel = "label"
+	// ListDataSourceFieldTags the tags the listed objects must have
+	ListDataSourceFieldTags = "tags"
+	// ListDataSourceFieldIDs the IDs of the listed objects
+	ListDataSourceFieldIDs = "ids"
+	// ListDataSourceFieldItems the listed objects
+	ListDataSourceFieldItems = "items"
+)
+
+// NewTerraformListDataSource creates a new terraform data source which lists all objects of the given handle. The data
+// source is named after the plural of the resource name and provides the objects with the schema of the resource.
+func NewTerraformListDataSource[T client.InstanaDataObject](handle resourcehandle.ResourceHandle[T]) datasource.DataSource {
+	return &terraformListDataSourceImpl[T]{
+		resourceHandle: handle,
+	}
+}
+
+type terraformListDataSourceImpl[T client.InstanaDataObject] struct {
+	resourceHandle resourcehandle.ResourceHandle[T]
+	providerMeta   *shared.ProviderMeta
+}
+
+// listDataSourceModel the filters and results of a list data source. Filter attributes which are not supported by the
+// listed resource are not part of the schema, so they are read individually.
+type listDataSourceModel struct {
+	NameRegex types.String
+	Label     types.String
+	Tags      []string
+}
+
+// Metadata returns the data source type name
+func (d *terraformListDataSourceImpl[T]) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + pluralize(d.resourceHandle.MetaData().ResourceName)
+}
+
+// Schema defines the schema for the data source
+func (d *terraformListDataSourceImpl[T]) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	metaData := d.resourceHandle.MetaData()
+	attributes := map[string]schema.Attribute{
+		ListDataSourceFieldID: schema.StringAttribute{
+			Description: "The ID of the data source.",
+			Computed:    true,
+		},
+		ListDataSourceFieldIDs: schema.ListAttribute{
+			Description: fmt.Sprintf("The IDs of the matching %s objects sorted by ID.", metaData.ResourceName),
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		ListDataSourceFieldItems: schema.ListAttribute{
+			Description: fmt.Sprintf("The matching %s objects sorted by ID. Each item has the attributes of the %s resource.", metaData.ResourceName, metaData.ResourceName),
+			Computed:    true,
+			Sensitive:   hasSensitiveAttributes(metaData.Schema.Attributes, metaData.Schema.Blocks),
+			ElementType: metaData.Schema.Type(),
+		},
+	}
+	if d.nameAttribute() != "" {
+		attributes[ListDataSourceFieldNameRegex] = schema.StringAttribute{
+			Description: fmt.Sprintf("Optional regular expression the %s of the objects must match.", d.nameAttribute()),
+			Optional:    true,
+		}
+	}
+	if isStringAttribute(metaData.Schema, ListDataSourceFieldLabel) {
+		attributes[ListDataSourceFieldLabel] = schema.StringAttribute{
+			Description: "Optional label the objects must have.",
+			Optional:    true,
+		}
+	}
+	if isStringCollectionAttribute(metaData.Schema, ListDataSourceFieldTags) {
+		attributes[ListDataSourceFieldTags] = schema.SetAttribute{
+			Description: "Optional tags the objects must have. Objects match when they have all of the given tags.",
+			Optional:    true,
+			ElementType: types.StringType,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Data source to list all %s objects, optionally filtered by name, label or tags.", metaData.ResourceName),
+		Attributes:  attributes,
+	}
+}
+
+// Configure stores the provider meta for use by the data source
+func (d *terraformListDataSourceImpl[T]) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerMeta, ok := req.ProviderData.(*shared.ProviderMeta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *shared.ProviderMeta, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerMeta = providerMeta
+}
+
+// Read lists all objects of the resource and sets the objects matching the filters
+func (d *terraformListDataSourceImpl[T]) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.providerMeta == nil || d.providerMeta.InstanaAPI == nil {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before reading the data source, likely because it depends on an unknown value from another resource.",
+		)
+		return
+	}
+
+	filters, diags := d.readFilters(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !filters.NameRegex.IsNull() && !filters.NameRegex.IsUnknown() {
+		var err error
+		nameRegex, err = regexp.Compile(filters.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(ListDataSourceFieldNameRegex), "Invalid Name Regex", fmt.Sprintf("The name regex is not a valid regular expression: %s", err))
+			return
+		}
+	}
+
+	resourceName := d.resourceHandle.MetaData().ResourceName
+	tflog.Debug(ctx, "Listing objects from API", map[string]interface{}{
+		"resource": resourceName,
+	})
+
+	objects, err := d.resourceHandle.GetRestResource(d.providerMeta.InstanaAPI).GetAll()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing objects",
+			fmt.Sprintf("Could not list %s objects: %s", resourceName, err),
+		)
+		return
+	}
+
+	var matches []T
+	if objects != nil {
+		matches = slices.Clone(*objects)
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].GetIDForResourcePath() < matches[j].GetIDForResourcePath()
+	})
+
+	ids := make([]attr.Value, 0, len(matches))
+	items := make([]attr.Value, 0, len(matches))
+	for _, obj := range matches {
+		state, diags := resourcehandle.MapObjectToState(ctx, d.resourceHandle, obj)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		match, diags := d.matchesFilters(ctx, state, filters, nameRegex)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !match {
+			continue
+		}
+
+		item, err := state.Schema.Type().ValueFromTerraform(ctx, state.Raw)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error mapping object",
+				fmt.Sprintf("Could not map %s object %s: %s", resourceName, obj.GetIDForResourcePath(), err),
+			)
+			return
+		}
+		ids = append(ids, types.StringValue(obj.GetIDForResourcePath()))
+		items = append(items, item)
+	}
+
+	itemsValue, diags := types.ListValue(d.resourceHandle.MetaData().Schema.Type(), items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(ListDataSourceFieldID), types.StringValue(pluralize(resourceName)))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(ListDataSourceFieldIDs), types.ListValueMust(types.StringType, ids))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(ListDataSourceFieldItems), itemsValue)...)
+}
+
+// readFilters reads the filter attributes supported by the listed resource from the configuration
+func (d *terraformListDataSourceImpl[T]) readFilters(ctx context.Context, config tfsdk.Config) (listDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	filters := listDataSourceModel{NameRegex: types.StringNull(), Label: types.StringNull()}
+	metaData := d.resourceHandle.MetaData()
+
+	if d.nameAttribute() != "" {
+		diags.Append(config.GetAttribute(ctx, path.Root(ListDataSourceFieldNameRegex), &filters.NameRegex)...)
+	}
+	if isStringAttribute(metaData.Schema, ListDataSourceFieldLabel) {
+		diags.Append(config.GetAttribute(ctx, path.Root(ListDataSourceFieldLabel), &filters.Label)...)
+	}
+	if isStringCollectionAttribute(metaData.Schema, ListDataSourceFieldTags) {
+		diags.Append(config.GetAttribute(ctx, path.Root(ListDataSourceFieldTags), &filters.Tags)...)
+	}
+	return filters, diags
+}
+
+// matchesFilters returns true when the mapped object matches all of the given filters
+func (d *terraformListDataSourceImpl[T]) matchesFilters(ctx context.Context, state *tfsdk.State, filters listDataSourceModel, nameRegex *regexp.Regexp) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if nameRegex != nil {
+		var name types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(d.nameAttribute()), &name)...)
+		if diags.HasError() || !nameRegex.MatchString(name.ValueString()) {
+			return false, diags
+		}
+	}
+
+	if !filters.Label.IsNull() && !filters.Label.IsUnknown() {
+		var label types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(ListDataSourceFieldLabel), &label)...)
+		if diags.HasError() || label.ValueString() != filters.Label.ValueString() {
+			return false, diags
+		}
+	}
+
+	if len(filters.Tags) > 0 {
+		var tags []string
+		diags.Append(state.GetAttribute(ctx, path.Root(ListDataSourceFieldTags), &tags)...)
+		if diags.HasError() {
+			return false, diags
+		}
+		for _, tag := range filters.Tags {
+			if !slices.Contains(tags, tag) {
+				return false, diags
+			}
+		}
+	}
+	return true, diags
+}
+
+// nameAttribute returns the resource attribute holding the name of the objects or an empty string when the resource
+// has no name
+func (d *terraformListDataSourceImpl[T]) nameAttribute() string {
+	for _, attribute := range objectNameAttributes {
+		if isStringAttribute(d.resourceHandle.MetaData().Schema, attribute) {
+			return attribute
+		}
+	}
+	return ""
+}
+
+func isStringAttribute(resourceSchema resourceschema.Schema, name string) bool {
+	attribute, ok := resourceSchema.Attributes[name]
+	return ok && attribute.GetType().Equal(types.StringType)
+}
+
+func isStringCollectionAttribute(resourceSchema resourceschema.Schema, name string) bool {
+	attribute, ok := resourceSchema.Attributes[name]
+	if !ok {
+		return false
+	}
+	attributeType := attribute.GetType()
+	return attributeType.Equal(types.SetType{ElemType: types.StringType}) || attributeType.Equal(types.ListType{ElemType: types.StringType})
+}
+
+// hasSensitiveAttributes returns true when any of the given attributes or the attributes of the given blocks is
+// sensitive
+func hasSensitiveAttributes(attributes map[string]resourceschema.Attribute, blocks map[string]resourceschema.Block) bool {
+	for _, attribute := range attributes {
+		if attribute.IsSensitive() {
+			return true
+		}
+		switch a := attribute.(type) {
+		case resourceschema.SingleNestedAttribute:
+			if hasSensitiveAttributes(a.Attributes, nil) {
+				return true
+			}
+		case resourceschema.ListNestedAttribute:
+			if hasSensitiveAttributes(a.NestedObject.Attributes, nil) {
+				return true
+			}
+		case resourceschema.SetNestedAttribute:
+			if hasSensitiveAttributes(a.NestedObject.Attributes, nil) {
+				return true
+			}
+		case resourceschema.MapNestedAttribute:
+			if hasSensitiveAttributes(a.NestedObject.Attributes, nil) {
+				return true
+			}
+		}
+	}
+	for _, block := range blocks {
+		switch b := block.(type) {
+		case resourceschema.SingleNestedBlock:
+			if hasSensitiveAttributes(b.Attributes, b.Blocks) {
+				return true
+			}
+		case resourceschema.ListNestedBlock:
+			if hasSensitiveAttributes(b.NestedObject.Attributes, b.NestedObject.Blocks) {
+				return true
+			}
+		case resourceschema.SetNestedBlock:
+			if hasSensitiveAttributes(b.NestedObject.Attributes, b.NestedObject.Blocks) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// pluralize returns the plural of the given snake case resource name, e.g. automation_policy becomes
+// automation_policies
+func pluralize(name string) string {
+	switch {
+	case strings.HasSuffix(name, "y") && !strings.HasSuffix(name, "ay") && !strings.HasSuffix(name, "ey"):
+		return strings.TrimSuffix(name, "y") + "ies"
+	case strings.HasSuffix(name, "s"), strings.HasSuffix(name, "x"), strings.HasSuffix(name, "ch"), strings.HasSuffix(name, "sh"):
+		return name + "es"
+	default:
+		return name + "s"
+	}
+}
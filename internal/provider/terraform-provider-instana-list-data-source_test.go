@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testListObject struct {
+	ID   string
+	Name string
+	Tags []string
+}
+
+func (o *testListObject) GetIDForResourcePath() string {
+	return o.ID
+}
+
+type testListObjectModel struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Tags   []string     `tfsdk:"tags"`
+	Secret types.String `tfsdk:"secret"`
+}
+
+type testListRestResource struct {
+	rest.RestResource[*testListObject]
+	objects []*testListObject
+	err     error
+}
+
+func (r *testListRestResource) GetAll() (*[]*testListObject, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return &r.objects, nil
+}
+
+type testListResourceHandle struct {
+	resourcehandle.ResourceHandle[*testListObject]
+	restResource *testListRestResource
+	schema       schema.Schema
+}
+
+func newTestListResourceHandle(restResource *testListRestResource) *testListResourceHandle {
+	return &testListResourceHandle{
+		restResource: restResource,
+		schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"id":     schema.StringAttribute{Computed: true},
+				"name":   schema.StringAttribute{Required: true},
+				"tags":   schema.SetAttribute{Optional: true, ElementType: types.StringType},
+				"secret": schema.StringAttribute{Optional: true, Sensitive: true},
+			},
+		},
+	}
+}
+
+func (h *testListResourceHandle) MetaData() *resourcehandle.ResourceMetaData {
+	return &resourcehandle.ResourceMetaData{ResourceName: "test_policy", Schema: h.schema, ImportNameAttribute: "name"}
+}
+
+func (h *testListResourceHandle) GetRestResource(_ client.InstanaAPI) rest.RestResource[*testListObject] {
+	return h.restResource
+}
+
+func (h *testListResourceHandle) UpdateState(ctx context.Context, state *tfsdk.State, _ *tfsdk.Plan, obj *testListObject) diag.Diagnostics {
+	return state.Set(ctx, &testListObjectModel{
+		ID:     types.StringValue(obj.ID),
+		Name:   types.StringValue(obj.Name),
+		Tags:   obj.Tags,
+		Secret: types.StringNull(),
+	})
+}
+
+func newConfiguredTestListDataSource(t *testing.T, restResource *testListRestResource) (datasource.DataSource, datasource.SchemaResponse) {
+	dataSource := NewTerraformListDataSource[*testListObject](newTestListResourceHandle(restResource))
+	configureResp := &datasource.ConfigureResponse{}
+	dataSource.(datasource.DataSourceWithConfigure).Configure(context.Background(), datasource.ConfigureRequest{
+		ProviderData: &shared.ProviderMeta{InstanaAPI: &testutils.MockInstanaAPI{}},
+	}, configureResp)
+	require.False(t, configureResp.Diagnostics.HasError())
+
+	schemaResp := datasource.SchemaResponse{}
+	dataSource.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	return dataSource, schemaResp
+}
+
+func readTestListDataSource(t *testing.T, dataSource datasource.DataSource, schemaResp datasource.SchemaResponse, filters map[string]tftypes.Value) *datasource.ReadResponse {
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range filters {
+		values[name] = value
+	}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	dataSource.Read(context.Background(), datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+	return resp
+}
+
+func readListDataSourceIDs(t *testing.T, resp *datasource.ReadResponse) []string {
+	var ids []string
+	diags := resp.State.GetAttribute(context.Background(), path.Root(ListDataSourceFieldIDs), &ids)
+	require.False(t, diags.HasError())
+	return ids
+}
+
+func TestListDataSourceMetadataAndSchema(t *testing.T) {
+	dataSource, schemaResp := newConfiguredTestListDataSource(t, &testListRestResource{})
+
+	metadataResp := &datasource.MetadataResponse{}
+	dataSource.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "instana"}, metadataResp)
+	assert.Equal(t, "instana_test_policies", metadataResp.TypeName)
+
+	attributes := schemaResp.Schema.Attributes
+	assert.Contains(t, attributes, ListDataSourceFieldNameRegex)
+	assert.Contains(t, attributes, ListDataSourceFieldTags)
+	assert.NotContains(t, attributes, ListDataSourceFieldLabel)
+	assert.True(t, attributes[ListDataSourceFieldItems].IsSensitive())
+}
+
+func TestListDataSourceRead(t *testing.T) {
+	restResource := &testListRestResource{objects: []*testListObject{
+		{ID: "id-3", Name: "prod-checkout", Tags: []string{"team-a", "prod"}},
+		{ID: "id-1", Name: "prod-search", Tags: []string{"team-b", "prod"}},
+		{ID: "id-2", Name: "test-checkout", Tags: []string{"team-a"}},
+	}}
+	dataSource, schemaResp := newConfiguredTestListDataSource(t, restResource)
+	stringSet := func(values ...string) tftypes.Value {
+		elements := make([]tftypes.Value, len(values))
+		for i, value := range values {
+			elements[i] = tftypes.NewValue(tftypes.String, value)
+		}
+		return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elements)
+	}
+
+	tests := []struct {
+		name     string
+		filters  map[string]tftypes.Value
+		expected []string
+	}{
+		{name: "no filter", expected: []string{"id-1", "id-2", "id-3"}},
+		{name: "name regex", filters: map[string]tftypes.Value{ListDataSourceFieldNameRegex: tftypes.NewValue(tftypes.String, "^prod-")}, expected: []string{"id-1", "id-3"}},
+		{name: "tags", filters: map[string]tftypes.Value{ListDataSourceFieldTags: stringSet("team-a", "prod")}, expected: []string{"id-3"}},
+		{name: "name regex and tags", filters: map[string]tftypes.Value{ListDataSourceFieldNameRegex: tftypes.NewValue(tftypes.String, "checkout"), ListDataSourceFieldTags: stringSet("team-a")}, expected: []string{"id-2", "id-3"}},
+		{name: "no match", filters: map[string]tftypes.Value{ListDataSourceFieldNameRegex: tftypes.NewValue(tftypes.String, "^staging")}, expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := readTestListDataSource(t, dataSource, schemaResp, tt.filters)
+
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			if tt.expected == nil {
+				assert.Empty(t, readListDataSourceIDs(t, resp))
+			} else {
+				assert.Equal(t, tt.expected, readListDataSourceIDs(t, resp))
+			}
+
+			var items []testListObjectModel
+			require.False(t, resp.State.GetAttribute(context.Background(), path.Root(ListDataSourceFieldItems), &items).HasError())
+			require.Len(t, items, len(tt.expected))
+			for i, item := range items {
+				assert.Equal(t, tt.expected[i], item.ID.ValueString())
+			}
+		})
+	}
+}
+
+func TestListDataSourceReadWithInvalidNameRegex(t *testing.T) {
+	dataSource, schemaResp := newConfiguredTestListDataSource(t, &testListRestResource{})
+
+	resp := readTestListDataSource(t, dataSource, schemaResp, map[string]tftypes.Value{ListDataSourceFieldNameRegex: tftypes.NewValue(tftypes.String, "(")})
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Invalid Name Regex", resp.Diagnostics.Errors()[0].Summary())
+}
+
+func TestListDataSourceReadWithAPIError(t *testing.T) {
+	dataSource, schemaResp := newConfiguredTestListDataSource(t, &testListRestResource{err: errors.New("boom")})
+
+	resp := readTestListDataSource(t, dataSource, schemaResp, nil)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "boom")
+}
+
+func TestPluralize(t *testing.T) {
+	assert.Equal(t, "application_alert_configs", pluralize("application_alert_config"))
+	assert.Equal(t, "automation_policies", pluralize("automation_policy"))
+	assert.Equal(t, "api_tokens", pluralize("api_token"))
+	assert.Equal(t, "rbac_groups", pluralize("rbac_group"))
+}
@@ -4,9 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/instana/instana-go-client/client"
@@ -112,6 +118,10 @@ func (r *terraformResourceImpl[T]) Create(ctx context.Context, req resource.Crea
 		tflog.Error(ctx, "Failed to map state to data object")
 		return
 	}
+	resp.Diagnostics.Append(r.mapWriteOnlyAttributes(ctx, &req.Config, createRequest)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Create the resource
 	tflog.Debug(ctx, "Calling Instana API to create resource", map[string]interface{}{
@@ -159,8 +169,24 @@ func (r *terraformResourceImpl[T]) Create(ctx context.Context, req resource.Crea
 		finalObject = updatedObject
 	}
 
+	// Objects are created enabled; disable them via the dedicated endpoint if required.
+	toggler, hasToggler := any(r.resourceHandle).(resourcehandle.EnabledStateToggler[T])
+	if hasToggler {
+		finalObject, diags = r.applyEnabledState(ctx, toggler, finalObject.GetIDForResourcePath(), &req.Plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Update state with created object
 	diags = r.resourceHandle.UpdateState(ctx, &resp.State, &req.Plan, finalObject)
+	if hasToggler && !diags.HasError() {
+		diags.Append(r.setEnabledState(ctx, toggler, &resp.State, finalObject)...)
+	}
+	if triggerAttribute := r.baselineRefreshTriggerAttribute(); triggerAttribute != "" && !diags.HasError() {
+		diags.Append(copyStringAttribute(ctx, triggerAttribute, &req.Plan, &resp.State)...)
+	}
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "Failed to update state after creation", map[string]interface{}{
@@ -253,6 +279,12 @@ func (r *terraformResourceImpl[T]) Read(ctx context.Context, req resource.ReadRe
 
 	// Update state with the current object
 	diags := r.resourceHandle.UpdateState(ctx, &resp.State, nil, obj)
+	if toggler, ok := any(r.resourceHandle).(resourcehandle.EnabledStateToggler[T]); ok && !diags.HasError() {
+		diags.Append(r.setEnabledState(ctx, toggler, &resp.State, obj)...)
+	}
+	if triggerAttribute := r.baselineRefreshTriggerAttribute(); triggerAttribute != "" && !diags.HasError() {
+		diags.Append(copyStringAttribute(ctx, triggerAttribute, &req.State, &resp.State)...)
+	}
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "Failed to update state after read", map[string]interface{}{
@@ -283,33 +315,82 @@ func (r *terraformResourceImpl[T]) Update(ctx context.Context, req resource.Upda
 		tflog.Error(ctx, "Failed to map state to data object for update")
 		return
 	}
+	resp.Diagnostics.Append(r.mapWriteOnlyAttributes(ctx, &req.Config, obj)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Debug(ctx, "Starting resource update", map[string]interface{}{
 		"resource_id":    obj.GetIDForResourcePath(),
 		"correlation_id": correlationID,
 	})
 
-	// Update the resource
-	tflog.Debug(ctx, "Calling Instana API to update resource", map[string]interface{}{
-		"resource_id":    obj.GetIDForResourcePath(),
-		"correlation_id": correlationID,
-	})
-	updatedObject, err := r.resourceHandle.GetRestResource(r.providerMeta.InstanaAPI).Update(obj)
-	if err != nil {
-		tflog.Error(ctx, "Failed to update resource via API", map[string]interface{}{
+	// Changes of the enabled state and the baseline refresh trigger only are applied via the dedicated endpoints
+	// without a full update
+	toggler, hasToggler := any(r.resourceHandle).(resourcehandle.EnabledStateToggler[T])
+	triggerAttribute := r.baselineRefreshTriggerAttribute()
+	sideEffectAttributes := make([]string, 0, 2)
+	if hasToggler {
+		sideEffectAttributes = append(sideEffectAttributes, toggler.EnabledAttribute())
+	}
+	if triggerAttribute != "" {
+		sideEffectAttributes = append(sideEffectAttributes, triggerAttribute)
+	}
+	onlySideEffectAttributesChanged := false
+	if len(sideEffectAttributes) > 0 {
+		onlySideEffectAttributesChanged, diags = isOnlyAttributesChanged(ctx, sideEffectAttributes, &req.Plan, &req.State)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	updatedObject := obj
+	if !onlySideEffectAttributesChanged {
+		// Update the resource
+		tflog.Debug(ctx, "Calling Instana API to update resource", map[string]interface{}{
 			"resource_id":    obj.GetIDForResourcePath(),
 			"correlation_id": correlationID,
-			"error":          err.Error(),
 		})
-		resp.Diagnostics.AddError(
-			"Error updating resource",
-			fmt.Sprintf("Could not update resource: %s", err),
-		)
-		return
+		var err error
+		updatedObject, err = r.resourceHandle.GetRestResource(r.providerMeta.InstanaAPI).Update(obj)
+		if err != nil {
+			tflog.Error(ctx, "Failed to update resource via API", map[string]interface{}{
+				"resource_id":    obj.GetIDForResourcePath(),
+				"correlation_id": correlationID,
+				"error":          err.Error(),
+			})
+			resp.Diagnostics.AddError(
+				"Error updating resource",
+				fmt.Sprintf("Could not update resource: %s", err),
+			)
+			return
+		}
+	}
+
+	if hasToggler {
+		updatedObject, diags = r.applyEnabledState(ctx, toggler, obj.GetIDForResourcePath(), &req.Plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if triggerAttribute != "" {
+		resp.Diagnostics.Append(r.refreshBaselineOnTriggerChange(ctx, triggerAttribute, obj.GetIDForResourcePath(), &req.Plan, &req.State)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// Update state with updated object
 	diags = r.resourceHandle.UpdateState(ctx, &resp.State, &req.Plan, updatedObject)
+	if hasToggler && !diags.HasError() {
+		diags.Append(r.setEnabledState(ctx, toggler, &resp.State, updatedObject)...)
+	}
+	if triggerAttribute != "" && !diags.HasError() {
+		diags.Append(copyStringAttribute(ctx, triggerAttribute, &req.Plan, &resp.State)...)
+	}
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "Failed to update state after update", map[string]interface{}{
@@ -358,7 +439,12 @@ func (r *terraformResourceImpl[T]) Delete(ctx context.Context, req resource.Dele
 		"resource_id":    resourceID,
 		"correlation_id": correlationID,
 	})
-	err := r.resourceHandle.GetRestResource(r.providerMeta.InstanaAPI).DeleteByID(resourceID)
+	var err error
+	if deleter, ok := any(r.resourceHandle).(resourcehandle.ObjectDeleter[T]); ok {
+		err = deleter.DeleteObject(r.providerMeta.InstanaAPI, object)
+	} else {
+		err = r.resourceHandle.GetRestResource(r.providerMeta.InstanaAPI).DeleteByID(resourceID)
+	}
 	if err != nil {
 		tflog.Error(ctx, "Failed to delete resource via API", map[string]interface{}{
 			"resource_id":    resourceID,
@@ -387,11 +473,17 @@ func (r *terraformResourceImpl[T]) ImportState(ctx context.Context, req resource
 		"resource_id": req.ID,
 	})
 
+	resourceID, diags := r.resolveImportID(ctx, req.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set ID
 	if r.resourceHandle.MetaData().ResourceIDField != nil {
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(*r.resourceHandle.MetaData().ResourceIDField), types.StringValue(req.ID))...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(*r.resourceHandle.MetaData().ResourceIDField), types.StringValue(resourceID))...)
 	} else {
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(req.ID))...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(resourceID))...)
 	}
 
 	if resp.Diagnostics.HasError() {
@@ -400,8 +492,84 @@ func (r *terraformResourceImpl[T]) ImportState(ctx context.Context, req resource
 		})
 	} else {
 		tflog.Info(ctx, "Successfully imported resource", map[string]interface{}{
-			"resource_id": req.ID,
+			"resource_id": resourceID,
+		})
+	}
+}
+
+// objectNameAttributes the attributes which usually hold the name of an object, in order of precedence
+var objectNameAttributes = []string{"name", "title", "label"}
+
+// resolveImportID returns the ID of the object to import. Import IDs of the form <attribute>=<value> are resolved to
+// the ID of the single object with the given value of the ImportNameAttribute of the resource. Other import IDs are
+// used as they are.
+func (r *terraformResourceImpl[T]) resolveImportID(ctx context.Context, importID string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	metaData := r.resourceHandle.MetaData()
+	attribute, value, found := strings.Cut(importID, "=")
+	if !found || (attribute != metaData.ImportNameAttribute && !slices.Contains(objectNameAttributes, attribute)) {
+		return importID, diags
+	}
+
+	if attribute != metaData.ImportNameAttribute {
+		detail := fmt.Sprintf("Resources of type %s cannot be imported by %s. Use the ID of the object", metaData.ResourceName, attribute)
+		if metaData.ImportNameAttribute != "" {
+			detail += fmt.Sprintf(" or %s=<value>", metaData.ImportNameAttribute)
+		}
+		diags.AddError("Unsupported Import ID", detail+".")
+		return "", diags
+	}
+
+	if r.providerMeta == nil || r.providerMeta.InstanaAPI == nil {
+		diags.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before import, likely because it depends on an unknown value from another resource.",
+		)
+		return "", diags
+	}
+
+	objects, err := r.resourceHandle.GetRestResource(r.providerMeta.InstanaAPI).GetAll()
+	if err != nil {
+		diags.AddError("Error resolving import ID", fmt.Sprintf("Could not list %s objects to resolve %s: %s", metaData.ResourceName, importID, err))
+		return "", diags
+	}
+
+	var matchingIDs []string
+	if objects != nil {
+		for _, obj := range *objects {
+			state, mapDiags := resourcehandle.MapObjectToState(ctx, r.resourceHandle, obj)
+			diags.Append(mapDiags...)
+			if diags.HasError() {
+				return "", diags
+			}
+			var objectValue types.String
+			diags.Append(state.GetAttribute(ctx, path.Root(attribute), &objectValue)...)
+			if diags.HasError() {
+				return "", diags
+			}
+			if objectValue.ValueString() == value {
+				matchingIDs = append(matchingIDs, obj.GetIDForResourcePath())
+			}
+		}
+	}
+
+	switch len(matchingIDs) {
+	case 0:
+		diags.AddError("Object not found", fmt.Sprintf("No %s object with %s %q exists.", metaData.ResourceName, attribute, value))
+		return "", diags
+	case 1:
+		tflog.Debug(ctx, "Resolved import ID", map[string]interface{}{
+			"import_id":   importID,
+			"resource_id": matchingIDs[0],
 		})
+		return matchingIDs[0], diags
+	default:
+		sort.Strings(matchingIDs)
+		diags.AddError(
+			"Ambiguous Import ID",
+			fmt.Sprintf("%d %s objects have the %s %q: %s. Import the object by its ID instead.", len(matchingIDs), metaData.ResourceName, attribute, value, strings.Join(matchingIDs, ", ")),
+		)
+		return "", diags
 	}
 }
 
@@ -410,6 +578,129 @@ func (r *terraformResourceImpl[T]) UpgradeState(ctx context.Context) map[int64]r
 	return r.resourceHandle.GetStateUpgraders(ctx)
 }
 
+// applyEnabledState reads the object with the given ID and enables or disables it via the EnabledStateToggler
+// when its enabled state differs from the plan. The current object is returned.
+func (r *terraformResourceImpl[T]) applyEnabledState(ctx context.Context, toggler resourcehandle.EnabledStateToggler[T], id string, plan *tfsdk.Plan) (T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var planned types.Bool
+	diags.Append(plan.GetAttribute(ctx, path.Root(toggler.EnabledAttribute()), &planned)...)
+
+	restResource := r.resourceHandle.GetRestResource(r.providerMeta.InstanaAPI)
+	current, err := restResource.GetOne(id)
+	if err != nil {
+		diags.AddError("Error reading resource", fmt.Sprintf("Could not read resource: %s", err))
+	}
+	if diags.HasError() || planned.IsNull() || planned.IsUnknown() {
+		return current, diags
+	}
+
+	enabled, err := toggler.IsEnabled(r.providerMeta.InstanaAPI, current)
+	if err != nil {
+		diags.AddError("Error reading enabled state", fmt.Sprintf("Could not read enabled state of resource: %s", err))
+		return current, diags
+	}
+	if enabled == planned.ValueBool() {
+		return current, diags
+	}
+
+	tflog.Debug(ctx, "Calling Instana API to change enabled state of resource", map[string]interface{}{
+		"resource_id": id,
+		"enabled":     planned.ValueBool(),
+	})
+	if err := toggler.SetEnabled(r.providerMeta.InstanaAPI, id, planned.ValueBool()); err != nil {
+		diags.AddError("Error changing enabled state", fmt.Sprintf("Could not change enabled state of resource: %s", err))
+		return current, diags
+	}
+	current, err = restResource.GetOne(id)
+	if err != nil {
+		diags.AddError("Error reading resource", fmt.Sprintf("Could not read resource: %s", err))
+	}
+	return current, diags
+}
+
+// mapWriteOnlyAttributes applies the configured write-only attributes to the given API object when the resource
+// handle supports write-only attributes
+func (r *terraformResourceImpl[T]) mapWriteOnlyAttributes(ctx context.Context, config *tfsdk.Config, obj T) diag.Diagnostics {
+	if mapper, ok := any(r.resourceHandle).(resourcehandle.WriteOnlyAttributeMapper[T]); ok {
+		return mapper.MapWriteOnlyAttributes(ctx, config, obj)
+	}
+	return nil
+}
+
+// setEnabledState sets the enabled attribute of the state to the enabled state of the given object
+func (r *terraformResourceImpl[T]) setEnabledState(ctx context.Context, toggler resourcehandle.EnabledStateToggler[T], state *tfsdk.State, obj T) diag.Diagnostics {
+	var diags diag.Diagnostics
+	enabled, err := toggler.IsEnabled(r.providerMeta.InstanaAPI, obj)
+	if err != nil {
+		diags.AddError("Error reading enabled state", fmt.Sprintf("Could not read enabled state of resource: %s", err))
+		return diags
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root(toggler.EnabledAttribute()), types.BoolValue(enabled))...)
+	return diags
+}
+
+// baselineRefreshTriggerAttribute returns the name of the baseline refresh trigger attribute when the resource
+// handle implements resourcehandle.BaselineRefresher and an empty string otherwise
+func (r *terraformResourceImpl[T]) baselineRefreshTriggerAttribute() string {
+	if refresher, ok := any(r.resourceHandle).(resourcehandle.BaselineRefresher); ok {
+		return refresher.BaselineRefreshTriggerAttribute()
+	}
+	return ""
+}
+
+// refreshBaselineOnTriggerChange refreshes the baseline of the object with the given ID when the planned value of the
+// trigger attribute is set and differs from the state
+func (r *terraformResourceImpl[T]) refreshBaselineOnTriggerChange(ctx context.Context, triggerAttribute string, id string, plan *tfsdk.Plan, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var planned, current types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root(triggerAttribute), &planned)...)
+	diags.Append(state.GetAttribute(ctx, path.Root(triggerAttribute), &current)...)
+	if diags.HasError() || planned.IsNull() || planned.IsUnknown() || planned.Equal(current) {
+		return diags
+	}
+
+	tflog.Debug(ctx, "Calling Instana API to refresh baseline of resource", map[string]interface{}{
+		"resource_id": id,
+	})
+	refresher := any(r.resourceHandle).(resourcehandle.BaselineRefresher)
+	if err := refresher.RefreshBaseline(r.providerMeta.InstanaAPI, id); err != nil {
+		diags.AddError("Error refreshing baseline", fmt.Sprintf("Could not refresh baseline of resource: %s", err))
+	}
+	return diags
+}
+
+// attributeGetter is implemented by tfsdk.Plan and tfsdk.State
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// copyStringAttribute copies the value of the given string attribute from the source into the state. Used for
+// attributes which are not part of the API objects.
+func copyStringAttribute(ctx context.Context, attribute string, source attributeGetter, target *tfsdk.State) diag.Diagnostics {
+	var value types.String
+	diags := source.GetAttribute(ctx, path.Root(attribute), &value)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(target.SetAttribute(ctx, path.Root(attribute), value)...)
+	return diags
+}
+
+// isOnlyAttributesChanged returns true when the plan differs from the state in the given attributes only
+func isOnlyAttributesChanged(ctx context.Context, attributes []string, plan *tfsdk.Plan, state *tfsdk.State) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	compared := tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()}
+	for _, attribute := range attributes {
+		var planned attr.Value
+		diags.Append(plan.GetAttribute(ctx, path.Root(attribute), &planned)...)
+		if diags.HasError() {
+			return false, diags
+		}
+		diags.Append(compared.SetAttribute(ctx, path.Root(attribute), planned)...)
+	}
+	return !diags.HasError() && compared.Raw.Equal(plan.Raw), diags
+}
+
 // ---------------------------------------------------------------------------
 // Singleton resource — no ID, no list; uses Get / Upsert / Delete
 // ---------------------------------------------------------------------------
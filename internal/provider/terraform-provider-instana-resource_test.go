@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsOnlyAttributesChanged(t *testing.T) {
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":    schema.StringAttribute{Required: true},
+			"enabled": schema.BoolAttribute{Optional: true},
+			"trigger": schema.StringAttribute{Optional: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String, "enabled": tftypes.Bool, "trigger": tftypes.String}}
+	newValueWithTrigger := func(name string, enabled bool, trigger string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name":    tftypes.NewValue(tftypes.String, name),
+			"enabled": tftypes.NewValue(tftypes.Bool, enabled),
+			"trigger": tftypes.NewValue(tftypes.String, trigger),
+		})
+	}
+	newValue := func(name string, enabled bool) tftypes.Value {
+		return newValueWithTrigger(name, enabled, "a")
+	}
+	state := &tfsdk.State{Schema: testSchema, Raw: newValue("name", true)}
+
+	tests := []struct {
+		name     string
+		plan     tftypes.Value
+		expected bool
+	}{
+		{name: "no change", plan: newValue("name", true), expected: true},
+		{name: "enabled changed", plan: newValue("name", false), expected: true},
+		{name: "name changed", plan: newValue("other", true), expected: false},
+		{name: "name and enabled changed", plan: newValue("other", false), expected: false},
+		{name: "trigger changed", plan: newValueWithTrigger("name", true, "b"), expected: true},
+		{name: "enabled and trigger changed", plan: newValueWithTrigger("name", false, "b"), expected: true},
+		{name: "name and trigger changed", plan: newValueWithTrigger("other", true, "b"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, diags := isOnlyAttributesChanged(context.Background(), []string{"enabled", "trigger"}, &tfsdk.Plan{Schema: testSchema, Raw: tt.plan}, state)
+
+			require.False(t, diags.HasError())
+			assert.Equal(t, tt.expected, result)
+			assert.True(t, state.Raw.Equal(newValue("name", true)))
+		})
+	}
+}
+
+func TestImportStateResolvesImportID(t *testing.T) {
+	restResource := &testListRestResource{objects: []*testListObject{
+		{ID: "id-1", Name: "checkout"},
+		{ID: "id-2", Name: "search"},
+		{ID: "id-3", Name: "search"},
+	}}
+	terraformResource := NewTerraformResource[*testListObject](newTestListResourceHandle(restResource))
+	configureResp := &resource.ConfigureResponse{}
+	terraformResource.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: &shared.ProviderMeta{InstanaAPI: &testutils.MockInstanaAPI{}},
+	}, configureResp)
+	require.False(t, configureResp.Diagnostics.HasError())
+	resourceSchema := newTestListResourceHandle(restResource).schema
+
+	tests := []struct {
+		name          string
+		importID      string
+		expectedID    string
+		expectedError string
+	}{
+		{name: "id", importID: "id-2", expectedID: "id-2"},
+		{name: "unique name", importID: "name=checkout", expectedID: "id-1"},
+		{name: "unknown name", importID: "name=other", expectedError: "Object not found"},
+		{name: "ambiguous name", importID: "name=search", expectedError: "Ambiguous Import ID"},
+		{name: "unsupported attribute", importID: "title=checkout", expectedError: "Unsupported Import ID"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &resource.ImportStateResponse{State: tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(context.Background()), nil)}}
+
+			terraformResource.ImportState(context.Background(), resource.ImportStateRequest{ID: tt.importID}, resp)
+
+			if tt.expectedError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tt.expectedError, resp.Diagnostics.Errors()[0].Summary())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			var id types.String
+			require.False(t, resp.State.GetAttribute(context.Background(), path.Root("id"), &id).HasError())
+			assert.Equal(t, tt.expectedID, id.ValueString())
+		})
+	}
+}
+
+type testDeletableRestResource struct {
+	*testListRestResource
+	deletedObject *testListObject
+	deletedID     string
+}
+
+func (r *testDeletableRestResource) Delete(data *testListObject) error {
+	r.deletedObject = data
+	return nil
+}
+
+func (r *testDeletableRestResource) DeleteByID(id string) error {
+	r.deletedID = id
+	return nil
+}
+
+type testDeletableResourceHandle struct {
+	*testListResourceHandle
+	restResource *testDeletableRestResource
+}
+
+func (h *testDeletableResourceHandle) GetRestResource(_ client.InstanaAPI) rest.RestResource[*testListObject] {
+	return h.restResource
+}
+
+func (h *testDeletableResourceHandle) MapStateToDataObject(ctx context.Context, _ *tfsdk.Plan, state *tfsdk.State) (*testListObject, diag.Diagnostics) {
+	var model testListObjectModel
+	diags := state.Get(ctx, &model)
+	return &testListObject{ID: model.ID.ValueString(), Name: model.Name.ValueString(), Tags: model.Tags}, diags
+}
+
+type testObjectDeleterResourceHandle struct {
+	*testDeletableResourceHandle
+}
+
+func (h *testObjectDeleterResourceHandle) DeleteObject(api client.InstanaAPI, obj *testListObject) error {
+	return h.GetRestResource(api).Delete(obj)
+}
+
+func deleteTestResource(t *testing.T, handle resourcehandle.ResourceHandle[*testListObject], resourceSchema schema.Schema) *resource.DeleteResponse {
+	ctx := context.Background()
+	terraformResource := NewTerraformResource[*testListObject](handle)
+	configureResp := &resource.ConfigureResponse{}
+	terraformResource.Configure(ctx, resource.ConfigureRequest{
+		ProviderData: &shared.ProviderMeta{InstanaAPI: &testutils.MockInstanaAPI{}},
+	}, configureResp)
+	require.False(t, configureResp.Diagnostics.HasError())
+
+	state := tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}
+	require.False(t, state.Set(ctx, &testListObjectModel{ID: types.StringValue("id-1"), Name: types.StringValue("checkout"), Secret: types.StringNull()}).HasError())
+	resp := &resource.DeleteResponse{State: state}
+	terraformResource.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+	return resp
+}
+
+func TestDeleteDeletesObjectByID(t *testing.T) {
+	restResource := &testDeletableRestResource{testListRestResource: &testListRestResource{}}
+	handle := &testDeletableResourceHandle{testListResourceHandle: newTestListResourceHandle(restResource.testListRestResource), restResource: restResource}
+
+	resp := deleteTestResource(t, handle, handle.schema)
+
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.Equal(t, "id-1", restResource.deletedID)
+	assert.Nil(t, restResource.deletedObject)
+	assert.True(t, resp.State.Raw.IsNull())
+}
+
+func TestDeleteDeletesObjectWithObjectDeleter(t *testing.T) {
+	restResource := &testDeletableRestResource{testListRestResource: &testListRestResource{}}
+	handle := &testObjectDeleterResourceHandle{&testDeletableResourceHandle{testListResourceHandle: newTestListResourceHandle(restResource.testListRestResource), restResource: restResource}}
+
+	resp := deleteTestResource(t, handle, handle.schema)
+
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.Empty(t, restResource.deletedID)
+	require.NotNil(t, restResource.deletedObject)
+	assert.Equal(t, "checkout", restResource.deletedObject.Name)
+	assert.True(t, resp.State.Raw.IsNull())
+}
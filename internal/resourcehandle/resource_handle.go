@@ -50,6 +50,25 @@ type ResourceMetaData struct {
 	ResourceIDField    *string
 	CreateOnly         bool
 	DeprecationMessage string
+	// ImportNameAttribute the string attribute (e.g. name, label or title) which allows to import objects with the
+	// import ID <attribute>=<value> instead of the ID of the object
+	ImportNameAttribute string
+	// DisableListDataSource skips the plural list data source and the export of the resource, e.g. because the Instana
+	// API does not support to list the objects of the resource or listing them would manage built-in objects which
+	// the user did not ask for
+	DisableListDataSource bool
+	// ReferenceAttributes maps the names of the attributes holding IDs of other objects to the names of the resources
+	// of these objects, e.g. alert_channel_ids to alerting_channel. The export writes these IDs as references to the
+	// exported objects of the referenced resource.
+	ReferenceAttributes map[string]string
+}
+
+// IDAttribute returns the name of the attribute holding the ID of the resource
+func (m *ResourceMetaData) IDAttribute() string {
+	if m.ResourceIDField != nil {
+		return *m.ResourceIDField
+	}
+	return "id"
 }
 
 // ResourceHandle resource specific implementation which provides metadata and maps data from/to terraform state.
@@ -96,3 +115,72 @@ type PostCreateUpdater[T client.InstanaDataObject] interface {
 	// correct resource.
 	ApplyCreatedID(original T, created T) T
 }
+
+// EnabledStateToggler is an optional interface that a ResourceHandle can implement
+// when the enabled state of the resource is changed through dedicated enable and
+// disable endpoints (e.g. smart alert configurations) instead of the update of
+// the whole object.
+//
+// If the resource handle implements this interface, the generic operations apply
+// the planned value of the boolean attribute returned by EnabledAttribute via
+// SetEnabled: Create disables the object right after creation when required and
+// Update skips the Update API call when the enabled attribute is the only change.
+// After each operation the attribute is set from IsEnabled.
+type EnabledStateToggler[T client.InstanaDataObject] interface {
+	// EnabledAttribute returns the name of the boolean schema attribute holding
+	// the enabled state.
+	EnabledAttribute() string
+
+	// SetEnabled enables or disables the object with the given ID.
+	SetEnabled(api client.InstanaAPI, id string, enabled bool) error
+
+	// IsEnabled returns the current enabled state of the given object as
+	// provided by the Instana API.
+	IsEnabled(api client.InstanaAPI, obj T) (bool, error)
+}
+
+// BaselineRefresher is an optional interface that a ResourceHandle can implement
+// when the resource offers a string attribute which triggers the recalculation of
+// the baselines of the object (e.g. historic baselines of smart alert
+// configurations) whenever its value changes, similar to the triggers of a
+// null_resource.
+//
+// The trigger attribute is not part of the API object. The generic operations
+// therefore keep its value in the state and call RefreshBaseline after Update when
+// the planned value differs from the state. Update skips the Update API call when
+// the trigger attribute is the only change. An empty attribute name disables the
+// baseline refresh, e.g. for variants of a resource without baseline endpoint.
+type BaselineRefresher interface {
+	// BaselineRefreshTriggerAttribute returns the name of the string schema
+	// attribute which triggers the baseline refresh.
+	BaselineRefreshTriggerAttribute() string
+
+	// RefreshBaseline recalculates the baseline of the object with the given ID.
+	RefreshBaseline(api client.InstanaAPI, id string) error
+}
+
+// WriteOnlyAttributeMapper is an optional interface that a ResourceHandle can
+// implement when the resource offers write-only attributes (e.g. secrets which
+// must never be stored in the state).
+//
+// Write-only values are only available in the configuration; plan and state
+// always contain null values. The generic operations therefore call
+// MapWriteOnlyAttributes during Create and Update after MapStateToDataObject to
+// apply the configured write-only values to the API object.
+type WriteOnlyAttributeMapper[T client.InstanaDataObject] interface {
+	// MapWriteOnlyAttributes applies the write-only values of the given
+	// configuration to the API object.
+	MapWriteOnlyAttributes(ctx context.Context, config *tfsdk.Config, obj T) diag.Diagnostics
+}
+
+// ObjectDeleter is an optional interface that a ResourceHandle can implement
+// when the object cannot be deleted by its ID alone but requires the data of
+// the state (e.g. to restore the original state of a built-in object).
+//
+// If the resource handle implements this interface, the generic Delete
+// operation calls DeleteObject with the object mapped from the state instead of
+// DeleteByID of the RestResource.
+type ObjectDeleter[T client.InstanaDataObject] interface {
+	// DeleteObject deletes the given object mapped from the state.
+	DeleteObject(api client.InstanaAPI, obj T) error
+}
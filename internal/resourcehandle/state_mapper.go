@@ -0,0 +1,29 @@
+package resourcehandle
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/instana/instana-go-client/client"
+)
+
+// MapObjectToState maps the given object to a new state of the resource schema using the UpdateState function of the
+// handle. The state is initialized with the ID of the object only, like the state of an imported resource.
+func MapObjectToState[T client.InstanaDataObject](ctx context.Context, handle ResourceHandle[T], obj T) (*tfsdk.State, diag.Diagnostics) {
+	metaData := handle.MetaData()
+	state := &tfsdk.State{
+		Schema: metaData.Schema,
+		Raw:    tftypes.NewValue(metaData.Schema.Type().TerraformType(ctx), nil),
+	}
+
+	diags := state.SetAttribute(ctx, path.Root(metaData.IDAttribute()), types.StringValue(obj.GetIDForResourcePath()))
+	if diags.HasError() {
+		return nil, diags
+	}
+	diags.Append(handle.UpdateState(ctx, state, nil, obj)...)
+	return state, diags
+}
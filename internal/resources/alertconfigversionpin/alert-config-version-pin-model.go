@@ -0,0 +1,12 @@
+package alertconfigversionpin
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// AlertConfigVersionPinModel represents the data model for the version pin of a smart alert configuration
+type AlertConfigVersionPinModel struct {
+	ID              types.String `tfsdk:"id"`
+	AlertConfigType types.String `tfsdk:"alert_config_type"`
+	AlertConfigID   types.String `tfsdk:"alert_config_id"`
+	Version         types.Int64  `tfsdk:"version"`
+	RestoredVersion types.Int64  `tfsdk:"restored_version"`
+}
@@ -0,0 +1,30 @@
+package alertconfigversionpin
+
+// ResourceInstanaAlertConfigVersionPin the name of the terraform-provider-instana resource to pin smart alert configurations to a historic version
+const ResourceInstanaAlertConfigVersionPin = "alert_config_version_pin"
+
+// Description constants for the alert config version pin resource
+const (
+	AlertConfigVersionPinDescResource = "This resource pins a smart alert configuration to a historic version. The pinned version is restored on create, " +
+		"when the version changes and when the alert configuration was changed outside of this resource. Destroying the resource keeps the current alert configuration. " +
+		"The available versions can be read with the data source instana_alert_config_versions."
+	AlertConfigVersionPinDescID              = "The ID of the version pin in the format <alert_config_type>/<alert_config_id>."
+	AlertConfigVersionPinDescAlertConfigType = "The type of the smart alert configuration. Supported values: application, global_application, website, mobile_app, infra, synthetic."
+	AlertConfigVersionPinDescAlertConfigID   = "The ID of the smart alert configuration."
+	AlertConfigVersionPinDescVersion         = "The creation timestamp (milliseconds since epoch) of the version to restore."
+	AlertConfigVersionPinDescRestoredVersion = "The creation timestamp of the version which was created by restoring the pinned version. Used to detect changes of the alert configuration outside of this resource."
+)
+
+// Field name constants
+const (
+	//AlertConfigVersionPinFieldID field name for id
+	AlertConfigVersionPinFieldID = "id"
+	//AlertConfigVersionPinFieldAlertConfigType field name for alert_config_type
+	AlertConfigVersionPinFieldAlertConfigType = "alert_config_type"
+	//AlertConfigVersionPinFieldAlertConfigID field name for alert_config_id
+	AlertConfigVersionPinFieldAlertConfigID = "alert_config_id"
+	//AlertConfigVersionPinFieldVersion field name for version
+	AlertConfigVersionPinFieldVersion = "version"
+	//AlertConfigVersionPinFieldRestoredVersion field name for restored_version
+	AlertConfigVersionPinFieldRestoredVersion = "restored_version"
+)
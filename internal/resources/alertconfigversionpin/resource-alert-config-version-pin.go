@@ -0,0 +1,171 @@
+package alertconfigversionpin
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+)
+
+// ============================================================================
+// Resource Factory
+// ============================================================================
+
+// NewAlertConfigVersionPinResourceHandle creates the resource handle for version pins of smart alert configurations
+func NewAlertConfigVersionPinResourceHandle() resourcehandle.ResourceHandle[*instanaapi.AlertConfigVersionPin] {
+	return &alertConfigVersionPinResource{
+		metaData: resourcehandle.ResourceMetaData{
+			ResourceName:          ResourceInstanaAlertConfigVersionPin,
+			DisableListDataSource: true,
+			Schema: schema.Schema{
+				Description: AlertConfigVersionPinDescResource,
+				Attributes: map[string]schema.Attribute{
+					AlertConfigVersionPinFieldID: schema.StringAttribute{
+						Computed:    true,
+						Description: AlertConfigVersionPinDescID,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					AlertConfigVersionPinFieldAlertConfigType: schema.StringAttribute{
+						Required:    true,
+						Description: AlertConfigVersionPinDescAlertConfigType,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf(instanaapi.SupportedAlertConfigTypes...),
+						},
+					},
+					AlertConfigVersionPinFieldAlertConfigID: schema.StringAttribute{
+						Required:    true,
+						Description: AlertConfigVersionPinDescAlertConfigID,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					AlertConfigVersionPinFieldVersion: schema.Int64Attribute{
+						Required:    true,
+						Description: AlertConfigVersionPinDescVersion,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					AlertConfigVersionPinFieldRestoredVersion: schema.Int64Attribute{
+						Computed:    true,
+						Description: AlertConfigVersionPinDescRestoredVersion,
+					},
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+// ============================================================================
+// Resource Implementation
+// ============================================================================
+
+type alertConfigVersionPinResource struct {
+	metaData resourcehandle.ResourceMetaData
+}
+
+// MetaData returns the resource metadata
+func (r *alertConfigVersionPinResource) MetaData() *resourcehandle.ResourceMetaData {
+	return &r.metaData
+}
+
+// GetRestResource returns the REST resource for version pins of smart alert configurations
+func (r *alertConfigVersionPinResource) GetRestResource(api client.InstanaAPI) rest.RestResource[*instanaapi.AlertConfigVersionPin] {
+	return instanaapi.From(api).AlertConfigVersionPins()
+}
+
+// SetComputedFields sets computed fields in the plan (none for this resource)
+func (r *alertConfigVersionPinResource) SetComputedFields(_ context.Context, _ *tfsdk.Plan) diag.Diagnostics {
+	return nil
+}
+
+// ============================================================================
+// API to State Mapping
+// ============================================================================
+
+// UpdateState converts API data object to Terraform state. On create and update the pinned version is taken from the
+// API object. On read the pinned version of the state is kept as long as the alert configuration was not changed
+// since the restore; otherwise the latest version is reported to trigger a new restore of the pinned version.
+func (r *alertConfigVersionPinResource) UpdateState(ctx context.Context, state *tfsdk.State, plan *tfsdk.Plan, pin *instanaapi.AlertConfigVersionPin) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	version := types.Int64Value(pin.Version)
+	if plan == nil {
+		var pinnedVersion, restoredVersion types.Int64
+		diags.Append(state.GetAttribute(ctx, path.Root(AlertConfigVersionPinFieldVersion), &pinnedVersion)...)
+		diags.Append(state.GetAttribute(ctx, path.Root(AlertConfigVersionPinFieldRestoredVersion), &restoredVersion)...)
+		if diags.HasError() {
+			return diags
+		}
+		version = types.Int64Value(pin.CurrentVersion)
+		if !pinnedVersion.IsNull() && restoredVersion.ValueInt64() == pin.CurrentVersion {
+			version = pinnedVersion
+		}
+	}
+
+	model := AlertConfigVersionPinModel{
+		ID:              types.StringValue(pin.GetIDForResourcePath()),
+		AlertConfigType: types.StringValue(string(pin.AlertConfigType)),
+		AlertConfigID:   types.StringValue(pin.AlertConfigID),
+		Version:         version,
+		RestoredVersion: types.Int64Value(pin.CurrentVersion),
+	}
+
+	diags.Append(state.Set(ctx, model)...)
+	return diags
+}
+
+// ============================================================================
+// State to API Mapping
+// ============================================================================
+
+// MapStateToDataObject converts Terraform state to API data object
+func (r *alertConfigVersionPinResource) MapStateToDataObject(ctx context.Context, plan *tfsdk.Plan, state *tfsdk.State) (*instanaapi.AlertConfigVersionPin, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var model AlertConfigVersionPinModel
+
+	if plan != nil {
+		diags.Append(plan.Get(ctx, &model)...)
+	} else if state != nil {
+		diags.Append(state.Get(ctx, &model)...)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &instanaapi.AlertConfigVersionPin{
+		AlertConfigType: instanaapi.AlertConfigType(model.AlertConfigType.ValueString()),
+		AlertConfigID:   model.AlertConfigID.ValueString(),
+		Version:         model.Version.ValueInt64(),
+		CurrentVersion:  model.RestoredVersion.ValueInt64(),
+	}, diags
+}
+
+// GetStateUpgraders returns the state upgraders for this resource (none needed for v0)
+func (r *alertConfigVersionPinResource) GetStateUpgraders(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
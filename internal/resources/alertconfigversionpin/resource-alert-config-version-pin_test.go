@@ -0,0 +1,149 @@
+package alertconfigversionpin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAlertConfigID   = "alert-id"
+	testPinID           = "application/alert-id"
+	testPinnedVersion   = int64(1000)
+	testRestoredVersion = int64(3000)
+)
+
+func TestNewAlertConfigVersionPinResourceHandle(t *testing.T) {
+	t.Run("should create resource handle with correct metadata", func(t *testing.T) {
+		handle := NewAlertConfigVersionPinResourceHandle()
+
+		require.NotNil(t, handle)
+		metadata := handle.MetaData()
+		assert.Equal(t, ResourceInstanaAlertConfigVersionPin, metadata.ResourceName)
+		assert.Equal(t, int64(0), metadata.SchemaVersion)
+		assert.True(t, metadata.SkipIDGeneration)
+		assert.Nil(t, metadata.ResourceIDField)
+	})
+
+	t.Run("should have correct schema attributes", func(t *testing.T) {
+		s := NewAlertConfigVersionPinResourceHandle().MetaData().Schema
+
+		assert.True(t, s.Attributes[AlertConfigVersionPinFieldID].IsComputed())
+		assert.True(t, s.Attributes[AlertConfigVersionPinFieldAlertConfigType].IsRequired())
+		assert.True(t, s.Attributes[AlertConfigVersionPinFieldAlertConfigID].IsRequired())
+		assert.True(t, s.Attributes[AlertConfigVersionPinFieldVersion].IsRequired())
+		assert.True(t, s.Attributes[AlertConfigVersionPinFieldRestoredVersion].IsComputed())
+	})
+}
+
+type mockAlertConfigVersionPinAPI struct {
+	testutils.MockInstanaAPI
+	restResource rest.RestResource[*instanaapi.AlertConfigVersionPin]
+}
+
+func (m *mockAlertConfigVersionPinAPI) AlertConfigVersionPins() rest.RestResource[*instanaapi.AlertConfigVersionPin] {
+	return m.restResource
+}
+
+func TestAlertConfigVersionPinGetRestResource(t *testing.T) {
+	restResource := instanaapi.NewAlertConfigVersionPinRestResource(nil)
+
+	result := NewAlertConfigVersionPinResourceHandle().GetRestResource(&mockAlertConfigVersionPinAPI{restResource: restResource})
+
+	assert.Same(t, restResource, result)
+}
+
+func newTestModel(version int64, restoredVersion int64) AlertConfigVersionPinModel {
+	return AlertConfigVersionPinModel{
+		ID:              types.StringValue(testPinID),
+		AlertConfigType: types.StringValue(string(instanaapi.AlertConfigTypeApplication)),
+		AlertConfigID:   types.StringValue(testAlertConfigID),
+		Version:         types.Int64Value(version),
+		RestoredVersion: types.Int64Value(restoredVersion),
+	}
+}
+
+func newTestPin(version int64, currentVersion int64) *instanaapi.AlertConfigVersionPin {
+	return &instanaapi.AlertConfigVersionPin{
+		AlertConfigType: instanaapi.AlertConfigTypeApplication,
+		AlertConfigID:   testAlertConfigID,
+		Version:         version,
+		CurrentVersion:  currentVersion,
+	}
+}
+
+func TestAlertConfigVersionPinMapStateToDataObject(t *testing.T) {
+	ctx := context.Background()
+	handle := NewAlertConfigVersionPinResourceHandle()
+	plan := &tfsdk.Plan{Schema: handle.MetaData().Schema}
+	require.False(t, plan.Set(ctx, newTestModel(testPinnedVersion, testRestoredVersion)).HasError())
+
+	result, diags := handle.MapStateToDataObject(ctx, plan, nil)
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, newTestPin(testPinnedVersion, testRestoredVersion), result)
+	assert.Equal(t, testPinID, result.GetIDForResourcePath())
+}
+
+func TestAlertConfigVersionPinUpdateState(t *testing.T) {
+	ctx := context.Background()
+	handle := NewAlertConfigVersionPinResourceHandle()
+
+	t.Run("should set pinned and restored version after restore", func(t *testing.T) {
+		plan := &tfsdk.Plan{Schema: handle.MetaData().Schema}
+		require.False(t, plan.Set(ctx, newTestModel(testPinnedVersion, 0)).HasError())
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+
+		diags := handle.UpdateState(ctx, state, plan, newTestPin(testPinnedVersion, testRestoredVersion))
+
+		require.False(t, diags.HasError())
+		var model AlertConfigVersionPinModel
+		require.False(t, state.Get(ctx, &model).HasError())
+		assert.Equal(t, newTestModel(testPinnedVersion, testRestoredVersion), model)
+	})
+
+	t.Run("should keep pinned version on read when alert config is unchanged", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+		require.False(t, state.Set(ctx, newTestModel(testPinnedVersion, testRestoredVersion)).HasError())
+
+		diags := handle.UpdateState(ctx, state, nil, newTestPin(0, testRestoredVersion))
+
+		require.False(t, diags.HasError())
+		var model AlertConfigVersionPinModel
+		require.False(t, state.Get(ctx, &model).HasError())
+		assert.Equal(t, newTestModel(testPinnedVersion, testRestoredVersion), model)
+	})
+
+	t.Run("should report latest version on read when alert config was changed", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+		require.False(t, state.Set(ctx, newTestModel(testPinnedVersion, testRestoredVersion)).HasError())
+
+		diags := handle.UpdateState(ctx, state, nil, newTestPin(0, 4000))
+
+		require.False(t, diags.HasError())
+		var model AlertConfigVersionPinModel
+		require.False(t, state.Get(ctx, &model).HasError())
+		assert.Equal(t, newTestModel(4000, 4000), model)
+	})
+
+	t.Run("should report latest version on read after import", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema, Raw: tftypes.NewValue(handle.MetaData().Schema.Type().TerraformType(ctx), nil)}
+		require.False(t, state.SetAttribute(ctx, path.Root(AlertConfigVersionPinFieldID), testPinID).HasError())
+
+		diags := handle.UpdateState(ctx, state, nil, newTestPin(0, testRestoredVersion))
+
+		require.False(t, diags.HasError())
+		var model AlertConfigVersionPinModel
+		require.False(t, state.Get(ctx, &model).HasError())
+		assert.Equal(t, newTestModel(testRestoredVersion, testRestoredVersion), model)
+	})
+}
@@ -241,7 +241,7 @@ const (
 	AlertingChannelErrUnsupportedType       = "Unsupported alerting channel type"
 	AlertingChannelErrUnsupportedTypeMsg    = "Received unsupported alerting channel of type %s"
 	AlertingChannelErrMissingPassword       = "Missing Password"
-	AlertingChannelErrMissingPasswordMsg    = "password must be specified when creating the resource"
+	AlertingChannelErrMissingPasswordMsg    = "password or password_wo must be specified when creating the resource"
 	AlertingChannelErrInstanaURLRequired    = "InstanaURL is required"
 	AlertingChannelErrInstanaURLRequiredMsg = "InstanaURL is required when creating the resource"
 	AlertingChannelErrInvalidConfig         = "Invalid Alerting Channel Configuration"
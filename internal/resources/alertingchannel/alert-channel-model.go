@@ -7,25 +7,25 @@ import (
 
 // AlertingChannelModel represents the data model for the alerting channel resource
 type AlertingChannelModel struct {
-	ID                    types.String                       `tfsdk:"id"`
-	Name                  types.String                       `tfsdk:"name"`
-	RbacTags              types.List                         `tfsdk:"rbac_tags"`
-	Email                 *shared.EmailModel                 `tfsdk:"email"`
-	OpsGenie              *shared.OpsGenieModel              `tfsdk:"ops_genie"`
-	PagerDuty             *shared.PagerDutyModel             `tfsdk:"pager_duty"`
-	Slack                 *shared.SlackModel                 `tfsdk:"slack"`
-	Splunk                *shared.SplunkModel                `tfsdk:"splunk"`
-	VictorOps             *shared.VictorOpsModel             `tfsdk:"victor_ops"`
-	Webhook               *shared.WebhookModel               `tfsdk:"webhook"`
-	Office365             *shared.WebhookBasedModel          `tfsdk:"office_365"`
-	GoogleChat            *shared.WebhookBasedModel          `tfsdk:"google_chat"`
-	ServiceNow            *shared.ServiceNowModel            `tfsdk:"service_now"`
-	ServiceNowApplication *shared.ServiceNowApplicationModel `tfsdk:"service_now_application"`
-	PrometheusWebhook     *shared.PrometheusWebhookModel     `tfsdk:"prometheus_webhook"`
-	WebexTeamsWebhook     *shared.WebhookBasedModel          `tfsdk:"webex_teams_webhook"`
-	WatsonAIOpsWebhook    *shared.WatsonAIOpsWebhookModel    `tfsdk:"watson_aiops_webhook"`
-	SlackApp              *shared.SlackAppModel              `tfsdk:"slack_app"`
-	MsTeamsApp            *shared.MsTeamsAppModel            `tfsdk:"ms_teams_app"`
+	ID                    types.String                               `tfsdk:"id"`
+	Name                  types.String                               `tfsdk:"name"`
+	RbacTags              types.List                                 `tfsdk:"rbac_tags"`
+	Email                 *shared.EmailModel                         `tfsdk:"email"`
+	OpsGenie              *shared.OpsGenieResourceModel              `tfsdk:"ops_genie"`
+	PagerDuty             *shared.PagerDutyModel                     `tfsdk:"pager_duty"`
+	Slack                 *shared.SlackModel                         `tfsdk:"slack"`
+	Splunk                *shared.SplunkResourceModel                `tfsdk:"splunk"`
+	VictorOps             *shared.VictorOpsResourceModel             `tfsdk:"victor_ops"`
+	Webhook               *shared.WebhookModel                       `tfsdk:"webhook"`
+	Office365             *shared.WebhookBasedModel                  `tfsdk:"office_365"`
+	GoogleChat            *shared.WebhookBasedModel                  `tfsdk:"google_chat"`
+	ServiceNow            *shared.ServiceNowResourceModel            `tfsdk:"service_now"`
+	ServiceNowApplication *shared.ServiceNowApplicationResourceModel `tfsdk:"service_now_application"`
+	PrometheusWebhook     *shared.PrometheusWebhookModel             `tfsdk:"prometheus_webhook"`
+	WebexTeamsWebhook     *shared.WebhookBasedModel                  `tfsdk:"webex_teams_webhook"`
+	WatsonAIOpsWebhook    *shared.WatsonAIOpsWebhookModel            `tfsdk:"watson_aiops_webhook"`
+	SlackApp              *shared.SlackAppModel                      `tfsdk:"slack_app"`
+	MsTeamsApp            *shared.MsTeamsAppModel                    `tfsdk:"ms_teams_app"`
 }
 
 // AlertingChannelRbacTagModel represents an RBAC tag (team assignment) of an alerting channel
@@ -27,7 +27,8 @@ func NewAlertingChannelResourceHandle() resourcehandle.ResourceHandle[*api.Alert
 	supportedOpsGenieRegions := []string{OpsGenieRegionEU, OpsGenieRegionUS}
 	return &alertingChannelResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName: ResourceInstanaAlertingChannel,
+			ResourceName:        ResourceInstanaAlertingChannel,
+			ImportNameAttribute: AlertingChannelFieldName,
 			Schema: schema.Schema{
 				Description: AlertingChannelDescResource,
 				Attributes: map[string]schema.Attribute{
@@ -73,10 +74,11 @@ func NewAlertingChannelResourceHandle() resourcehandle.ResourceHandle[*api.Alert
 					AlertingChannelFieldChannelOpsGenie: schema.SingleNestedAttribute{
 						Optional:    true,
 						Description: AlertingChannelDescOpsGenie,
-						Attributes: map[string]schema.Attribute{
+						Attributes: shared.AddWriteOnlySecretAttributes(map[string]schema.Attribute{
 							AlertingChannelOpsGenieFieldAPIKey: schema.StringAttribute{
-								Required:    true,
+								Optional:    true,
 								Description: AlertingChannelDescOpsGenieAPIKey,
+								Validators:  shared.RequiredSecretValidators(AlertingChannelOpsGenieFieldAPIKey),
 							},
 							AlertingChannelOpsGenieFieldTags: schema.SetAttribute{
 								Required:    true,
@@ -90,7 +92,7 @@ func NewAlertingChannelResourceHandle() resourcehandle.ResourceHandle[*api.Alert
 									stringvalidator.OneOf(supportedOpsGenieRegions...),
 								},
 							},
-						},
+						}, AlertingChannelOpsGenieFieldAPIKey, AlertingChannelDescOpsGenieAPIKey),
 					},
 					AlertingChannelFieldChannelPageDuty: schema.SingleNestedAttribute{
 						Optional:    true,
@@ -123,30 +125,32 @@ func NewAlertingChannelResourceHandle() resourcehandle.ResourceHandle[*api.Alert
 					AlertingChannelFieldChannelSplunk: schema.SingleNestedAttribute{
 						Optional:    true,
 						Description: AlertingChannelDescSplunk,
-						Attributes: map[string]schema.Attribute{
+						Attributes: shared.AddWriteOnlySecretAttributes(map[string]schema.Attribute{
 							AlertingChannelSplunkFieldURL: schema.StringAttribute{
 								Required:    true,
 								Description: AlertingChannelDescSplunkURL,
 							},
 							AlertingChannelSplunkFieldToken: schema.StringAttribute{
-								Required:    true,
+								Optional:    true,
 								Description: AlertingChannelDescSplunkToken,
+								Validators:  shared.RequiredSecretValidators(AlertingChannelSplunkFieldToken),
 							},
-						},
+						}, AlertingChannelSplunkFieldToken, AlertingChannelDescSplunkToken),
 					},
 					AlertingChannelFieldChannelVictorOps: schema.SingleNestedAttribute{
 						Optional:    true,
 						Description: AlertingChannelDescVictorOps,
-						Attributes: map[string]schema.Attribute{
+						Attributes: shared.AddWriteOnlySecretAttributes(map[string]schema.Attribute{
 							AlertingChannelVictorOpsFieldAPIKey: schema.StringAttribute{
-								Required:    true,
+								Optional:    true,
 								Description: AlertingChannelDescVictorOpsAPIKey,
+								Validators:  shared.RequiredSecretValidators(AlertingChannelVictorOpsFieldAPIKey),
 							},
 							AlertingChannelVictorOpsFieldRoutingKey: schema.StringAttribute{
 								Required:    true,
 								Description: AlertingChannelDescVictorOpsRoutingKey,
 							},
-						},
+						}, AlertingChannelVictorOpsFieldAPIKey, AlertingChannelDescVictorOpsAPIKey),
 					},
 					AlertingChannelFieldChannelWebhook: schema.SingleNestedAttribute{
 						Optional:    true,
@@ -187,7 +191,7 @@ func NewAlertingChannelResourceHandle() resourcehandle.ResourceHandle[*api.Alert
 					AlertingChannelFieldChannelServiceNow: schema.SingleNestedAttribute{
 						Optional:    true,
 						Description: AlertingChannelDescServiceNow,
-						Attributes: map[string]schema.Attribute{
+						Attributes: shared.AddWriteOnlySecretAttributes(map[string]schema.Attribute{
 							AlertingChannelServiceNowFieldServiceNowURL: schema.StringAttribute{
 								Required:    true,
 								Description: AlertingChannelDescServiceNowURL,
@@ -208,12 +212,12 @@ func NewAlertingChannelResourceHandle() resourcehandle.ResourceHandle[*api.Alert
 								Optional:    true,
 								Description: AlertingChannelDescServiceNowAutoClose,
 							},
-						},
+						}, AlertingChannelServiceNowFieldPassword, AlertingChannelDescServiceNowPassword),
 					},
 					AlertingChannelFieldChannelServiceNowApplication: schema.SingleNestedAttribute{
 						Optional:    true,
 						Description: AlertingChannelDescServiceNowApplication,
-						Attributes: map[string]schema.Attribute{
+						Attributes: shared.AddWriteOnlySecretAttributes(map[string]schema.Attribute{
 							AlertingChannelServiceNowFieldServiceNowURL: schema.StringAttribute{
 								Required:    true,
 								Description: AlertingChannelDescServiceNowAppURL,
@@ -270,7 +274,7 @@ func NewAlertingChannelResourceHandle() resourcehandle.ResourceHandle[*api.Alert
 								Optional:    true,
 								Description: AlertingChannelDescServiceNowAppCloseStatus,
 							},
-						},
+						}, AlertingChannelServiceNowFieldPassword, AlertingChannelDescServiceNowAppPassword),
 					},
 					AlertingChannelFieldChannelPrometheusWebhook: schema.SingleNestedAttribute{
 						Optional:    true,
@@ -432,8 +436,15 @@ func (r *alertingChannelResource) UpdateState(ctx context.Context, state *tfsdk.
 	// 	model.RbacTags = planModel.RbacTags
 	// }
 
+	// The versions of the write-only secrets are not part of the API object and are kept from the plan (Create and
+	// Update) or the prior state (Read)
+	prior, priorDiags := r.getPriorModel(ctx, state, plan)
+	if priorDiags.HasError() {
+		return priorDiags
+	}
+
 	// Map channel-specific data based on channel type
-	channelDiags := r.mapChannelTypeToModel(ctx, alertingChannel, &model)
+	channelDiags := r.mapChannelTypeToModel(ctx, alertingChannel, &model, &prior)
 	if channelDiags.HasError() {
 		return channelDiags
 	}
@@ -443,6 +454,21 @@ func (r *alertingChannelResource) UpdateState(ctx context.Context, state *tfsdk.
 	return diags
 }
 
+// getPriorModel retrieves the model from the plan or, when no plan is given, from the prior state. An empty model is
+// returned when neither contains a value (e.g. on import).
+func (r *alertingChannelResource) getPriorModel(ctx context.Context, state *tfsdk.State, plan *tfsdk.Plan) (AlertingChannelModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var model AlertingChannelModel
+
+	if plan != nil && !plan.Raw.IsNull() {
+		diags.Append(plan.Get(ctx, &model)...)
+	} else if state != nil && !state.Raw.IsNull() {
+		diags.Append(state.Get(ctx, &model)...)
+	}
+
+	return model, diags
+}
+
 // createBaseModel creates the base model with common fields (ID, Name and RbacTags)
 func (r *alertingChannelResource) createBaseModel(alertingChannel *api.AlertingChannel) AlertingChannelModel {
 	return AlertingChannelModel{
@@ -509,22 +535,22 @@ func (r *alertingChannelResource) mapRbacTagsFromModel(rbacTagsList types.List)
 }
 
 // mapChannelTypeToModel maps the API channel data to the appropriate model field based on channel type
-func (r *alertingChannelResource) mapChannelTypeToModel(ctx context.Context, alertingChannel *api.AlertingChannel, model *AlertingChannelModel) diag.Diagnostics {
+func (r *alertingChannelResource) mapChannelTypeToModel(ctx context.Context, alertingChannel *api.AlertingChannel, model *AlertingChannelModel, prior *AlertingChannelModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch alertingChannel.Kind {
 	case api.EmailChannelType:
 		return r.mapEmailToModel(ctx, alertingChannel, model)
 	case api.OpsGenieChannelType:
-		return r.mapOpsGenieToModel(ctx, alertingChannel, model)
+		return r.mapOpsGenieToModel(ctx, alertingChannel, model, prior)
 	case api.PagerDutyChannelType:
 		return r.mapPagerDutyToModel(ctx, alertingChannel, model)
 	case api.SlackChannelType:
 		return r.mapSlackToModel(ctx, alertingChannel, model)
 	case api.SplunkChannelType:
-		return r.mapSplunkToModel(ctx, alertingChannel, model)
+		return r.mapSplunkToModel(ctx, alertingChannel, model, prior)
 	case api.VictorOpsChannelType:
-		return r.mapVictorOpsToModel(ctx, alertingChannel, model)
+		return r.mapVictorOpsToModel(ctx, alertingChannel, model, prior)
 	case api.WebhookChannelType:
 		return r.mapWebhookToModel(ctx, alertingChannel, model)
 	case api.Office365ChannelType:
@@ -532,9 +558,9 @@ func (r *alertingChannelResource) mapChannelTypeToModel(ctx context.Context, ale
 	case api.GoogleChatChannelType:
 		return r.mapGoogleChatToModel(ctx, alertingChannel, model)
 	case api.ServiceNowChannelType:
-		return r.mapServiceNowToModel(ctx, alertingChannel, model)
+		return r.mapServiceNowToModel(ctx, alertingChannel, model, prior)
 	case api.ServiceNowApplicationChannelType:
-		return r.mapServiceNowApplicationToModel(ctx, alertingChannel, model)
+		return r.mapServiceNowApplicationToModel(ctx, alertingChannel, model, prior)
 	case api.PrometheusWebhookChannelType:
 		return r.mapPrometheusWebhookToModel(ctx, alertingChannel, model)
 	case api.WebexTeamsWebhookChannelType:
@@ -563,10 +589,19 @@ func (r *alertingChannelResource) mapEmailToModel(ctx context.Context, channel *
 	return diags
 }
 
-func (r *alertingChannelResource) mapOpsGenieToModel(ctx context.Context, channel *api.AlertingChannel, model *AlertingChannelModel) diag.Diagnostics {
+func (r *alertingChannelResource) mapOpsGenieToModel(ctx context.Context, channel *api.AlertingChannel, model *AlertingChannelModel, prior *AlertingChannelModel) diag.Diagnostics {
+	apiKeyWoVersion := types.Int64Null()
+	if prior.OpsGenie != nil {
+		apiKeyWoVersion = prior.OpsGenie.APIKeyWoVersion
+	}
 	opsGenieChannel, diags := shared.MapOpsGenieChannelToState(ctx, channel)
 	if !diags.HasError() {
-		model.OpsGenie = opsGenieChannel
+		opsGenieChannel.APIKey = shared.WriteOnlySecretToState(opsGenieChannel.APIKey, apiKeyWoVersion)
+		model.OpsGenie = &shared.OpsGenieResourceModel{
+			OpsGenieModel:   *opsGenieChannel,
+			APIKeyWo:        types.StringNull(),
+			APIKeyWoVersion: apiKeyWoVersion,
+		}
 	}
 	return diags
 }
@@ -587,18 +622,36 @@ func (r *alertingChannelResource) mapSlackToModel(ctx context.Context, channel *
 	return diags
 }
 
-func (r *alertingChannelResource) mapSplunkToModel(ctx context.Context, channel *api.AlertingChannel, model *AlertingChannelModel) diag.Diagnostics {
+func (r *alertingChannelResource) mapSplunkToModel(ctx context.Context, channel *api.AlertingChannel, model *AlertingChannelModel, prior *AlertingChannelModel) diag.Diagnostics {
+	tokenWoVersion := types.Int64Null()
+	if prior.Splunk != nil {
+		tokenWoVersion = prior.Splunk.TokenWoVersion
+	}
 	splunkChannel, diags := shared.MapSplunkChannelToState(ctx, channel)
 	if !diags.HasError() {
-		model.Splunk = splunkChannel
+		splunkChannel.Token = shared.WriteOnlySecretToState(splunkChannel.Token, tokenWoVersion)
+		model.Splunk = &shared.SplunkResourceModel{
+			SplunkModel:    *splunkChannel,
+			TokenWo:        types.StringNull(),
+			TokenWoVersion: tokenWoVersion,
+		}
 	}
 	return diags
 }
 
-func (r *alertingChannelResource) mapVictorOpsToModel(ctx context.Context, channel *api.AlertingChannel, model *AlertingChannelModel) diag.Diagnostics {
+func (r *alertingChannelResource) mapVictorOpsToModel(ctx context.Context, channel *api.AlertingChannel, model *AlertingChannelModel, prior *AlertingChannelModel) diag.Diagnostics {
+	apiKeyWoVersion := types.Int64Null()
+	if prior.VictorOps != nil {
+		apiKeyWoVersion = prior.VictorOps.APIKeyWoVersion
+	}
 	victorOpsChannel, diags := shared.MapVictorOpsChannelToState(ctx, channel)
 	if !diags.HasError() {
-		model.VictorOps = victorOpsChannel
+		victorOpsChannel.APIKey = shared.WriteOnlySecretToState(victorOpsChannel.APIKey, apiKeyWoVersion)
+		model.VictorOps = &shared.VictorOpsResourceModel{
+			VictorOpsModel:  *victorOpsChannel,
+			APIKeyWo:        types.StringNull(),
+			APIKeyWoVersion: apiKeyWoVersion,
+		}
 	}
 	return diags
 }
@@ -627,18 +680,36 @@ func (r *alertingChannelResource) mapGoogleChatToModel(ctx context.Context, chan
 	return diags
 }
 
-func (r *alertingChannelResource) mapServiceNowToModel(ctx context.Context, channel *api.AlertingChannel, model *AlertingChannelModel) diag.Diagnostics {
+func (r *alertingChannelResource) mapServiceNowToModel(ctx context.Context, channel *api.AlertingChannel, model *AlertingChannelModel, prior *AlertingChannelModel) diag.Diagnostics {
+	passwordWoVersion := types.Int64Null()
+	if prior.ServiceNow != nil {
+		passwordWoVersion = prior.ServiceNow.PasswordWoVersion
+	}
 	serviceNowChannel, diags := shared.MapServiceNowChannelToState(ctx, channel)
 	if !diags.HasError() {
-		model.ServiceNow = serviceNowChannel
+		serviceNowChannel.Password = shared.WriteOnlySecretToState(serviceNowChannel.Password, passwordWoVersion)
+		model.ServiceNow = &shared.ServiceNowResourceModel{
+			ServiceNowModel:   *serviceNowChannel,
+			PasswordWo:        types.StringNull(),
+			PasswordWoVersion: passwordWoVersion,
+		}
 	}
 	return diags
 }
 
-func (r *alertingChannelResource) mapServiceNowApplicationToModel(ctx context.Context, channel *api.AlertingChannel, model *AlertingChannelModel) diag.Diagnostics {
+func (r *alertingChannelResource) mapServiceNowApplicationToModel(ctx context.Context, channel *api.AlertingChannel, model *AlertingChannelModel, prior *AlertingChannelModel) diag.Diagnostics {
+	passwordWoVersion := types.Int64Null()
+	if prior.ServiceNowApplication != nil {
+		passwordWoVersion = prior.ServiceNowApplication.PasswordWoVersion
+	}
 	serviceNowAppChannel, diags := shared.MapServiceNowApplicationChannelToState(ctx, channel)
 	if !diags.HasError() {
-		model.ServiceNowApplication = serviceNowAppChannel
+		serviceNowAppChannel.Password = shared.WriteOnlySecretToState(serviceNowAppChannel.Password, passwordWoVersion)
+		model.ServiceNowApplication = &shared.ServiceNowApplicationResourceModel{
+			ServiceNowApplicationModel: *serviceNowAppChannel,
+			PasswordWo:                 types.StringNull(),
+			PasswordWoVersion:          passwordWoVersion,
+		}
 	}
 	return diags
 }
@@ -859,10 +930,11 @@ func (r *alertingChannelResource) mapWebhookBasedChannelFromState(ctx context.Co
 }
 
 // mapServiceNowChannelFromState converts ServiceNow channel state to API object
-func (r *alertingChannelResource) mapServiceNowChannelFromState(ctx context.Context, id string, name string, serviceNow *shared.ServiceNowModel) (*api.AlertingChannel, diag.Diagnostics) {
+func (r *alertingChannelResource) mapServiceNowChannelFromState(ctx context.Context, id string, name string, serviceNow *shared.ServiceNowResourceModel) (*api.AlertingChannel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	if serviceNow.Password.IsNull() || serviceNow.Password.IsUnknown() {
+	// The password is not part of the plan and state when it is managed by the write-only attribute
+	if (serviceNow.Password.IsNull() || serviceNow.Password.IsUnknown()) && !shared.IsWriteOnlySecret(serviceNow.PasswordWoVersion) {
 		diags.AddError(AlertingChannelErrMissingPassword, AlertingChannelErrMissingPasswordMsg)
 		return nil, diags
 	}
@@ -889,10 +961,11 @@ func (r *alertingChannelResource) mapServiceNowChannelFromState(ctx context.Cont
 }
 
 // mapServiceNowApplicationChannelFromState converts ServiceNow Enhanced (ITSM) channel state to API object
-func (r *alertingChannelResource) mapServiceNowApplicationChannelFromState(ctx context.Context, id string, name string, serviceNowApp *shared.ServiceNowApplicationModel) (*api.AlertingChannel, diag.Diagnostics) {
+func (r *alertingChannelResource) mapServiceNowApplicationChannelFromState(ctx context.Context, id string, name string, serviceNowApp *shared.ServiceNowApplicationResourceModel) (*api.AlertingChannel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	if serviceNowApp.Password.IsNull() || serviceNowApp.Password.IsUnknown() {
+	// The password is not part of the plan and state when it is managed by the write-only attribute
+	if (serviceNowApp.Password.IsNull() || serviceNowApp.Password.IsUnknown()) && !shared.IsWriteOnlySecret(serviceNowApp.PasswordWoVersion) {
 		diags.AddError(AlertingChannelErrMissingPassword, AlertingChannelErrMissingPasswordMsg)
 		return nil, diags
 	}
@@ -1093,6 +1166,18 @@ func (r *alertingChannelResource) MapStateToDataObject(ctx context.Context, plan
 	return r.applyRbacTags(channel, model), diags
 }
 
+// MapWriteOnlyAttributes applies the write-only secrets of the configured channel to the API object
+func (r *alertingChannelResource) MapWriteOnlyAttributes(ctx context.Context, config *tfsdk.Config, channel *api.AlertingChannel) diag.Diagnostics {
+	var model AlertingChannelModel
+	diags := config.Get(ctx, &model)
+	if diags.HasError() {
+		return diags
+	}
+
+	shared.MapWriteOnlyChannelSecrets(channel, model.OpsGenie, model.Splunk, model.VictorOps, model.ServiceNow, model.ServiceNowApplication)
+	return diags
+}
+
 // getModelFromPlanOrState retrieves the model from either plan or state
 func (r *alertingChannelResource) getModelFromPlanOrState(ctx context.Context, plan *tfsdk.Plan, state *tfsdk.State) (AlertingChannelModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -1127,7 +1212,7 @@ func (r *alertingChannelResource) mapConfiguredChannelType(ctx context.Context,
 		return r.mapEmailChannelFromState(ctx, id, name, model.Email)
 	}
 	if model.OpsGenie != nil {
-		return r.mapOpsGenieChannelFromState(ctx, id, name, model.OpsGenie)
+		return r.mapOpsGenieChannelFromState(ctx, id, name, &model.OpsGenie.OpsGenieModel)
 	}
 	if model.PagerDuty != nil {
 		return r.mapPagerDutyChannelFromState(ctx, id, name, model.PagerDuty)
@@ -1136,10 +1221,10 @@ func (r *alertingChannelResource) mapConfiguredChannelType(ctx context.Context,
 		return r.mapSlackChannelFromState(ctx, id, name, model.Slack)
 	}
 	if model.Splunk != nil {
-		return r.mapSplunkChannelFromState(ctx, id, name, model.Splunk)
+		return r.mapSplunkChannelFromState(ctx, id, name, &model.Splunk.SplunkModel)
 	}
 	if model.VictorOps != nil {
-		return r.mapVictorOpsChannelFromState(ctx, id, name, model.VictorOps)
+		return r.mapVictorOpsChannelFromState(ctx, id, name, &model.VictorOps.VictorOpsModel)
 	}
 	if model.Webhook != nil {
 		return r.mapWebhookChannelFromState(ctx, id, name, model.Webhook)
@@ -267,12 +267,12 @@ func TestMapServiceNowChannelFromState(t *testing.T) {
 
 	t.Run("with all fields", func(t *testing.T) {
 		autoClose := true
-		serviceNowModel := &shared.ServiceNowModel{
+		serviceNowModel := &shared.ServiceNowResourceModel{ServiceNowModel: shared.ServiceNowModel{
 			ServiceNowURL:      types.StringValue("https://servicenow.example.com"),
 			Username:           types.StringValue("test-user"),
 			Password:           types.StringValue("test-password"),
 			AutoCloseIncidents: types.BoolValue(autoClose),
-		}
+		}}
 
 		channel, diags := resource.mapServiceNowChannelFromState(ctx, "test-id", "test-name", serviceNowModel)
 		require.False(t, diags.HasError())
@@ -285,12 +285,12 @@ func TestMapServiceNowChannelFromState(t *testing.T) {
 	})
 
 	t.Run("missing password", func(t *testing.T) {
-		serviceNowModel := &shared.ServiceNowModel{
+		serviceNowModel := &shared.ServiceNowResourceModel{ServiceNowModel: shared.ServiceNowModel{
 			ServiceNowURL:      types.StringValue("https://servicenow.example.com"),
 			Username:           types.StringValue("test-user"),
 			Password:           types.StringNull(),
 			AutoCloseIncidents: types.BoolNull(),
-		}
+		}}
 
 		channel, diags := resource.mapServiceNowChannelFromState(ctx, "test-id", "test-name", serviceNowModel)
 		require.True(t, diags.HasError())
@@ -304,7 +304,7 @@ func TestMapServiceNowApplicationChannelFromState(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("with all fields", func(t *testing.T) {
-		serviceNowAppModel := &shared.ServiceNowApplicationModel{
+		serviceNowAppModel := &shared.ServiceNowApplicationResourceModel{ServiceNowApplicationModel: shared.ServiceNowApplicationModel{
 			ServiceNowURL:                  types.StringValue("https://servicenow.example.com"),
 			Username:                       types.StringValue("test-user"),
 			Password:                       types.StringValue("test-password"),
@@ -318,7 +318,7 @@ func TestMapServiceNowApplicationChannelFromState(t *testing.T) {
 			ManuallyClosedIncidents:        types.BoolValue(false),
 			ResolutionOfIncident:           types.BoolValue(true),
 			SnowStatusOnCloseEvent:         types.Int64Value(6),
-		}
+		}}
 
 		channel, diags := resource.mapServiceNowApplicationChannelFromState(ctx, "test-id", "test-name", serviceNowAppModel)
 		require.False(t, diags.HasError())
@@ -335,28 +335,28 @@ func TestMapServiceNowApplicationChannelFromState(t *testing.T) {
 	})
 
 	t.Run("missing password", func(t *testing.T) {
-		serviceNowAppModel := &shared.ServiceNowApplicationModel{
+		serviceNowAppModel := &shared.ServiceNowApplicationResourceModel{ServiceNowApplicationModel: shared.ServiceNowApplicationModel{
 			ServiceNowURL: types.StringValue("https://servicenow.example.com"),
 			Username:      types.StringValue("test-user"),
 			Password:      types.StringNull(),
 			Tenant:        types.StringValue("test-tenant"),
 			Unit:          types.StringValue("test-unit"),
 			InstanaURL:    types.StringValue("https://api.example.com"),
-		}
+		}}
 
 		_, diags := resource.mapServiceNowApplicationChannelFromState(ctx, "test-id", "test-name", serviceNowAppModel)
 		require.True(t, diags.HasError())
 	})
 
 	t.Run("missing InstanaURL", func(t *testing.T) {
-		serviceNowAppModel := &shared.ServiceNowApplicationModel{
+		serviceNowAppModel := &shared.ServiceNowApplicationResourceModel{ServiceNowApplicationModel: shared.ServiceNowApplicationModel{
 			ServiceNowURL: types.StringValue("https://servicenow.example.com"),
 			Username:      types.StringValue("test-user"),
 			Password:      types.StringValue("test-password"),
 			Tenant:        types.StringValue("test-tenant"),
 			Unit:          types.StringValue("test-unit"),
 			InstanaURL:    types.StringNull(),
-		}
+		}}
 
 		_, diags := resource.mapServiceNowApplicationChannelFromState(ctx, "test-id", "test-name", serviceNowAppModel)
 		require.True(t, diags.HasError())
@@ -479,11 +479,11 @@ func TestMapStateToDataObject(t *testing.T) {
 			ID:       types.StringValue("test-id"),
 			Name:     types.StringValue("test-name"),
 			RbacTags: emptyList,
-			OpsGenie: &shared.OpsGenieModel{
+			OpsGenie: &shared.OpsGenieResourceModel{OpsGenieModel: shared.OpsGenieModel{
 				APIKey: types.StringValue("api-key"),
 				Region: types.StringValue("EU"),
 				Tags:   tagsList,
-			},
+			}},
 		}
 
 		state := createMockState(t, ctx, model)
@@ -559,10 +559,10 @@ func TestMapStateToDataObject(t *testing.T) {
 			ID:       types.StringValue("test-id"),
 			Name:     types.StringValue("test-name"),
 			RbacTags: emptyList,
-			Splunk: &shared.SplunkModel{
+			Splunk: &shared.SplunkResourceModel{SplunkModel: shared.SplunkModel{
 				URL:   types.StringValue("https://splunk.com"),
 				Token: types.StringValue("token"),
-			},
+			}},
 		}
 
 		state := createMockState(t, ctx, model)
@@ -586,10 +586,10 @@ func TestMapStateToDataObject(t *testing.T) {
 			ID:       types.StringValue("test-id"),
 			Name:     types.StringValue("test-name"),
 			RbacTags: emptyList,
-			VictorOps: &shared.VictorOpsModel{
+			VictorOps: &shared.VictorOpsResourceModel{VictorOpsModel: shared.VictorOpsModel{
 				APIKey:     types.StringValue("api-key"),
 				RoutingKey: types.StringValue("routing-key"),
-			},
+			}},
 		}
 
 		state := createMockState(t, ctx, model)
@@ -695,11 +695,11 @@ func TestMapStateToDataObject(t *testing.T) {
 			ID:       types.StringValue("test-id"),
 			Name:     types.StringValue("test-name"),
 			RbacTags: emptyList,
-			ServiceNow: &shared.ServiceNowModel{
+			ServiceNow: &shared.ServiceNowResourceModel{ServiceNowModel: shared.ServiceNowModel{
 				ServiceNowURL: types.StringValue("https://servicenow.com"),
 				Username:      types.StringValue("user"),
 				Password:      types.StringValue("pass"),
-			},
+			}},
 		}
 
 		state := createMockState(t, ctx, model)
@@ -723,14 +723,14 @@ func TestMapStateToDataObject(t *testing.T) {
 			ID:       types.StringValue("test-id"),
 			Name:     types.StringValue("test-name"),
 			RbacTags: emptyList,
-			ServiceNowApplication: &shared.ServiceNowApplicationModel{
+			ServiceNowApplication: &shared.ServiceNowApplicationResourceModel{ServiceNowApplicationModel: shared.ServiceNowApplicationModel{
 				ServiceNowURL: types.StringValue("https://servicenow.com"),
 				Username:      types.StringValue("user"),
 				Password:      types.StringValue("pass"),
 				Tenant:        types.StringValue("tenant"),
 				Unit:          types.StringValue("unit"),
 				InstanaURL:    types.StringValue("https://api.com"),
-			},
+			}},
 		}
 
 		state := createMockState(t, ctx, model)
@@ -1221,6 +1221,114 @@ func TestUpdateState(t *testing.T) {
 	})
 }
 
+func TestWriteOnlySecrets(t *testing.T) {
+	resource := &alertingChannelResource{}
+	ctx := context.Background()
+	tagsSet, _ := types.SetValueFrom(ctx, types.StringType, []string{"tag1"})
+	emptyList, _ := types.ListValue(
+		types.ObjectType{AttrTypes: map[string]attr.Type{
+			AlertingChannelFieldRbacTagID:          types.StringType,
+			AlertingChannelFieldRbacTagDisplayName: types.StringType,
+		}},
+		[]attr.Value{},
+	)
+
+	t.Run("should not store secret in state when write-only variant is used", func(t *testing.T) {
+		prior := createMockState(t, ctx, AlertingChannelModel{
+			ID:       types.StringValue("test-id"),
+			Name:     types.StringValue("test-name"),
+			RbacTags: emptyList,
+			OpsGenie: &shared.OpsGenieResourceModel{
+				OpsGenieModel: shared.OpsGenieModel{
+					APIKey: types.StringNull(),
+					Region: types.StringValue("EU"),
+					Tags:   tagsSet,
+				},
+				APIKeyWoVersion: types.Int64Value(2),
+			},
+		})
+		apiKey := "secret-api-key"
+		region := "EU"
+		tags := "tag1"
+
+		diags := resource.UpdateState(ctx, prior, nil, &api.AlertingChannel{
+			ID:     "test-id",
+			Name:   "test-name",
+			Kind:   api.OpsGenieChannelType,
+			APIKey: &apiKey,
+			Region: &region,
+			Tags:   &tags,
+		})
+		require.False(t, diags.HasError())
+
+		var model AlertingChannelModel
+		require.False(t, prior.Get(ctx, &model).HasError())
+		require.NotNil(t, model.OpsGenie)
+		assert.True(t, model.OpsGenie.APIKey.IsNull())
+		assert.True(t, model.OpsGenie.APIKeyWo.IsNull())
+		assert.Equal(t, int64(2), model.OpsGenie.APIKeyWoVersion.ValueInt64())
+	})
+
+	t.Run("should store secret in state when write-only variant is not used", func(t *testing.T) {
+		state := &tfsdk.State{Schema: NewAlertingChannelResourceHandle().MetaData().Schema}
+		url := "https://splunk.com"
+		token := "secret-token"
+
+		diags := resource.UpdateState(ctx, state, nil, &api.AlertingChannel{
+			ID:    "test-id",
+			Name:  "test-name",
+			Kind:  api.SplunkChannelType,
+			URL:   &url,
+			Token: &token,
+		})
+		require.False(t, diags.HasError())
+
+		var model AlertingChannelModel
+		require.False(t, state.Get(ctx, &model).HasError())
+		require.NotNil(t, model.Splunk)
+		assert.Equal(t, token, model.Splunk.Token.ValueString())
+		assert.True(t, model.Splunk.TokenWoVersion.IsNull())
+	})
+
+	t.Run("should allow missing ServiceNow password when write-only variant is used", func(t *testing.T) {
+		channel, diags := resource.mapServiceNowChannelFromState(ctx, "test-id", "test-name", &shared.ServiceNowResourceModel{
+			ServiceNowModel: shared.ServiceNowModel{
+				ServiceNowURL: types.StringValue("https://servicenow.example.com"),
+				Username:      types.StringValue("test-user"),
+				Password:      types.StringNull(),
+			},
+			PasswordWoVersion: types.Int64Value(1),
+		})
+		require.False(t, diags.HasError())
+		require.NotNil(t, channel)
+	})
+
+	t.Run("should apply write-only secret from config", func(t *testing.T) {
+		state := createMockState(t, ctx, AlertingChannelModel{
+			ID:       types.StringValue("test-id"),
+			Name:     types.StringValue("test-name"),
+			RbacTags: emptyList,
+			VictorOps: &shared.VictorOpsResourceModel{
+				VictorOpsModel: shared.VictorOpsModel{
+					APIKey:     types.StringNull(),
+					RoutingKey: types.StringValue("routing-key"),
+				},
+				APIKeyWo:        types.StringValue("secret-api-key"),
+				APIKeyWoVersion: types.Int64Value(1),
+			},
+		})
+		config := &tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+
+		channel, diags := resource.MapStateToDataObject(ctx, nil, state)
+		require.False(t, diags.HasError())
+		diags = resource.MapWriteOnlyAttributes(ctx, config, channel)
+		require.False(t, diags.HasError())
+
+		assert.Equal(t, "secret-api-key", *channel.APIKey)
+		assert.Equal(t, "routing-key", *channel.RoutingKey)
+	})
+}
+
 // Helper function to create a mock state with a model
 func createMockState(t *testing.T, ctx context.Context, model AlertingChannelModel) *tfsdk.State {
 	handle := NewAlertingChannelResourceHandle()
@@ -20,6 +20,8 @@ import (
 	"github.com/instana/instana-go-client/shared/rest"
 	common "github.com/instana/instana-go-client/shared/types"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/alertingchannel"
+	"github.com/instana/terraform-provider-instana/internal/resources/applicationalertconfig"
 	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/instana/terraform-provider-instana/internal/util"
 )
@@ -28,7 +30,8 @@ import (
 func NewAlertingConfigResourceHandle() resourcehandle.ResourceHandle[*api.AlertingConfiguration] {
 	return &alertingConfigResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName: ResourceInstanaAlertingConfig,
+			ResourceName:        ResourceInstanaAlertingConfig,
+			ImportNameAttribute: AlertingConfigFieldAlertName,
 			Schema: schema.Schema{
 				Description: AlertingConfigDescResource,
 				Attributes: map[string]schema.Attribute{
@@ -100,6 +103,10 @@ func NewAlertingConfigResourceHandle() resourcehandle.ResourceHandle[*api.Alerti
 				},
 			},
 			SchemaVersion: 3,
+			ReferenceAttributes: map[string]string{
+				AlertingConfigFieldIntegrationIds:                 alertingchannel.ResourceInstanaAlertingChannel,
+				AlertingConfigFieldEventFilterApplicationAlertIDs: applicationalertconfig.ResourceInstanaApplicationAlertConfig,
+			},
 		},
 	}
 }
@@ -2,6 +2,7 @@ package apdexconfig
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/terraform-provider-instana/internal/shared"
 )
 
 // ApdexConfigModel represents the data model for the Apdex configuration resource
@@ -27,18 +28,18 @@ type ApdexEntityModel struct {
 
 // ApplicationApdexEntityModel represents an application entity in the Terraform model
 type ApplicationApdexEntityModel struct {
-	EntityID         types.String `tfsdk:"entity_id"`
-	Threshold        types.Int64  `tfsdk:"threshold"`
-	BoundaryScope    types.String `tfsdk:"boundary_scope"`
-	IncludeInternal  types.Bool   `tfsdk:"include_internal"`
-	IncludeSynthetic types.Bool   `tfsdk:"include_synthetic"`
-	FilterExpression types.String `tfsdk:"filter_expression"`
+	EntityID         types.String          `tfsdk:"entity_id"`
+	Threshold        types.Int64           `tfsdk:"threshold"`
+	BoundaryScope    types.String          `tfsdk:"boundary_scope"`
+	IncludeInternal  types.Bool            `tfsdk:"include_internal"`
+	IncludeSynthetic types.Bool            `tfsdk:"include_synthetic"`
+	FilterExpression shared.TagFilterValue `tfsdk:"filter_expression"`
 }
 
 // WebsiteApdexEntityModel represents a website entity in the Terraform model
 type WebsiteApdexEntityModel struct {
-	EntityID         types.String `tfsdk:"entity_id"`
-	Threshold        types.Int64  `tfsdk:"threshold"`
-	BeaconType       types.String `tfsdk:"beacon_type"`
-	FilterExpression types.String `tfsdk:"filter_expression"`
+	EntityID         types.String          `tfsdk:"entity_id"`
+	Threshold        types.Int64           `tfsdk:"threshold"`
+	BeaconType       types.String          `tfsdk:"beacon_type"`
+	FilterExpression shared.TagFilterValue `tfsdk:"filter_expression"`
 }
@@ -22,6 +22,7 @@ import (
 	tag "github.com/instana/instana-go-client/shared/tagfilter"
 	common "github.com/instana/instana-go-client/shared/types"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
 	"github.com/instana/terraform-provider-instana/internal/util"
 )
@@ -168,6 +169,13 @@ func buildApplicationEntityAttribute() schema.SingleNestedAttribute {
 			ApdexConfigFieldFilterExpression: schema.StringAttribute{
 				Optional:    true,
 				Description: ApdexConfigDescFilterExpression,
+				CustomType:  shared.TagFilterType{},
+				Validators: []validator.String{
+					shared.TagFilterValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					shared.TagFilterPlanModifier(),
+				},
 			},
 		},
 	}
@@ -201,6 +209,13 @@ func buildWebsiteEntityAttribute() schema.SingleNestedAttribute {
 			ApdexConfigFieldFilterExpression: schema.StringAttribute{
 				Optional:    true,
 				Description: ApdexConfigDescFilterExpression,
+				CustomType:  shared.TagFilterType{},
+				Validators: []validator.String{
+					shared.TagFilterValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					shared.TagFilterPlanModifier(),
+				},
 			},
 		},
 	}
@@ -398,7 +413,7 @@ func (r *apdexConfigResource) validateAndMapWebsiteEntity(model *WebsiteApdexEnt
 }
 
 // mapFilterExpressionToEntity converts filter expression to API model
-func (r *apdexConfigResource) mapFilterExpressionToEntity(filterExpression types.String) (*tag.TagFilter, diag.Diagnostics) {
+func (r *apdexConfigResource) mapFilterExpressionToEntity(filterExpression shared.TagFilterValue) (*tag.TagFilter, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	if !filterExpression.IsNull() && !filterExpression.IsUnknown() && filterExpression.ValueString() != "" {
@@ -551,12 +566,12 @@ func (r *apdexConfigResource) buildApplicationEntityModel(entity api.ApdexEntity
 	if entity.TagFilter != nil {
 		expression, err := tagfilter.MapTagFilterToNormalizedString(entity.TagFilter)
 		if err == nil && expression != nil {
-			model.FilterExpression = types.StringValue(*expression)
+			model.FilterExpression = shared.NewTagFilterValue(*expression)
 		} else {
-			model.FilterExpression = types.StringNull()
+			model.FilterExpression = shared.NewTagFilterNull()
 		}
 	} else {
-		model.FilterExpression = types.StringNull()
+		model.FilterExpression = shared.NewTagFilterNull()
 	}
 
 	return model
@@ -577,12 +592,12 @@ func (r *apdexConfigResource) buildWebsiteEntityModel(entity api.ApdexEntity) We
 	if entity.TagFilter != nil {
 		expression, err := tagfilter.MapTagFilterToNormalizedString(entity.TagFilter)
 		if err == nil && expression != nil {
-			model.FilterExpression = types.StringValue(*expression)
+			model.FilterExpression = shared.NewTagFilterValue(*expression)
 		} else {
-			model.FilterExpression = types.StringNull()
+			model.FilterExpression = shared.NewTagFilterNull()
 		}
 	} else {
-		model.FilterExpression = types.StringNull()
+		model.FilterExpression = shared.NewTagFilterNull()
 	}
 
 	return model
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	api "github.com/instana/instana-go-client/api"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -63,7 +64,7 @@ func TestMapStateToDataObject_ApplicationEntity(t *testing.T) {
 				BoundaryScope:    types.StringValue("ALL"),
 				IncludeInternal:  types.BoolValue(true),
 				IncludeSynthetic: types.BoolValue(false),
-				FilterExpression: types.StringValue("call.http.status EQUALS 200"),
+				FilterExpression: shared.NewTagFilterValue("call.http.status EQUALS 200"),
 			},
 		}
 
@@ -162,7 +163,7 @@ func TestMapStateToDataObject_ApplicationEntity(t *testing.T) {
 				EntityID:         types.StringValue("app-123"),
 				Threshold:        types.Int64Value(500),
 				BoundaryScope:    types.StringValue("ALL"),
-				FilterExpression: types.StringValue("invalid((expression"),
+				FilterExpression: shared.NewTagFilterValue("invalid((expression"),
 			},
 		}
 
@@ -182,7 +183,7 @@ func TestMapStateToDataObject_WebsiteEntity(t *testing.T) {
 				EntityID:         types.StringValue("website-123"),
 				Threshold:        types.Int64Value(1000),
 				BeaconType:       types.StringValue("pageLoad"),
-				FilterExpression: types.StringValue("beacon.page.name CONTAINS 'checkout'"),
+				FilterExpression: shared.NewTagFilterValue("beacon.page.name CONTAINS 'checkout'"),
 			},
 		}
 
@@ -265,7 +266,7 @@ func TestMapStateToDataObject_WebsiteEntity(t *testing.T) {
 				EntityID:         types.StringValue("website-123"),
 				Threshold:        types.Int64Value(1000),
 				BeaconType:       types.StringValue("pageLoad"),
-				FilterExpression: types.StringValue("invalid((expression"),
+				FilterExpression: shared.NewTagFilterValue("invalid((expression"),
 			},
 		}
 
@@ -407,35 +408,35 @@ func TestMapFilterExpressionToEntity(t *testing.T) {
 	resource := &apdexConfigResource{}
 
 	t.Run("should return default filter for empty expression", func(t *testing.T) {
-		result, diags := resource.mapFilterExpressionToEntity(types.StringValue(""))
+		result, diags := resource.mapFilterExpressionToEntity(shared.NewTagFilterValue(""))
 
 		assert.False(t, diags.HasError())
 		require.NotNil(t, result)
 	})
 
 	t.Run("should parse valid filter expression", func(t *testing.T) {
-		result, diags := resource.mapFilterExpressionToEntity(types.StringValue("call.http.status EQUALS 200"))
+		result, diags := resource.mapFilterExpressionToEntity(shared.NewTagFilterValue("call.http.status EQUALS 200"))
 
 		assert.False(t, diags.HasError())
 		require.NotNil(t, result)
 	})
 
 	t.Run("should parse complex filter expression with AND", func(t *testing.T) {
-		result, diags := resource.mapFilterExpressionToEntity(types.StringValue("call.http.status EQUALS 200 AND call.http.method EQUALS 'GET'"))
+		result, diags := resource.mapFilterExpressionToEntity(shared.NewTagFilterValue("call.http.status EQUALS 200 AND call.http.method EQUALS 'GET'"))
 
 		assert.False(t, diags.HasError())
 		require.NotNil(t, result)
 	})
 
 	t.Run("should return error for invalid filter expression", func(t *testing.T) {
-		_, diags := resource.mapFilterExpressionToEntity(types.StringValue("invalid((expression"))
+		_, diags := resource.mapFilterExpressionToEntity(shared.NewTagFilterValue("invalid((expression"))
 
 		assert.True(t, diags.HasError())
 		assert.Contains(t, diags[0].Summary(), ApdexConfigErrParsingFilterExpression)
 	})
 
 	t.Run("should return error for malformed filter expression", func(t *testing.T) {
-		_, diags := resource.mapFilterExpressionToEntity(types.StringValue("call.http.status EQUALS"))
+		_, diags := resource.mapFilterExpressionToEntity(shared.NewTagFilterValue("call.http.status EQUALS"))
 
 		assert.True(t, diags.HasError())
 		assert.Contains(t, diags[0].Summary(), ApdexConfigErrParsingFilterExpression)
@@ -0,0 +1,209 @@
+package apitoken
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	restapi "github.com/instana/instana-go-client/api"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+	"github.com/instana/terraform-provider-instana/internal/util"
+)
+
+// ephemeralAPITokenPrivateDataKey the key of the private data of the ephemeral resource holding the IDs of the
+// created API token
+const ephemeralAPITokenPrivateDataKey = "api_token"
+
+// ephemeralAPITokenPrivateData the IDs of the created API token which are required to delete the token on close
+type ephemeralAPITokenPrivateData struct {
+	ID         string `json:"id"`
+	InternalID string `json:"internalId"`
+}
+
+// NewAPITokenEphemeralResource creates the ephemeral resource for short-lived API tokens
+func NewAPITokenEphemeralResource() ephemeral.EphemeralResource {
+	return &apiTokenEphemeralResource{}
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure = &apiTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &apiTokenEphemeralResource{}
+)
+
+type apiTokenEphemeralResource struct {
+	instanaAPI client.InstanaAPI
+}
+
+func (r *apiTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + EphemeralResourceInstanaAPIToken
+}
+
+// Schema returns the schema of the ephemeral resource. The permission flags are taken from the schema of the API
+// token resource, so that both support the same permissions and validations.
+func (r *apiTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		APITokenFieldID: schema.StringAttribute{
+			Computed:    true,
+			Description: APITokenDescID,
+		},
+		APITokenFieldAccessGrantingToken: schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: APITokenDescAccessGrantingToken,
+		},
+		APITokenFieldInternalID: schema.StringAttribute{
+			Computed:    true,
+			Description: APITokenDescInternalID,
+		},
+		APITokenFieldName: schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: APITokenDescEphemeralName,
+		},
+	}
+	for name, attribute := range NewAPITokenResourceHandle().MetaData().Schema.Attributes {
+		if permission, ok := attribute.(resourceschema.BoolAttribute); ok {
+			attributes[name] = schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: permission.Description,
+				Validators:  permission.Validators,
+			}
+		}
+	}
+	resp.Schema = schema.Schema{
+		Description: APITokenDescEphemeralResource,
+		Attributes:  attributes,
+	}
+}
+
+func (r *apiTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerMeta, ok := req.ProviderData.(*shared.ProviderMeta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			APITokenErrUnexpectedConfigureType,
+			fmt.Sprintf(APITokenErrUnexpectedConfigureTypeDetail, req.ProviderData),
+		)
+		return
+	}
+
+	r.instanaAPI = providerMeta.InstanaAPI
+}
+
+// Open creates the API token and keeps its IDs in the private data so that the token can be deleted on close. When the
+// response cannot be populated, Terraform never closes the ephemeral resource, so the created token is deleted again.
+func (r *apiTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model APITokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiToken, diags := r.createAPIToken(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateOpenResponse(ctx, apiToken, resp)...)
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(r.deleteAPITokenByIDs(ctx, ephemeralAPITokenPrivateData{ID: apiToken.ID, InternalID: apiToken.InternalID})...)
+	}
+}
+
+// populateOpenResponse stores the IDs of the created API token in the private data and sets the result of the response
+func (r *apiTokenEphemeralResource) populateOpenResponse(ctx context.Context, apiToken *restapi.APIToken, resp *ephemeral.OpenResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	privateData, err := json.Marshal(ephemeralAPITokenPrivateData{ID: apiToken.ID, InternalID: apiToken.InternalID})
+	if err != nil {
+		diags.AddError(APITokenErrCreating, fmt.Sprintf(APITokenErrCreatingDetail, err))
+		return diags
+	}
+	diags.Append(resp.Private.SetKey(ctx, ephemeralAPITokenPrivateDataKey, privateData)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	result := (&apiTokenResource{}).mapAPITokenToModel(apiToken)
+	diags.Append(resp.Result.Set(ctx, &result)...)
+	return diags
+}
+
+// Close deletes the API token created by Open
+func (r *apiTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateData, diags := req.Private.GetKey(ctx, ephemeralAPITokenPrivateDataKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateData == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.deleteAPIToken(ctx, privateData)...)
+}
+
+// createAPIToken creates the API token of the given configuration. Permissions which are not configured are not
+// granted, so that the token is limited to the permissions required by the run.
+func (r *apiTokenEphemeralResource) createAPIToken(ctx context.Context, model APITokenModel) (*restapi.APIToken, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if r.instanaAPI == nil {
+		diags.AddError(APITokenErrProviderNotConfigured, APITokenErrProviderNotConfiguredDetail)
+		return nil, diags
+	}
+
+	name := model.Name.ValueString()
+	if model.Name.IsNull() || model.Name.IsUnknown() {
+		name = EphemeralAPITokenNamePrefix + util.RandomID()
+	}
+	apiToken := &restapi.APIToken{
+		AccessGrantingToken: util.RandomID(),
+		InternalID:          util.RandomID(),
+		Name:                name,
+	}
+	mapper := &apiTokenResource{}
+	mapper.mapPermissionsFromModel(model, apiToken)
+	mapper.mapScopeLimitationsFromModel(model, apiToken)
+	mapper.mapAdditionalPermissionsFromModel(model, apiToken)
+
+	tflog.Debug(ctx, "Creating ephemeral API token", map[string]interface{}{"name": apiToken.Name})
+	createdAPIToken, err := r.instanaAPI.APITokens().Create(apiToken)
+	if err != nil {
+		diags.AddError(APITokenErrCreating, fmt.Sprintf(APITokenErrCreatingDetail, err))
+		return nil, diags
+	}
+	return createdAPIToken, diags
+}
+
+// deleteAPIToken deletes the API token identified by the given private data of the ephemeral resource
+func (r *apiTokenEphemeralResource) deleteAPIToken(ctx context.Context, privateData []byte) diag.Diagnostics {
+	var ids ephemeralAPITokenPrivateData
+	if err := json.Unmarshal(privateData, &ids); err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(APITokenErrDeleting, fmt.Sprintf(APITokenErrDeletingDetail, string(privateData), err))
+		return diags
+	}
+	return r.deleteAPITokenByIDs(ctx, ids)
+}
+
+// deleteAPITokenByIDs deletes the API token with the given IDs
+func (r *apiTokenEphemeralResource) deleteAPITokenByIDs(ctx context.Context, ids ephemeralAPITokenPrivateData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.instanaAPI == nil {
+		diags.AddError(APITokenErrProviderNotConfigured, APITokenErrProviderNotConfiguredDetail)
+		return diags
+	}
+
+	tflog.Debug(ctx, "Deleting ephemeral API token", map[string]interface{}{"internal_id": ids.InternalID})
+	if err := r.instanaAPI.APITokens().Delete(&restapi.APIToken{ID: ids.ID, InternalID: ids.InternalID}); err != nil {
+		diags.AddError(APITokenErrDeleting, fmt.Sprintf(APITokenErrDeletingDetail, ids.InternalID, err))
+	}
+	return diags
+}
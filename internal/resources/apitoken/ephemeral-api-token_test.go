@@ -0,0 +1,222 @@
+package apitoken
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/instana/instana-go-client/api"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testAPITokenRestResource struct {
+	rest.RestResource[*api.APIToken]
+	created []*api.APIToken
+	deleted []*api.APIToken
+	err     error
+}
+
+func (r *testAPITokenRestResource) Create(apiToken *api.APIToken) (*api.APIToken, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	r.created = append(r.created, apiToken)
+	created := *apiToken
+	created.ID = "token-id"
+	return &created, nil
+}
+
+func (r *testAPITokenRestResource) Delete(apiToken *api.APIToken) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.deleted = append(r.deleted, apiToken)
+	return nil
+}
+
+type testInstanaAPI struct {
+	testutils.MockInstanaAPI
+	apiTokens *testAPITokenRestResource
+}
+
+func (a *testInstanaAPI) APITokens() rest.RestResource[*api.APIToken] {
+	return a.apiTokens
+}
+
+func newConfiguredEphemeralResource(t *testing.T, apiTokens *testAPITokenRestResource) *apiTokenEphemeralResource {
+	r := NewAPITokenEphemeralResource().(*apiTokenEphemeralResource)
+	resp := &ephemeral.ConfigureResponse{}
+	r.Configure(context.Background(), ephemeral.ConfigureRequest{ProviderData: &shared.ProviderMeta{InstanaAPI: &testInstanaAPI{apiTokens: apiTokens}}}, resp)
+	require.False(t, resp.Diagnostics.HasError())
+	return r
+}
+
+func TestEphemeralAPITokenMetadata(t *testing.T) {
+	resp := &ephemeral.MetadataResponse{}
+	NewAPITokenEphemeralResource().Metadata(context.Background(), ephemeral.MetadataRequest{ProviderTypeName: "instana"}, resp)
+
+	assert.Equal(t, "instana_api_token", resp.TypeName)
+}
+
+func TestEphemeralAPITokenSchema(t *testing.T) {
+	resp := &ephemeral.SchemaResponse{}
+	NewAPITokenEphemeralResource().Schema(context.Background(), ephemeral.SchemaRequest{}, resp)
+
+	require.False(t, resp.Diagnostics.HasError())
+	resourceAttributes := NewAPITokenResourceHandle().MetaData().Schema.Attributes
+	assert.Len(t, resp.Schema.Attributes, len(resourceAttributes))
+
+	accessGrantingToken := resp.Schema.Attributes[APITokenFieldAccessGrantingToken].(schema.StringAttribute)
+	assert.True(t, accessGrantingToken.Computed)
+	assert.True(t, accessGrantingToken.Sensitive)
+	assert.True(t, resp.Schema.Attributes[APITokenFieldName].(schema.StringAttribute).Optional)
+
+	permission := resp.Schema.Attributes[APITokenFieldCanViewLogs].(schema.BoolAttribute)
+	assert.True(t, permission.Optional)
+	assert.Equal(t, APITokenDescCanViewLogs, permission.Description)
+}
+
+func TestEphemeralAPITokenSchemaShouldMatchModel(t *testing.T) {
+	ctx := context.Background()
+	resp := &ephemeral.SchemaResponse{}
+	NewAPITokenEphemeralResource().Schema(ctx, ephemeral.SchemaRequest{}, resp)
+	result := tfsdk.EphemeralResultData{Schema: resp.Schema, Raw: tftypes.NewValue(resp.Schema.Type().TerraformType(ctx), nil)}
+
+	model := (&apiTokenResource{}).mapAPITokenToModel(&api.APIToken{ID: "token-id", InternalID: "internal-id", Name: "ci-token", CanViewLogs: true})
+	diags := result.Set(ctx, &model)
+
+	require.False(t, diags.HasError(), "%v", diags)
+	var canViewLogs types.Bool
+	require.False(t, result.GetAttribute(ctx, path.Root(APITokenFieldCanViewLogs), &canViewLogs).HasError())
+	assert.True(t, canViewLogs.ValueBool())
+}
+
+func TestEphemeralAPITokenConfigureShouldFailForUnexpectedProviderData(t *testing.T) {
+	resp := &ephemeral.ConfigureResponse{}
+	NewAPITokenEphemeralResource().(*apiTokenEphemeralResource).Configure(context.Background(), ephemeral.ConfigureRequest{ProviderData: "invalid"}, resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, APITokenErrUnexpectedConfigureType, resp.Diagnostics.Errors()[0].Summary())
+}
+
+func TestEphemeralAPITokenShouldOnlyGrantConfiguredPermissions(t *testing.T) {
+	apiTokens := &testAPITokenRestResource{}
+	r := newConfiguredEphemeralResource(t, apiTokens)
+
+	created, diags := r.createAPIToken(context.Background(), APITokenModel{
+		Name:                  types.StringValue("ci-token"),
+		CanViewLogs:           types.BoolValue(true),
+		CanConfigureAgents:    types.BoolValue(false),
+		LimitedLogsScope:      types.BoolValue(true),
+		CanViewSyntheticTests: types.BoolValue(true),
+	})
+
+	require.False(t, diags.HasError())
+	require.Len(t, apiTokens.created, 1)
+	assert.Equal(t, "token-id", created.ID)
+	assert.Equal(t, "ci-token", created.Name)
+	assert.NotEmpty(t, created.InternalID)
+	assert.NotEmpty(t, created.AccessGrantingToken)
+	assert.True(t, created.CanViewLogs)
+	assert.True(t, created.LimitedLogsScope)
+	assert.True(t, created.CanViewSyntheticTests)
+	assert.False(t, created.CanConfigureAgents)
+	assert.False(t, created.CanConfigureUsers)
+	assert.False(t, created.CanConfigureAPITokens)
+}
+
+func TestEphemeralAPITokenShouldGenerateNameWhenNotConfigured(t *testing.T) {
+	apiTokens := &testAPITokenRestResource{}
+	r := newConfiguredEphemeralResource(t, apiTokens)
+
+	created, diags := r.createAPIToken(context.Background(), APITokenModel{Name: types.StringNull()})
+
+	require.False(t, diags.HasError())
+	assert.True(t, strings.HasPrefix(created.Name, EphemeralAPITokenNamePrefix))
+}
+
+func TestEphemeralAPITokenShouldFailWhenCreationFails(t *testing.T) {
+	r := newConfiguredEphemeralResource(t, &testAPITokenRestResource{err: errors.New("forbidden")})
+
+	_, diags := r.createAPIToken(context.Background(), APITokenModel{Name: types.StringValue("ci-token")})
+
+	require.True(t, diags.HasError())
+	assert.Equal(t, APITokenErrCreating, diags.Errors()[0].Summary())
+	assert.Contains(t, diags.Errors()[0].Detail(), "forbidden")
+}
+
+func TestEphemeralAPITokenShouldFailWhenProviderIsNotConfigured(t *testing.T) {
+	r := NewAPITokenEphemeralResource().(*apiTokenEphemeralResource)
+
+	_, diags := r.createAPIToken(context.Background(), APITokenModel{})
+
+	require.True(t, diags.HasError())
+	assert.Equal(t, APITokenErrProviderNotConfigured, diags.Errors()[0].Summary())
+}
+
+func newEphemeralAPITokenOpenRequest() (ephemeral.OpenRequest, schema.Schema) {
+	ctx := context.Background()
+	schemaResp := &ephemeral.SchemaResponse{}
+	NewAPITokenEphemeralResource().Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values[APITokenFieldName] = tftypes.NewValue(tftypes.String, "ci-token")
+	return ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}}, schemaResp.Schema
+}
+
+func TestEphemeralAPITokenOpenShouldDeleteCreatedTokenWhenResponseCannotBePopulated(t *testing.T) {
+	ctx := context.Background()
+	apiTokens := &testAPITokenRestResource{}
+	r := newConfiguredEphemeralResource(t, apiTokens)
+	req, ephemeralSchema := newEphemeralAPITokenOpenRequest()
+	// the private data of the response is not initialized, so storing the IDs of the created token fails
+	resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: ephemeralSchema, Raw: tftypes.NewValue(ephemeralSchema.Type().TerraformType(ctx), nil)}}
+
+	r.Open(ctx, req, resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	require.Len(t, apiTokens.created, 1)
+	require.Len(t, apiTokens.deleted, 1)
+	assert.Equal(t, "token-id", apiTokens.deleted[0].ID)
+	assert.Equal(t, apiTokens.created[0].InternalID, apiTokens.deleted[0].InternalID)
+}
+
+func TestEphemeralAPITokenShouldDeleteTokenOfPrivateData(t *testing.T) {
+	apiTokens := &testAPITokenRestResource{}
+	r := newConfiguredEphemeralResource(t, apiTokens)
+
+	diags := r.deleteAPIToken(context.Background(), []byte(`{"id":"token-id","internalId":"internal-id"}`))
+
+	require.False(t, diags.HasError())
+	require.Len(t, apiTokens.deleted, 1)
+	assert.Equal(t, "token-id", apiTokens.deleted[0].ID)
+	assert.Equal(t, "internal-id", apiTokens.deleted[0].InternalID)
+}
+
+func TestEphemeralAPITokenShouldFailWhenDeletionFails(t *testing.T) {
+	r := newConfiguredEphemeralResource(t, &testAPITokenRestResource{err: errors.New("not found")})
+
+	diags := r.deleteAPIToken(context.Background(), []byte(`{"id":"token-id","internalId":"internal-id"}`))
+
+	require.True(t, diags.HasError())
+	assert.Equal(t, APITokenErrDeleting, diags.Errors()[0].Summary())
+	assert.Contains(t, diags.Errors()[0].Detail(), "internal-id")
+
+	diags = r.deleteAPIToken(context.Background(), []byte(`invalid`))
+
+	require.True(t, diags.HasError())
+}
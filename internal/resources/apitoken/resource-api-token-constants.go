@@ -231,3 +231,25 @@ const (
 	APITokenFieldCanConfigureServiceLevelSmartAlerts                  = "can_configure_service_level_smart_alerts"
 	APITokenFieldCanConfigureServiceLevels                            = "can_configure_service_levels"
 )
+
+// Constants of the ephemeral API token resource
+const (
+	// EphemeralResourceInstanaAPIToken the name of the terraform-provider-instana ephemeral resource to create short-lived API tokens
+	EphemeralResourceInstanaAPIToken = "api_token"
+	// EphemeralAPITokenNamePrefix the prefix of the generated name of ephemeral API tokens without configured name
+	EphemeralAPITokenNamePrefix = "terraform-ephemeral-"
+
+	APITokenDescEphemeralResource = "This ephemeral resource creates a short-lived API token in Instana for the duration of the terraform run, e.g. to " +
+		"configure other providers or CI steps. The token is never stored in the state or plan and is deleted when terraform closes " +
+		"the ephemeral resource. Permissions which are not configured are not granted."
+	APITokenDescEphemeralName = "The name of the API token. Defaults to a generated name with prefix " + EphemeralAPITokenNamePrefix
+
+	APITokenErrUnexpectedConfigureType       = "Unexpected Ephemeral Resource Configure Type"
+	APITokenErrUnexpectedConfigureTypeDetail = "Expected *shared.ProviderMeta, got: %T. Please report this issue to the provider developers."
+	APITokenErrProviderNotConfigured         = "Provider not configured"
+	APITokenErrProviderNotConfiguredDetail   = "The provider hasn't been configured before opening the ephemeral API token, likely because it depends on an unknown value from another resource."
+	APITokenErrCreating                      = "Error creating ephemeral API token"
+	APITokenErrCreatingDetail                = "Could not create API token: %s"
+	APITokenErrDeleting                      = "Error deleting ephemeral API token"
+	APITokenErrDeletingDetail                = "Could not delete API token %s: %s"
+)
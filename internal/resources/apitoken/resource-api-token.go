@@ -25,7 +25,8 @@ func NewAPITokenResourceHandle() resourcehandle.ResourceHandle[*restapi.APIToken
 	internalIDFieldName := APITokenFieldInternalID
 	return &apiTokenResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName: ResourceInstanaAPIToken,
+			ResourceName:        ResourceInstanaAPIToken,
+			ImportNameAttribute: APITokenFieldName,
 			Schema: schema.Schema{
 				Description: APITokenDescResource,
 				Attributes: map[string]schema.Attribute{
@@ -597,6 +598,14 @@ func (r *apiTokenResource) SetComputedFields(ctx context.Context, plan *tfsdk.Pl
 
 // UpdateState updates the Terraform state with the API token data from the API
 func (r *apiTokenResource) UpdateState(ctx context.Context, state *tfsdk.State, plan *tfsdk.Plan, apiToken *restapi.APIToken) diag.Diagnostics {
+	model := r.mapAPITokenToModel(apiToken)
+
+	// Set the state with our populated model
+	return state.Set(ctx, &model)
+}
+
+// mapAPITokenToModel maps the API token from the API to the model
+func (r *apiTokenResource) mapAPITokenToModel(apiToken *restapi.APIToken) APITokenModel {
 	// Create base model with core fields
 	model := APITokenModel{
 		ID:                  types.StringValue(apiToken.ID),
@@ -614,8 +623,7 @@ func (r *apiTokenResource) UpdateState(ctx context.Context, state *tfsdk.State,
 	// Map additional permissions
 	r.mapAdditionalPermissionsToModel(apiToken, &model)
 
-	// Set the state with our populated model
-	return state.Set(ctx, &model)
+	return model
 }
 
 // mapPermissionsToModel maps basic permissions from API to model
@@ -7,23 +7,24 @@ import (
 
 // ApplicationAlertConfigModel represents the data model for the application alert configuration resource
 type ApplicationAlertConfigModel struct {
-	ID                  types.String                `tfsdk:"id"`
-	AlertChannels       types.Map                   `tfsdk:"alert_channels"`
-	Applications        []ApplicationModel          `tfsdk:"application"`
-	BoundaryScope       types.String                `tfsdk:"boundary_scope"`
-	CustomPayloadFields types.List                  `tfsdk:"custom_payload_field"`
-	Description         types.String                `tfsdk:"description"`
-	Enabled             types.Bool                  `tfsdk:"enabled"`
-	EvaluationType      types.String                `tfsdk:"evaluation_type"`
-	GracePeriod         types.Int64                 `tfsdk:"grace_period"`
-	Granularity         types.Int64                 `tfsdk:"granularity"`
-	IncludeInternal     types.Bool                  `tfsdk:"include_internal"`
-	IncludeSynthetic    types.Bool                  `tfsdk:"include_synthetic"`
-	Name                types.String                `tfsdk:"name"`
-	Rules               []RuleWithThresholdModel    `tfsdk:"rules"`
-	TagFilter           types.String                `tfsdk:"tag_filter"`
-	TimeThreshold       *AppAlertTimeThresholdModel `tfsdk:"time_threshold"`
-	Triggering          types.Bool                  `tfsdk:"triggering"`
+	ID                     types.String                `tfsdk:"id"`
+	AlertChannels          types.Map                   `tfsdk:"alert_channels"`
+	Applications           []ApplicationModel          `tfsdk:"application"`
+	BaselineRefreshTrigger types.String                `tfsdk:"baseline_refresh_trigger"`
+	BoundaryScope          types.String                `tfsdk:"boundary_scope"`
+	CustomPayloadFields    types.List                  `tfsdk:"custom_payload_field"`
+	Description            types.String                `tfsdk:"description"`
+	Enabled                types.Bool                  `tfsdk:"enabled"`
+	EvaluationType         types.String                `tfsdk:"evaluation_type"`
+	GracePeriod            types.Int64                 `tfsdk:"grace_period"`
+	Granularity            types.Int64                 `tfsdk:"granularity"`
+	IncludeInternal        types.Bool                  `tfsdk:"include_internal"`
+	IncludeSynthetic       types.Bool                  `tfsdk:"include_synthetic"`
+	Name                   types.String                `tfsdk:"name"`
+	Rules                  []RuleWithThresholdModel    `tfsdk:"rules"`
+	TagFilter              shared.TagFilterValue       `tfsdk:"tag_filter"`
+	TimeThreshold          *AppAlertTimeThresholdModel `tfsdk:"time_threshold"`
+	Triggering             types.Bool                  `tfsdk:"triggering"`
 }
 
 // ApplicationModel represents an application in the application alert config
@@ -22,7 +22,10 @@ import (
 	"github.com/instana/instana-go-client/client"
 	"github.com/instana/instana-go-client/shared/rest"
 	common "github.com/instana/instana-go-client/shared/types"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/alertingchannel"
+	"github.com/instana/terraform-provider-instana/internal/resources/applicationconfig"
 	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
 	"github.com/instana/terraform-provider-instana/internal/util"
@@ -32,7 +35,8 @@ import (
 func NewApplicationAlertConfigResourceHandle() resourcehandle.ResourceHandle[*api.ApplicationAlertConfig] {
 	return &applicationAlertConfigResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName: ResourceInstanaApplicationAlertConfig,
+			ResourceName:        ResourceInstanaApplicationAlertConfig,
+			ImportNameAttribute: ApplicationAlertConfigFieldName,
 			Schema: schema.Schema{
 				Description: "This resource manages application alert configurations in api.",
 				Attributes: map[string]schema.Attribute{
@@ -104,6 +108,13 @@ func NewApplicationAlertConfigResourceHandle() resourcehandle.ResourceHandle[*ap
 					ApplicationAlertConfigFieldTagFilter: schema.StringAttribute{
 						Optional:    true,
 						Description: "The tag filter expression for the application alert config",
+						CustomType:  shared.TagFilterType{},
+						Validators: []validator.String{
+							shared.TagFilterValidator(),
+						},
+						PlanModifiers: []planmodifier.String{
+							shared.TagFilterPlanModifier(),
+						},
 					},
 					ApplicationAlertConfigFieldTriggering: schema.BoolAttribute{
 						Optional:    true,
@@ -117,7 +128,8 @@ func NewApplicationAlertConfigResourceHandle() resourcehandle.ResourceHandle[*ap
 						Default:     booldefault.StaticBool(ApplicationAlertConfigDefaultEnabled),
 						Description: "Flag to enable or disable the alert configuration",
 					},
-					shared.DefaultCustomPayloadFieldsName: shared.GetCustomPayloadFieldsSchema(),
+					shared.BaselineRefreshTriggerFieldName: shared.BaselineRefreshTriggerAttributeSchema(),
+					shared.DefaultCustomPayloadFieldsName:  shared.GetCustomPayloadFieldsSchema(),
 					ApplicationAlertConfigFieldRules: schema.ListNestedAttribute{
 						Description: "A list of rules where each rule is associated with multiple thresholds and their corresponding severity levels.",
 						Optional:    true,
@@ -401,6 +413,10 @@ func NewApplicationAlertConfigResourceHandle() resourcehandle.ResourceHandle[*ap
 			},
 			SkipIDGeneration: true,
 			SchemaVersion:    2,
+			ReferenceAttributes: map[string]string{
+				ApplicationAlertConfigFieldAlertChannels:             alertingchannel.ResourceInstanaAlertingChannel,
+				ApplicationAlertConfigFieldApplicationsApplicationID: applicationconfig.ResourceInstanaApplicationConfig,
+			},
 		},
 	}
 }
@@ -409,14 +425,36 @@ func NewApplicationAlertConfigResourceHandle() resourcehandle.ResourceHandle[*ap
 func NewGlobalApplicationAlertConfigResourceHandle() resourcehandle.ResourceHandle[*api.ApplicationAlertConfig] {
 	return &applicationAlertConfigResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName:  ResourceInstanaGlobalApplicationAlertConfig,
-			Schema:        NewApplicationAlertConfigResourceHandle().MetaData().Schema,
-			SchemaVersion: 2,
+			ResourceName:        ResourceInstanaGlobalApplicationAlertConfig,
+			ImportNameAttribute: ApplicationAlertConfigFieldName,
+			Schema:              newGlobalApplicationAlertConfigSchema(),
+			SchemaVersion:       2,
+			ReferenceAttributes: NewApplicationAlertConfigResourceHandle().MetaData().ReferenceAttributes,
 		},
 		isGlobal: true,
 	}
 }
 
+// newGlobalApplicationAlertConfigSchema creates the schema of the global application alert configuration. The Instana
+// API does not support the recalculation of baselines of global application alert configurations, therefore the
+// baseline refresh trigger is read-only.
+func newGlobalApplicationAlertConfigSchema() schema.Schema {
+	globalSchema := NewApplicationAlertConfigResourceHandle().MetaData().Schema
+	attributes := make(map[string]schema.Attribute, len(globalSchema.Attributes))
+	for name, attribute := range globalSchema.Attributes {
+		attributes[name] = attribute
+	}
+	attributes[shared.BaselineRefreshTriggerFieldName] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Not supported for global application alert configurations.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	globalSchema.Attributes = attributes
+	return globalSchema
+}
+
 // ============================================================================
 // Resource  Implementation
 // ============================================================================
@@ -500,6 +538,46 @@ func (r *applicationAlertConfigResource) SetComputedFields(ctx context.Context,
 	return nil
 }
 
+// EnabledAttribute implementation of resourcehandle.EnabledStateToggler
+func (r *applicationAlertConfigResource) EnabledAttribute() string {
+	return ApplicationAlertConfigFieldEnabled
+}
+
+// SetEnabled enables or disables the application alert configuration via the dedicated endpoints
+func (r *applicationAlertConfigResource) SetEnabled(instanaAPI client.InstanaAPI, id string, enabled bool) error {
+	return instanaapi.From(instanaAPI).EnablementToggle(r.enablementResourcePath()).SetEnabled(id, enabled)
+}
+
+// IsEnabled returns the enabled flag of the application alert configuration
+func (r *applicationAlertConfigResource) IsEnabled(_ client.InstanaAPI, config *api.ApplicationAlertConfig) (bool, error) {
+	if config.Enabled == nil {
+		return ApplicationAlertConfigDefaultEnabled, nil
+	}
+	return *config.Enabled, nil
+}
+
+// BaselineRefreshTriggerAttribute implementation of resourcehandle.BaselineRefresher. Global application alert
+// configurations do not support the recalculation of baselines.
+func (r *applicationAlertConfigResource) BaselineRefreshTriggerAttribute() string {
+	if r.isGlobal {
+		return ""
+	}
+	return shared.BaselineRefreshTriggerFieldName
+}
+
+// RefreshBaseline recalculates the historic baseline of the application alert configuration
+func (r *applicationAlertConfigResource) RefreshBaseline(instanaAPI client.InstanaAPI, id string) error {
+	return instanaapi.From(instanaAPI).BaselineUpdater(instanaapi.ApplicationAlertConfigsResourcePath).UpdateBaseline(id)
+}
+
+// enablementResourcePath returns the resource path of the enable and disable endpoints
+func (r *applicationAlertConfigResource) enablementResourcePath() string {
+	if r.isGlobal {
+		return instanaapi.GlobalApplicationAlertConfigsResourcePath
+	}
+	return instanaapi.ApplicationAlertConfigsResourcePath
+}
+
 // ============================================================================
 // Resource  Interface
 // ============================================================================
@@ -1064,9 +1142,9 @@ func (r *applicationAlertConfigResourceImpl) updateTagFilter(model *ApplicationA
 			)
 			return diags
 		}
-		model.TagFilter = util.SetStringPointerToState(normalizedTagFilterString)
+		model.TagFilter = shared.NewTagFilterPointerValue(normalizedTagFilterString)
 	} else {
-		model.TagFilter = types.StringNull()
+		model.TagFilter = shared.NewTagFilterNull()
 	}
 
 	return diags
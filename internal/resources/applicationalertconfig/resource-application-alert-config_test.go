@@ -42,6 +42,7 @@ func TestNewGlobalApplicationAlertConfigResourceHandle(t *testing.T) {
 	assert.Equal(t, ResourceInstanaGlobalApplicationAlertConfig, metaData.ResourceName)
 	assert.NotNil(t, metaData.Schema)
 	assert.Equal(t, int64(2), metaData.SchemaVersion)
+	assert.Equal(t, NewApplicationAlertConfigResourceHandle().MetaData().ReferenceAttributes, metaData.ReferenceAttributes)
 }
 
 func TestMetaData(t *testing.T) {
@@ -57,6 +58,36 @@ func TestMetaData(t *testing.T) {
 	assert.Equal(t, int64(1), metaData.SchemaVersion)
 }
 
+func TestEnabledStateToggler(t *testing.T) {
+	for _, handle := range []resourcehandle.ResourceHandle[*api.ApplicationAlertConfig]{NewApplicationAlertConfigResourceHandle(), NewGlobalApplicationAlertConfigResourceHandle()} {
+		toggler, ok := handle.(resourcehandle.EnabledStateToggler[*api.ApplicationAlertConfig])
+		require.True(t, ok)
+		assert.Equal(t, ApplicationAlertConfigFieldEnabled, toggler.EnabledAttribute())
+
+		enabled, err := toggler.IsEnabled(nil, &api.ApplicationAlertConfig{})
+		require.NoError(t, err)
+		assert.True(t, enabled)
+
+		enabled, err = toggler.IsEnabled(nil, &api.ApplicationAlertConfig{Enabled: ptr(false)})
+		require.NoError(t, err)
+		assert.False(t, enabled)
+	}
+}
+
+func TestBaselineRefresher(t *testing.T) {
+	refresher, ok := NewApplicationAlertConfigResourceHandle().(resourcehandle.BaselineRefresher)
+	require.True(t, ok)
+	assert.Equal(t, shared.BaselineRefreshTriggerFieldName, refresher.BaselineRefreshTriggerAttribute())
+	assert.True(t, NewApplicationAlertConfigResourceHandle().MetaData().Schema.Attributes[shared.BaselineRefreshTriggerFieldName].IsOptional())
+
+	globalHandle := NewGlobalApplicationAlertConfigResourceHandle()
+	refresher, ok = globalHandle.(resourcehandle.BaselineRefresher)
+	require.True(t, ok)
+	assert.Empty(t, refresher.BaselineRefreshTriggerAttribute())
+	assert.False(t, globalHandle.MetaData().Schema.Attributes[shared.BaselineRefreshTriggerFieldName].IsOptional())
+	assert.True(t, globalHandle.MetaData().Schema.Attributes[shared.BaselineRefreshTriggerFieldName].IsComputed())
+}
+
 func TestSetComputedFields(t *testing.T) {
 	resource := NewApplicationAlertConfigResourceHandle()
 	ctx := context.Background()
@@ -1129,7 +1160,7 @@ func TestMapStateToDataObject_WithTagFilter(t *testing.T) {
 		IncludeInternal:  types.BoolValue(false),
 		IncludeSynthetic: types.BoolValue(false),
 		Triggering:       types.BoolValue(false),
-		TagFilter:        types.StringValue("entity.type EQUALS 'service'"),
+		TagFilter:        shared.NewTagFilterValue("entity.type EQUALS 'service'"),
 
 		AlertChannels:       types.MapNull(types.SetType{ElemType: types.StringType}),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -1366,7 +1397,7 @@ func TestMapStateToDataObject_InvalidTagFilter(t *testing.T) {
 		IncludeInternal:  types.BoolValue(false),
 		IncludeSynthetic: types.BoolValue(false),
 		Triggering:       types.BoolValue(false),
-		TagFilter:        types.StringValue("invalid tag filter syntax"),
+		TagFilter:        shared.NewTagFilterValue("invalid tag filter syntax"),
 
 		AlertChannels:       types.MapNull(types.SetType{ElemType: types.StringType}),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -1637,7 +1668,7 @@ func TestMapStateToDataObject_WithEmptyTagFilter(t *testing.T) {
 		IncludeInternal:  types.BoolValue(false),
 		IncludeSynthetic: types.BoolValue(false),
 		Triggering:       types.BoolValue(false),
-		TagFilter:        types.StringValue(""),
+		TagFilter:        shared.NewTagFilterValue(""),
 
 		AlertChannels:       types.MapNull(types.SetType{ElemType: types.StringType}),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -1,15 +1,18 @@
 package applicationconfig
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+)
 
 // ApplicationConfigModel represents the data model for the application configuration resource
 type ApplicationConfigModel struct {
-	ID            types.String `tfsdk:"id"`
-	Label         types.String `tfsdk:"label"`
-	Scope         types.String `tfsdk:"scope"`
-	BoundaryScope types.String `tfsdk:"boundary_scope"`
-	TagFilter     types.String `tfsdk:"tag_filter"`
-	AccessRules   types.List   `tfsdk:"access_rules"`
+	ID            types.String          `tfsdk:"id"`
+	Label         types.String          `tfsdk:"label"`
+	Scope         types.String          `tfsdk:"scope"`
+	BoundaryScope types.String          `tfsdk:"boundary_scope"`
+	TagFilter     shared.TagFilterValue `tfsdk:"tag_filter"`
+	AccessRules   types.List            `tfsdk:"access_rules"`
 }
 
 // AccessRuleModel represents an access rule in the application configuration
@@ -22,6 +22,7 @@ import (
 	tag "github.com/instana/instana-go-client/shared/tagfilter"
 	models "github.com/instana/instana-go-client/shared/types"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
 	"github.com/instana/terraform-provider-instana/internal/util"
 )
@@ -34,7 +35,8 @@ import (
 func NewApplicationConfigResourceHandle() resourcehandle.ResourceHandle[*api.ApplicationConfig] {
 	return &applicationConfigResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName: ResourceInstanaApplicationConfig,
+			ResourceName:        ResourceInstanaApplicationConfig,
+			ImportNameAttribute: ApplicationConfigFieldLabel,
 			Schema: schema.Schema{
 				Description: ApplicationConfigDescResource,
 				Attributes: map[string]schema.Attribute{
@@ -70,6 +72,13 @@ func NewApplicationConfigResourceHandle() resourcehandle.ResourceHandle[*api.App
 					ApplicationConfigFieldTagFilter: schema.StringAttribute{
 						Required:    true,
 						Description: ApplicationConfigDescTagFilter,
+						CustomType:  shared.TagFilterType{},
+						Validators: []validator.String{
+							shared.TagFilterValidator(),
+						},
+						PlanModifiers: []planmodifier.String{
+							shared.TagFilterPlanModifier(),
+						},
 					},
 					ApplicationConfigFieldAccessRules: schema.ListNestedAttribute{
 						Optional:    true,
@@ -195,9 +204,9 @@ func (r *applicationConfigResource) mapTagFilterToState(config *api.ApplicationC
 				),
 			}
 		}
-		model.TagFilter = util.SetStringPointerToState(normalizedTagFilterString)
+		model.TagFilter = shared.NewTagFilterPointerValue(normalizedTagFilterString)
 	} else {
-		model.TagFilter = types.StringNull()
+		model.TagFilter = shared.NewTagFilterNull()
 	}
 	return nil
 }
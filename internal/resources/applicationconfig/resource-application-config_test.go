@@ -12,6 +12,7 @@ import (
 	tag "github.com/instana/instana-go-client/shared/tagfilter"
 	common "github.com/instana/instana-go-client/shared/types"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -80,7 +81,7 @@ func TestMapStateToDataObject_BasicConfig(t *testing.T) {
 		Label:         types.StringValue("Test Application"),
 		Scope:         types.StringValue("INCLUDE_NO_DOWNSTREAM"),
 		BoundaryScope: types.StringValue("DEFAULT"),
-		TagFilter:     types.StringNull(),
+		TagFilter:     shared.NewTagFilterNull(),
 		AccessRules:   createAccessRulesList(t, ctx, accessRules),
 	})
 
@@ -116,7 +117,7 @@ func TestMapStateToDataObject_WithTagFilter(t *testing.T) {
 		Label:         types.StringValue("Test Application"),
 		Scope:         types.StringValue("INCLUDE_ALL_DOWNSTREAM"),
 		BoundaryScope: types.StringValue("ALL"),
-		TagFilter:     types.StringValue("entity.type EQUALS 'service'"),
+		TagFilter:     shared.NewTagFilterValue("entity.type EQUALS 'service'"),
 		AccessRules:   createAccessRulesList(t, ctx, accessRules),
 	})
 
@@ -163,7 +164,7 @@ func TestMapStateToDataObject_WithMultipleAccessRules(t *testing.T) {
 		Label:         types.StringValue("Test Application"),
 		Scope:         types.StringValue("INCLUDE_NO_DOWNSTREAM"),
 		BoundaryScope: types.StringValue("INBOUND"),
-		TagFilter:     types.StringNull(),
+		TagFilter:     shared.NewTagFilterNull(),
 		AccessRules:   createAccessRulesList(t, ctx, accessRules),
 	})
 
@@ -200,7 +201,7 @@ func TestMapStateToDataObject_WithEmptyAccessRules(t *testing.T) {
 		Label:         types.StringValue("Test Application"),
 		Scope:         types.StringValue("INCLUDE_NO_DOWNSTREAM"),
 		BoundaryScope: types.StringValue("DEFAULT"),
-		TagFilter:     types.StringNull(),
+		TagFilter:     shared.NewTagFilterNull(),
 		AccessRules:   createAccessRulesList(t, ctx, []AccessRuleModel{}),
 	})
 
@@ -228,7 +229,7 @@ func TestMapStateToDataObject_WithNullID(t *testing.T) {
 		Label:         types.StringValue("Test Application"),
 		Scope:         types.StringValue("INCLUDE_NO_DOWNSTREAM"),
 		BoundaryScope: types.StringValue("DEFAULT"),
-		TagFilter:     types.StringNull(),
+		TagFilter:     shared.NewTagFilterNull(),
 		AccessRules:   createAccessRulesList(t, ctx, accessRules),
 	})
 
@@ -256,7 +257,7 @@ func TestMapStateToDataObject_WithInvalidTagFilter(t *testing.T) {
 		Label:         types.StringValue("Test Application"),
 		Scope:         types.StringValue("INCLUDE_NO_DOWNSTREAM"),
 		BoundaryScope: types.StringValue("DEFAULT"),
-		TagFilter:     types.StringValue("invalid tag filter syntax"),
+		TagFilter:     shared.NewTagFilterValue("invalid tag filter syntax"),
 		AccessRules:   createAccessRulesList(t, ctx, accessRules),
 	})
 
@@ -285,7 +286,7 @@ func TestMapStateToDataObject_FromPlan(t *testing.T) {
 		Label:         types.StringValue("Test Application"),
 		Scope:         types.StringValue("INCLUDE_NO_DOWNSTREAM"),
 		BoundaryScope: types.StringValue("DEFAULT"),
-		TagFilter:     types.StringNull(),
+		TagFilter:     shared.NewTagFilterNull(),
 		AccessRules:   createAccessRulesList(t, ctx, accessRules),
 	}
 
@@ -325,7 +326,7 @@ func TestUpdateState_BasicConfig(t *testing.T) {
 	// Initialize state with a model that has TagFilter and AccessRules set to null
 	// This is necessary because UpdateState checks if TagFilter is null/unknown
 	initialModel := ApplicationConfigModel{
-		TagFilter: types.StringNull(),
+		TagFilter: shared.NewTagFilterNull(),
 		AccessRules: types.ListNull(types.ObjectType{
 			AttrTypes: map[string]attr.Type{
 				"access_type":   types.StringType,
@@ -394,7 +395,7 @@ func TestUpdateState_WithTagFilter(t *testing.T) {
 	// Initialize state with a model that has TagFilter and AccessRules set to null
 	// This is necessary because UpdateState checks if TagFilter is null/unknown
 	initialModel := ApplicationConfigModel{
-		TagFilter: types.StringNull(),
+		TagFilter: shared.NewTagFilterNull(),
 		AccessRules: types.ListNull(types.ObjectType{
 			AttrTypes: map[string]attr.Type{
 				"access_type":   types.StringType,
@@ -465,7 +466,7 @@ func TestUpdateState_WithMultipleAccessRules(t *testing.T) {
 	// Initialize state with a model that has TagFilter and AccessRules set to null
 	// This is necessary because UpdateState checks if TagFilter is null/unknown
 	initialModel := ApplicationConfigModel{
-		TagFilter: types.StringNull(),
+		TagFilter: shared.NewTagFilterNull(),
 		AccessRules: types.ListNull(types.ObjectType{
 			AttrTypes: map[string]attr.Type{
 				"access_type":   types.StringType,
@@ -522,7 +523,7 @@ func TestUpdateState_WithEmptyAccessRules(t *testing.T) {
 	// Initialize state with a model that has TagFilter and AccessRules set to null
 	// This is necessary because UpdateState checks if TagFilter is null/unknown
 	initialModel := ApplicationConfigModel{
-		TagFilter: types.StringNull(),
+		TagFilter: shared.NewTagFilterNull(),
 		AccessRules: types.ListNull(types.ObjectType{
 			AttrTypes: map[string]attr.Type{
 				"access_type":   types.StringType,
@@ -571,7 +572,7 @@ func TestUpdateState_WithNullTagFilter(t *testing.T) {
 	// Initialize state with a model that has TagFilter and AccessRules set to null
 	// This is necessary because UpdateState checks if TagFilter is null/unknown
 	initialModel := ApplicationConfigModel{
-		TagFilter: types.StringNull(),
+		TagFilter: shared.NewTagFilterNull(),
 		AccessRules: types.ListNull(types.ObjectType{
 			AttrTypes: map[string]attr.Type{
 				"access_type":   types.StringType,
@@ -618,7 +619,7 @@ func TestMapStateToDataObject_AllScopes(t *testing.T) {
 				Label:         types.StringValue("Test Application"),
 				Scope:         types.StringValue(scope),
 				BoundaryScope: types.StringValue("DEFAULT"),
-				TagFilter:     types.StringNull(),
+				TagFilter:     shared.NewTagFilterNull(),
 				AccessRules:   createAccessRulesList(t, ctx, accessRules),
 			})
 
@@ -651,7 +652,7 @@ func TestMapStateToDataObject_AllBoundaryScopes(t *testing.T) {
 				Label:         types.StringValue("Test Application"),
 				Scope:         types.StringValue("INCLUDE_NO_DOWNSTREAM"),
 				BoundaryScope: types.StringValue(boundaryScope),
-				TagFilter:     types.StringNull(),
+				TagFilter:     shared.NewTagFilterNull(),
 				AccessRules:   createAccessRulesList(t, ctx, accessRules),
 			})
 
@@ -684,7 +685,7 @@ func TestMapStateToDataObject_AllAccessTypes(t *testing.T) {
 				Label:         types.StringValue("Test Application"),
 				Scope:         types.StringValue("INCLUDE_NO_DOWNSTREAM"),
 				BoundaryScope: types.StringValue("DEFAULT"),
-				TagFilter:     types.StringNull(),
+				TagFilter:     shared.NewTagFilterNull(),
 				AccessRules:   createAccessRulesList(t, ctx, accessRules),
 			})
 
@@ -718,7 +719,7 @@ func TestMapStateToDataObject_AllRelationTypes(t *testing.T) {
 				Label:         types.StringValue("Test Application"),
 				Scope:         types.StringValue("INCLUDE_NO_DOWNSTREAM"),
 				BoundaryScope: types.StringValue("DEFAULT"),
-				TagFilter:     types.StringNull(),
+				TagFilter:     shared.NewTagFilterNull(),
 				AccessRules:   createAccessRulesList(t, ctx, accessRules),
 			})
 
@@ -748,7 +749,7 @@ func TestMapStateToDataObject_WithEmptyRelatedID(t *testing.T) {
 		Label:         types.StringValue("Test Application"),
 		Scope:         types.StringValue("INCLUDE_NO_DOWNSTREAM"),
 		BoundaryScope: types.StringValue("DEFAULT"),
-		TagFilter:     types.StringNull(),
+		TagFilter:     shared.NewTagFilterNull(),
 		AccessRules:   createAccessRulesList(t, ctx, accessRules),
 	})
 
@@ -879,7 +880,7 @@ func TestUpdateState_AllScopes(t *testing.T) {
 			// Initialize state with a model that has TagFilter and AccessRules set to null
 			// This is necessary because UpdateState checks if TagFilter is null/unknown
 			initialModel := ApplicationConfigModel{
-				TagFilter: types.StringNull(),
+				TagFilter: shared.NewTagFilterNull(),
 				AccessRules: types.ListNull(types.ObjectType{
 					AttrTypes: map[string]attr.Type{
 						"access_type":   types.StringType,
@@ -936,7 +937,7 @@ func TestUpdateState_AllBoundaryScopes(t *testing.T) {
 			// Initialize state with a model that has TagFilter and AccessRules set to null
 			// This is necessary because UpdateState checks if TagFilter is null/unknown
 			initialModel := ApplicationConfigModel{
-				TagFilter: types.StringNull(),
+				TagFilter: shared.NewTagFilterNull(),
 				AccessRules: types.ListNull(types.ObjectType{
 					AttrTypes: map[string]attr.Type{
 						"access_type":   types.StringType,
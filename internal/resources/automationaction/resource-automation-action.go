@@ -31,7 +31,8 @@ import (
 func NewAutomationActionResourceHandle() resourcehandle.ResourceHandle[*api.AutomationAction] {
 	return &automationActionResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName: ResourceInstanaAutomationAction,
+			ResourceName:        ResourceInstanaAutomationAction,
+			ImportNameAttribute: AutomationActionFieldName,
 			Schema: schema.Schema{
 				Description: AutomationActionDescResource,
 				Attributes: map[string]schema.Attribute{
@@ -124,16 +125,17 @@ func NewAutomationActionResourceHandle() resourcehandle.ResourceHandle[*api.Auto
 									AutomationActionFieldBasicAuth: schema.SingleNestedAttribute{
 										Optional:    true,
 										Description: AutomationActionDescHttpAuthBasic,
-										Attributes: map[string]schema.Attribute{
+										Attributes: shared.AddWriteOnlySecretAttributes(map[string]schema.Attribute{
 											AutomationActionFieldUsername: schema.StringAttribute{
 												Required:    true,
 												Description: AutomationActionDescHttpAuthBasicUsername,
 											},
 											AutomationActionFieldPassword: schema.StringAttribute{
-												Required:    true,
+												Optional:    true,
 												Description: AutomationActionDescHttpAuthBasicPassword,
+												Validators:  shared.RequiredSecretValidators(AutomationActionFieldPassword),
 											},
-										},
+										}, AutomationActionFieldPassword, AutomationActionDescHttpAuthBasicPassword),
 									},
 									AutomationActionFieldToken: schema.SingleNestedAttribute{
 										Optional:    true,
@@ -148,20 +150,21 @@ func NewAutomationActionResourceHandle() resourcehandle.ResourceHandle[*api.Auto
 									AutomationActionFieldApiKey: schema.SingleNestedAttribute{
 										Optional:    true,
 										Description: AutomationActionDescHttpAuthApiKey,
-										Attributes: map[string]schema.Attribute{
+										Attributes: shared.AddWriteOnlySecretAttributes(map[string]schema.Attribute{
 											AutomationActionFieldKey: schema.StringAttribute{
 												Required:    true,
 												Description: AutomationActionDescHttpAuthApiKeyKey,
 											},
 											AutomationActionFieldValue: schema.StringAttribute{
-												Required:    true,
+												Optional:    true,
 												Description: AutomationActionDescHttpAuthApiKeyValue,
+												Validators:  shared.RequiredSecretValidators(AutomationActionFieldValue),
 											},
 											AutomationActionFieldKeyLocation: schema.StringAttribute{
 												Required:    true,
 												Description: AutomationActionDescHttpAuthApiKeyLocation,
 											},
-										},
+										}, AutomationActionFieldValue, AutomationActionDescHttpAuthApiKeyValue),
 									},
 								},
 							},
@@ -433,6 +436,10 @@ func (r *automationActionResource) UpdateState(ctx context.Context, state *tfsdk
 	d := r.mapActionTypeFieldsToState(ctx, automationAction, &model)
 	diags.Append(d...)
 
+	// Keep the versions of write-only secrets from the plan (Create and Update) or the prior state (Read)
+	d = r.applyWriteOnlyAuthState(ctx, state, plan, &model)
+	diags.Append(d...)
+
 	// Set the entire model to state
 	diags.Append(state.Set(ctx, model)...)
 	return diags
@@ -448,6 +455,40 @@ func (r *automationActionResource) mapActionTypeFieldsToState(ctx context.Contex
 	return diags
 }
 
+// applyWriteOnlyAuthState keeps the versions of the write-only authentication secrets of the plan or the prior state
+// and removes the secrets managed by write-only attributes from the model
+func (r *automationActionResource) applyWriteOnlyAuthState(ctx context.Context, state *tfsdk.State, plan *tfsdk.Plan, model *shared.AutomationActionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if model.Http == nil {
+		return diags
+	}
+
+	var prior shared.AutomationActionModel
+	if plan != nil && !plan.Raw.IsNull() {
+		diags.Append(plan.Get(ctx, &prior)...)
+	} else if state != nil && !state.Raw.IsNull() {
+		diags.Append(state.Get(ctx, &prior)...)
+	}
+	if diags.HasError() || prior.Http == nil {
+		return diags
+	}
+
+	shared.ApplyWriteOnlyAuthState(model.Http.Auth, prior.Http.Auth)
+	return diags
+}
+
+// MapWriteOnlyAttributes applies the write-only authentication secrets of the configuration to the API object
+func (r *automationActionResource) MapWriteOnlyAttributes(ctx context.Context, config *tfsdk.Config, action *api.AutomationAction) diag.Diagnostics {
+	var model shared.AutomationActionModel
+	diags := config.Get(ctx, &model)
+	if diags.HasError() || model.Http == nil {
+		return diags
+	}
+
+	diags.Append(shared.MapWriteOnlyAuthFromConfig(action.Fields, model.Http.Auth)...)
+	return diags
+}
+
 // mapTagsToState converts tags from API format to Terraform state format
 func (r *automationActionResource) mapTagsToState(ctx context.Context, tags interface{}) (types.Set, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -766,7 +807,7 @@ func (r *automationActionResource) marshalBasicAuth(basicAuth *shared.BasicAuthM
 	authMap := map[string]string{
 		AuthJSONFieldType:     AutomationActionAuthTypeBasicAuth,
 		AuthJSONFieldUsername: basicAuth.UserName.ValueString(),
-		AuthJSONFieldPassword: basicAuth.Password.ValueString(),
+		AuthJSONFieldPassword: shared.WriteOnlySecretValue(basicAuth.Password, basicAuth.PasswordWo),
 	}
 	authJson, err := json.Marshal(authMap)
 	if err != nil {
@@ -801,7 +842,7 @@ func (r *automationActionResource) marshalApiKey(apiKey *shared.ApiKeyModel, dia
 	authMap := map[string]string{
 		AuthJSONFieldType:        AutomationActionAuthTypeApiKey,
 		AuthJSONFieldAPIKey:      apiKey.Key.ValueString(),
-		AuthJSONFieldAPIKeyValue: apiKey.Value.ValueString(),
+		AuthJSONFieldAPIKeyValue: shared.WriteOnlySecretValue(apiKey.Value, apiKey.ValueWo),
 		AuthJSONFieldAPIKeyAddTo: apiKey.KeyLocation.ValueString(),
 	}
 	authJson, err := json.Marshal(authMap)
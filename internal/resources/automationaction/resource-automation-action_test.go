@@ -1915,3 +1915,73 @@ func TestMapActionTypeAndFields_HTTPWithNullApiKeyField(t *testing.T) {
 	require.NotNil(t, authField)
 	assert.Contains(t, authField.Value, AutomationActionAuthTypeNoAuth)
 }
+
+// TestWriteOnlyAuthSecrets tests the write-only variants of the basic auth password and the API key value
+func TestWriteOnlyAuthSecrets(t *testing.T) {
+	ctx := context.Background()
+	resource := NewAutomationActionResourceHandle().(*automationActionResource)
+
+	newModel := func(apiKey *shared.ApiKeyModel) shared.AutomationActionModel {
+		return shared.AutomationActionModel{
+			ID:   types.StringValue("test-id"),
+			Name: types.StringValue("Test HTTP Action"),
+			Tags: types.SetNull(types.StringType),
+			Http: &shared.HttpModel{
+				Host:    types.StringValue("https://example.com"),
+				Method:  types.StringValue("GET"),
+				Headers: types.MapNull(types.StringType),
+				Auth:    &shared.AuthModel{ApiKey: apiKey},
+			},
+		}
+	}
+
+	t.Run("should apply write-only API key value from config", func(t *testing.T) {
+		state := createMockState(t, newModel(&shared.ApiKeyModel{
+			Key:            types.StringValue("X-API-Key"),
+			Value:          types.StringNull(),
+			ValueWo:        types.StringValue("secret"),
+			ValueWoVersion: types.Int64Value(1),
+			KeyLocation:    types.StringValue("header"),
+		}))
+		config := &tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+
+		action, diags := resource.MapStateToDataObject(ctx, nil, &state)
+		require.False(t, diags.HasError())
+		diags = resource.MapWriteOnlyAttributes(ctx, config, action)
+		require.False(t, diags.HasError())
+
+		authField := findField(action.Fields, AutomationActionAPIFieldAuthen)
+		require.NotNil(t, authField)
+		assert.JSONEq(t, `{"type":"apiKey","apiKey":"X-API-Key","apiKeyValue":"secret","apiKeyAddTo":"header"}`, authField.Value)
+	})
+
+	t.Run("should not store write-only API key value in state", func(t *testing.T) {
+		state := createMockState(t, newModel(&shared.ApiKeyModel{
+			Key:            types.StringValue("X-API-Key"),
+			Value:          types.StringNull(),
+			ValueWoVersion: types.Int64Value(3),
+			KeyLocation:    types.StringValue("header"),
+		}))
+
+		diags := resource.UpdateState(ctx, &state, nil, &api.AutomationAction{
+			ID:   "test-id",
+			Name: "Test HTTP Action",
+			Type: shared.ActionTypeHttp,
+			Fields: []api.Field{
+				{Name: api.HttpHostFieldName, Value: "https://example.com"},
+				{Name: api.HttpMethodFieldName, Value: "GET"},
+				{Name: AutomationActionAPIFieldAuthen, Value: `{"type":"apiKey","apiKey":"X-API-Key","apiKeyValue":"secret","apiKeyAddTo":"header"}`},
+			},
+		})
+		require.False(t, diags.HasError())
+
+		var model shared.AutomationActionModel
+		require.False(t, state.Get(ctx, &model).HasError())
+		require.NotNil(t, model.Http)
+		require.NotNil(t, model.Http.Auth)
+		require.NotNil(t, model.Http.Auth.ApiKey)
+		assert.True(t, model.Http.Auth.ApiKey.Value.IsNull())
+		assert.True(t, model.Http.Auth.ApiKey.ValueWo.IsNull())
+		assert.Equal(t, int64(3), model.Http.Auth.ApiKey.ValueWoVersion.ValueInt64())
+	})
+}
@@ -217,8 +217,10 @@ func (r *automationPolicyResource) UpdateState(ctx context.Context, state *tfsdk
 		model.Trigger = r.mapTriggerToState(policy.Trigger)
 	}
 
-	// Map type configurations
+	// Map type configurations and keep the versions of the write-only secrets of the actions
+	priorTypeConfigurations := model.TypeConfiguration
 	model.TypeConfiguration = r.mapTypeConfigurationsToState(ctx, policy.TypeConfigurations)
+	r.applyWriteOnlyAuthState(model.TypeConfiguration, priorTypeConfigurations)
 
 	// Set the entire model to state
 	diags.Append(state.Set(ctx, model)...)
@@ -465,6 +467,49 @@ func (r *automationPolicyResource) mapTriggerFromState(ctx context.Context, trig
 	return trigger, diags
 }
 
+// applyWriteOnlyAuthState keeps the versions of the write-only authentication secrets of the actions of the prior
+// type configurations (plan or state) and removes the secrets managed by write-only attributes
+func (r *automationPolicyResource) applyWriteOnlyAuthState(typeConfigs []TypeConfigurationModel, priorTypeConfigs []TypeConfigurationModel) {
+	for i := range typeConfigs {
+		if i >= len(priorTypeConfigs) {
+			return
+		}
+		for j := range typeConfigs[i].Action {
+			if j >= len(priorTypeConfigs[i].Action) {
+				break
+			}
+			action := typeConfigs[i].Action[j].Action
+			priorAction := priorTypeConfigs[i].Action[j].Action
+			if action.Http != nil && priorAction.Http != nil {
+				shared.ApplyWriteOnlyAuthState(action.Http.Auth, priorAction.Http.Auth)
+			}
+		}
+	}
+}
+
+// MapWriteOnlyAttributes applies the write-only authentication secrets of the configured actions to the API object
+func (r *automationPolicyResource) MapWriteOnlyAttributes(ctx context.Context, config *tfsdk.Config, policy *api.AutomationPolicy) diag.Diagnostics {
+	var model AutomationPolicyModel
+	diags := config.Get(ctx, &model)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, typeConfig := range model.TypeConfiguration {
+		if i >= len(policy.TypeConfigurations) {
+			break
+		}
+		actions := policy.TypeConfigurations[i].Runnable.RunConfiguration.Actions
+		for j, action := range typeConfig.Action {
+			if j >= len(actions) || action.Action.Http == nil {
+				continue
+			}
+			diags.Append(shared.MapWriteOnlyAuthFromConfig(actions[j].Action.Fields, action.Action.Http.Auth)...)
+		}
+	}
+	return diags
+}
+
 // mapTypeConfigurationsFromState converts type configurations from state to API format
 func (r *automationPolicyResource) mapTypeConfigurationsFromState(ctx context.Context, typeConfigModels []TypeConfigurationModel) ([]api.TypeConfiguration, diag.Diagnostics) {
 	var diags diag.Diagnostics
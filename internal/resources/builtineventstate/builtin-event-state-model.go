@@ -0,0 +1,11 @@
+package builtineventstate
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// BuiltinEventStateModel represents the data model for the enabled state of a built-in event specification
+type BuiltinEventStateModel struct {
+	ID              types.String `tfsdk:"id"`
+	BuiltinEventID  types.String `tfsdk:"builtin_event_id"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	OriginalEnabled types.Bool   `tfsdk:"original_enabled"`
+}
@@ -0,0 +1,27 @@
+package builtineventstate
+
+// ResourceInstanaBuiltinEventState the name of the terraform-provider-instana resource to enable or disable built-in events
+const ResourceInstanaBuiltinEventState = "builtin_event_state"
+
+// Description constants for the built-in event state resource
+const (
+	BuiltinEventStateDescResource = "This resource enables or disables a built-in event specification. Changes of the state outside of Terraform are detected and reverted. " +
+		"Destroying the resource restores the state of the built-in event before it was managed by this resource. " +
+		"The ID of built-in events can be read with the data source instana_builtin_event_spec."
+	BuiltinEventStateDescID              = "The ID of the built-in event state. Equal to the builtin_event_id."
+	BuiltinEventStateDescBuiltinEventID  = "The ID of the built-in event specification."
+	BuiltinEventStateDescEnabled         = "Flag indicating if the built-in event is enabled."
+	BuiltinEventStateDescOriginalEnabled = "The enabled state of the built-in event before it was managed by this resource. Restored when the resource is destroyed."
+)
+
+// Field name constants
+const (
+	//BuiltinEventStateFieldID field name for id
+	BuiltinEventStateFieldID = "id"
+	//BuiltinEventStateFieldBuiltinEventID field name for builtin_event_id
+	BuiltinEventStateFieldBuiltinEventID = "builtin_event_id"
+	//BuiltinEventStateFieldEnabled field name for enabled
+	BuiltinEventStateFieldEnabled = "enabled"
+	//BuiltinEventStateFieldOriginalEnabled field name for original_enabled
+	BuiltinEventStateFieldOriginalEnabled = "original_enabled"
+)
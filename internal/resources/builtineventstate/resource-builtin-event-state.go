@@ -0,0 +1,161 @@
+package builtineventstate
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+)
+
+// ============================================================================
+// Resource Factory
+// ============================================================================
+
+// NewBuiltinEventStateResourceHandle creates the resource handle for the enabled state of built-in events
+func NewBuiltinEventStateResourceHandle() resourcehandle.ResourceHandle[*instanaapi.BuiltinEventState] {
+	builtinEventIDField := BuiltinEventStateFieldBuiltinEventID
+	return &builtinEventStateResource{
+		metaData: resourcehandle.ResourceMetaData{
+			ResourceName:          ResourceInstanaBuiltinEventState,
+			DisableListDataSource: true,
+			Schema: schema.Schema{
+				Description: BuiltinEventStateDescResource,
+				Attributes: map[string]schema.Attribute{
+					BuiltinEventStateFieldID: schema.StringAttribute{
+						Computed:    true,
+						Description: BuiltinEventStateDescID,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					BuiltinEventStateFieldBuiltinEventID: schema.StringAttribute{
+						Required:    true,
+						Description: BuiltinEventStateDescBuiltinEventID,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					BuiltinEventStateFieldEnabled: schema.BoolAttribute{
+						Required:    true,
+						Description: BuiltinEventStateDescEnabled,
+					},
+					BuiltinEventStateFieldOriginalEnabled: schema.BoolAttribute{
+						Computed:    true,
+						Description: BuiltinEventStateDescOriginalEnabled,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			ResourceIDField:  &builtinEventIDField,
+		},
+	}
+}
+
+// ============================================================================
+// Resource Implementation
+// ============================================================================
+
+type builtinEventStateResource struct {
+	metaData resourcehandle.ResourceMetaData
+}
+
+// MetaData returns the resource metadata
+func (r *builtinEventStateResource) MetaData() *resourcehandle.ResourceMetaData {
+	return &r.metaData
+}
+
+// GetRestResource returns the REST resource for the enabled state of built-in events
+func (r *builtinEventStateResource) GetRestResource(api client.InstanaAPI) rest.RestResource[*instanaapi.BuiltinEventState] {
+	return instanaapi.From(api).BuiltinEventStates()
+}
+
+// DeleteObject restores the original enabled state of the built-in event, which is only available in the state
+func (r *builtinEventStateResource) DeleteObject(api client.InstanaAPI, state *instanaapi.BuiltinEventState) error {
+	return r.GetRestResource(api).Delete(state)
+}
+
+// SetComputedFields sets computed fields in the plan (none for this resource)
+func (r *builtinEventStateResource) SetComputedFields(_ context.Context, _ *tfsdk.Plan) diag.Diagnostics {
+	return nil
+}
+
+// ============================================================================
+// API to State Mapping
+// ============================================================================
+
+// UpdateState converts API data object to Terraform state. The API only knows the current state of the built-in
+// event, so on read the original state is kept from the Terraform state. After an import the current state is
+// used as original state.
+func (r *builtinEventStateResource) UpdateState(ctx context.Context, state *tfsdk.State, plan *tfsdk.Plan, builtinEventState *instanaapi.BuiltinEventState) diag.Diagnostics {
+	var diags diag.Diagnostics
+	originalEnabled := types.BoolValue(builtinEventState.OriginalEnabled)
+	if plan == nil {
+		var stateOriginalEnabled types.Bool
+		diags.Append(state.GetAttribute(ctx, path.Root(BuiltinEventStateFieldOriginalEnabled), &stateOriginalEnabled)...)
+		if diags.HasError() {
+			return diags
+		}
+		if !stateOriginalEnabled.IsNull() && !stateOriginalEnabled.IsUnknown() {
+			originalEnabled = stateOriginalEnabled
+		}
+	}
+
+	model := BuiltinEventStateModel{
+		ID:              types.StringValue(builtinEventState.ID),
+		BuiltinEventID:  types.StringValue(builtinEventState.ID),
+		Enabled:         types.BoolValue(builtinEventState.Enabled),
+		OriginalEnabled: originalEnabled,
+	}
+	diags.Append(state.Set(ctx, model)...)
+	return diags
+}
+
+// ============================================================================
+// State to API Mapping
+// ============================================================================
+
+// MapStateToDataObject converts Terraform state to API data object
+func (r *builtinEventStateResource) MapStateToDataObject(ctx context.Context, plan *tfsdk.Plan, state *tfsdk.State) (*instanaapi.BuiltinEventState, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var model BuiltinEventStateModel
+
+	if plan != nil {
+		diags.Append(plan.Get(ctx, &model)...)
+	} else if state != nil {
+		diags.Append(state.Get(ctx, &model)...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &instanaapi.BuiltinEventState{
+		ID:              model.BuiltinEventID.ValueString(),
+		Enabled:         model.Enabled.ValueBool(),
+		OriginalEnabled: model.OriginalEnabled.ValueBool(),
+	}, diags
+}
+
+// GetStateUpgraders returns the state upgraders for this resource (none needed for v0)
+func (r *builtinEventStateResource) GetStateUpgraders(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
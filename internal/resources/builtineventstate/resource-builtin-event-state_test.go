@@ -0,0 +1,142 @@
+package builtineventstate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBuiltinEventID = "event-id"
+
+func TestNewBuiltinEventStateResourceHandle(t *testing.T) {
+	t.Run("should create resource handle with correct metadata", func(t *testing.T) {
+		handle := NewBuiltinEventStateResourceHandle()
+
+		require.NotNil(t, handle)
+		metadata := handle.MetaData()
+		assert.Equal(t, ResourceInstanaBuiltinEventState, metadata.ResourceName)
+		assert.Equal(t, int64(0), metadata.SchemaVersion)
+		assert.True(t, metadata.SkipIDGeneration)
+		require.NotNil(t, metadata.ResourceIDField)
+		assert.Equal(t, BuiltinEventStateFieldBuiltinEventID, *metadata.ResourceIDField)
+		assert.True(t, metadata.DisableListDataSource)
+	})
+
+	t.Run("should have correct schema attributes", func(t *testing.T) {
+		s := NewBuiltinEventStateResourceHandle().MetaData().Schema
+
+		assert.True(t, s.Attributes[BuiltinEventStateFieldID].IsComputed())
+		assert.True(t, s.Attributes[BuiltinEventStateFieldBuiltinEventID].IsRequired())
+		assert.True(t, s.Attributes[BuiltinEventStateFieldEnabled].IsRequired())
+		assert.True(t, s.Attributes[BuiltinEventStateFieldOriginalEnabled].IsComputed())
+	})
+}
+
+type mockBuiltinEventStateAPI struct {
+	testutils.MockInstanaAPI
+	restResource rest.RestResource[*instanaapi.BuiltinEventState]
+}
+
+func (m *mockBuiltinEventStateAPI) BuiltinEventStates() rest.RestResource[*instanaapi.BuiltinEventState] {
+	return m.restResource
+}
+
+func TestBuiltinEventStateGetRestResource(t *testing.T) {
+	restResource := instanaapi.NewBuiltinEventStateRestResource(nil)
+
+	result := NewBuiltinEventStateResourceHandle().GetRestResource(&mockBuiltinEventStateAPI{restResource: restResource})
+
+	assert.Same(t, restResource, result)
+}
+
+type recordingBuiltinEventStateRestResource struct {
+	rest.RestResource[*instanaapi.BuiltinEventState]
+	deleted *instanaapi.BuiltinEventState
+}
+
+func (r *recordingBuiltinEventStateRestResource) Delete(data *instanaapi.BuiltinEventState) error {
+	r.deleted = data
+	return nil
+}
+
+func TestBuiltinEventStateDeleteObjectRestoresOriginalStateFromObject(t *testing.T) {
+	restResource := &recordingBuiltinEventStateRestResource{}
+	state := &instanaapi.BuiltinEventState{ID: testBuiltinEventID, Enabled: false, OriginalEnabled: true}
+
+	err := NewBuiltinEventStateResourceHandle().(*builtinEventStateResource).DeleteObject(&mockBuiltinEventStateAPI{restResource: restResource}, state)
+
+	require.NoError(t, err)
+	assert.Same(t, state, restResource.deleted)
+}
+
+func newTestModel(enabled bool, originalEnabled bool) BuiltinEventStateModel {
+	return BuiltinEventStateModel{
+		ID:              types.StringValue(testBuiltinEventID),
+		BuiltinEventID:  types.StringValue(testBuiltinEventID),
+		Enabled:         types.BoolValue(enabled),
+		OriginalEnabled: types.BoolValue(originalEnabled),
+	}
+}
+
+func TestBuiltinEventStateMapStateToDataObject(t *testing.T) {
+	ctx := context.Background()
+	handle := NewBuiltinEventStateResourceHandle()
+	state := &tfsdk.State{Schema: handle.MetaData().Schema}
+	require.False(t, state.Set(ctx, newTestModel(false, true)).HasError())
+
+	result, diags := handle.MapStateToDataObject(ctx, nil, state)
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, &instanaapi.BuiltinEventState{ID: testBuiltinEventID, Enabled: false, OriginalEnabled: true}, result)
+}
+
+func TestBuiltinEventStateUpdateState(t *testing.T) {
+	ctx := context.Background()
+	handle := NewBuiltinEventStateResourceHandle()
+
+	t.Run("should set original state returned by the API after create", func(t *testing.T) {
+		plan := &tfsdk.Plan{Schema: handle.MetaData().Schema}
+		require.False(t, plan.Set(ctx, newTestModel(false, false)).HasError())
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+
+		diags := handle.UpdateState(ctx, state, plan, &instanaapi.BuiltinEventState{ID: testBuiltinEventID, Enabled: false, OriginalEnabled: true})
+
+		require.False(t, diags.HasError())
+		var model BuiltinEventStateModel
+		require.False(t, state.Get(ctx, &model).HasError())
+		assert.Equal(t, newTestModel(false, true), model)
+	})
+
+	t.Run("should report drift and keep original state on read", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+		require.False(t, state.Set(ctx, newTestModel(false, true)).HasError())
+
+		diags := handle.UpdateState(ctx, state, nil, &instanaapi.BuiltinEventState{ID: testBuiltinEventID, Enabled: true, OriginalEnabled: true})
+
+		require.False(t, diags.HasError())
+		var model BuiltinEventStateModel
+		require.False(t, state.Get(ctx, &model).HasError())
+		assert.Equal(t, newTestModel(true, true), model)
+	})
+
+	t.Run("should use current state as original state after import", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema, Raw: tftypes.NewValue(handle.MetaData().Schema.Type().TerraformType(ctx), nil)}
+		require.False(t, state.SetAttribute(ctx, path.Root(BuiltinEventStateFieldBuiltinEventID), testBuiltinEventID).HasError())
+
+		diags := handle.UpdateState(ctx, state, nil, &instanaapi.BuiltinEventState{ID: testBuiltinEventID, Enabled: false, OriginalEnabled: false})
+
+		require.False(t, diags.HasError())
+		var model BuiltinEventStateModel
+		require.False(t, state.Get(ctx, &model).HasError())
+		assert.Equal(t, newTestModel(false, false), model)
+	})
+}
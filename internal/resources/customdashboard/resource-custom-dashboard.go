@@ -33,7 +33,8 @@ import (
 func NewCustomDashboardResourceHandle() resourcehandle.ResourceHandle[*api.CustomDashboard] {
 	return &customDashboardResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName: ResourceInstanaCustomDashboard,
+			ResourceName:        ResourceInstanaCustomDashboard,
+			ImportNameAttribute: CustomDashboardFieldTitle,
 			Schema: schema.Schema{
 				Description: CustomDashboardDescResource,
 				Attributes: map[string]schema.Attribute{
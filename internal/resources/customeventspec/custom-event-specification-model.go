@@ -1,6 +1,9 @@
 package customeventspec
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+)
 
 // CustomEventSpecificationModel represents the data model for the custom event specification resource
 type CustomEventSpecificationModel struct {
@@ -54,10 +57,10 @@ type EntityVerificationRuleModel struct {
 
 // HostAvailabilityRuleModel represents a host availability rule
 type HostAvailabilityRuleModel struct {
-	Severity        types.String `tfsdk:"severity"`
-	OfflineDuration types.Int64  `tfsdk:"offline_duration"`
-	CloseAfter      types.Int64  `tfsdk:"close_after"`
-	TagFilter       types.String `tfsdk:"tag_filter"`
+	Severity        types.String          `tfsdk:"severity"`
+	OfflineDuration types.Int64           `tfsdk:"offline_duration"`
+	CloseAfter      types.Int64           `tfsdk:"close_after"`
+	TagFilter       shared.TagFilterValue `tfsdk:"tag_filter"`
 }
 
 // SystemRuleModel represents a system rule
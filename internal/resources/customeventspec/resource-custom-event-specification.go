@@ -20,6 +20,7 @@ import (
 	"github.com/instana/instana-go-client/shared/rest"
 	tag "github.com/instana/instana-go-client/shared/tagfilter"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
 	"github.com/instana/terraform-provider-instana/internal/util"
 )
@@ -32,9 +33,10 @@ import (
 func NewCustomEventSpecificationResourceHandle() resourcehandle.ResourceHandle[*api.CustomEventSpecification] {
 	return &customEventSpecificationResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName:  ResourceInstanaCustomEventSpecification,
-			Schema:        createCustomEventSpecificationSchema(),
-			SchemaVersion: 1,
+			ResourceName:        ResourceInstanaCustomEventSpecification,
+			ImportNameAttribute: CustomEventSpecificationFieldName,
+			Schema:              createCustomEventSpecificationSchema(),
+			SchemaVersion:       1,
 		},
 	}
 }
@@ -223,6 +225,13 @@ func createCustomEventSpecificationSchema() schema.Schema {
 							CustomEventSpecificationHostAvailabilityRuleFieldTagFilter: schema.StringAttribute{
 								Description: CustomEventSpecificationResourceDescTagFilter,
 								Optional:    true,
+								CustomType:  shared.TagFilterType{},
+								Validators: []validator.String{
+									shared.TagFilterValidator(),
+								},
+								PlanModifiers: []planmodifier.String{
+									shared.TagFilterPlanModifier(),
+								},
 							},
 						},
 					},
@@ -484,7 +493,7 @@ func (r *customEventSpecificationResource) buildHostAvailabilityRule(rule *api.R
 		Severity:        mapIntToSeverityString(rule.Severity),
 		OfflineDuration: util.SetInt64PointerToState(rule.OfflineDuration),
 		CloseAfter:      util.SetInt64PointerToState(rule.CloseAfter),
-		TagFilter:       types.StringValue(CustomEventSpecificationDefaultEmptyString),
+		TagFilter:       shared.NewTagFilterValue(CustomEventSpecificationDefaultEmptyString),
 	}
 
 	// Handle tag filter conversion with proper error handling
@@ -496,7 +505,7 @@ func (r *customEventSpecificationResource) buildHostAvailabilityRule(rule *api.R
 				fmt.Sprintf("Failed to convert tag filter to string: %v. Using empty string.", err),
 			)
 		} else if normalizedTagFilterString != nil {
-			model.TagFilter = util.SetStringPointerToState(normalizedTagFilterString)
+			model.TagFilter = shared.NewTagFilterPointerValue(normalizedTagFilterString)
 		}
 	}
 
@@ -11,6 +11,7 @@ import (
 	tag "github.com/instana/instana-go-client/shared/tagfilter"
 	common "github.com/instana/instana-go-client/shared/types"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -296,7 +297,7 @@ func TestMapStateToDataObject_WithHostAvailabilityRule(t *testing.T) {
 				Severity:        types.StringValue("critical"),
 				OfflineDuration: types.Int64Value(120000),
 				CloseAfter:      types.Int64Value(300000),
-				TagFilter:       types.StringValue("entity.type EQUALS 'host'"),
+				TagFilter:       shared.NewTagFilterValue("entity.type EQUALS 'host'"),
 			},
 		},
 	})
@@ -331,7 +332,7 @@ func TestMapStateToDataObject_WithHostAvailabilityRuleNoCloseAfter(t *testing.T)
 				Severity:        types.StringValue("warning"),
 				OfflineDuration: types.Int64Value(60000),
 				CloseAfter:      types.Int64Null(),
-				TagFilter:       types.StringValue(""),
+				TagFilter:       shared.NewTagFilterValue(""),
 			},
 		},
 	})
@@ -362,7 +363,7 @@ func TestMapStateToDataObject_WithInvalidTagFilter(t *testing.T) {
 			HostAvailability: &HostAvailabilityRuleModel{
 				Severity:        types.StringValue("warning"),
 				OfflineDuration: types.Int64Value(60000),
-				TagFilter:       types.StringValue("invalid tag filter syntax"),
+				TagFilter:       shared.NewTagFilterValue("invalid tag filter syntax"),
 			},
 		},
 	})
@@ -0,0 +1,18 @@
+package custompayloadconfig
+
+// ResourceInstanaGlobalCustomPayloadConfig is the name of the terraform resource for the global custom payload configuration.
+const ResourceInstanaGlobalCustomPayloadConfig = "global_custom_payload_config"
+
+// Resource description constants
+const (
+	// CustomPayloadConfigDescResource describes the resource purpose
+	CustomPayloadConfigDescResource = "Manages the global custom payload configuration in Instana. " +
+		"The custom payload fields are attached to every alert of the tenant unit. " +
+		"This is a singleton resource — only one instance exists per tenant unit."
+)
+
+// Validation constants enforced by the Instana API
+const (
+	// CustomPayloadConfigMaxFields maximum number of custom payload fields
+	CustomPayloadConfigMaxFields = 20
+)
@@ -0,0 +1,8 @@
+package custompayloadconfig
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// CustomPayloadConfigModel is the Terraform model for the global custom payload configuration.
+type CustomPayloadConfigModel struct {
+	CustomPayloadFields types.List `tfsdk:"custom_payload_field"`
+}
@@ -0,0 +1,100 @@
+package custompayloadconfig
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+)
+
+// NewGlobalCustomPayloadConfigResourceHandle creates the resource handle for the global custom payload configuration.
+func NewGlobalCustomPayloadConfigResourceHandle() resourcehandle.SingletonResourceHandle[*instanaapi.CustomPayloadConfiguration] {
+	customPayloadFieldsSchema := shared.GetCustomPayloadFieldsSchema()
+	customPayloadFieldsSchema.Validators = []validator.List{
+		listvalidator.SizeAtMost(CustomPayloadConfigMaxFields),
+	}
+
+	return &customPayloadConfigResourceHandle{
+		metaData: resourcehandle.ResourceMetaData{
+			ResourceName: ResourceInstanaGlobalCustomPayloadConfig,
+			Schema: schema.Schema{
+				Description: CustomPayloadConfigDescResource,
+				Attributes: map[string]schema.Attribute{
+					shared.DefaultCustomPayloadFieldsName: customPayloadFieldsSchema,
+				},
+			},
+		},
+	}
+}
+
+type customPayloadConfigResourceHandle struct {
+	metaData resourcehandle.ResourceMetaData
+}
+
+// MetaData returns the resource metadata.
+func (h *customPayloadConfigResourceHandle) MetaData() *resourcehandle.ResourceMetaData {
+	return &h.metaData
+}
+
+// GetSingletonRestResource returns the singleton REST client for the global custom payload configuration.
+func (h *customPayloadConfigResourceHandle) GetSingletonRestResource(api client.InstanaAPI) rest.SingletonRestResource[*instanaapi.CustomPayloadConfiguration] {
+	return instanaapi.From(api).CustomPayloadConfiguration()
+}
+
+// SetComputedFields is a no-op — the global custom payload configuration has no computed fields.
+func (h *customPayloadConfigResourceHandle) SetComputedFields(_ context.Context, _ *tfsdk.Plan) diag.Diagnostics {
+	return diag.Diagnostics{}
+}
+
+// MapStateToDataObject maps the Terraform plan/state to the API object.
+func (h *customPayloadConfigResourceHandle) MapStateToDataObject(ctx context.Context, plan *tfsdk.Plan, state *tfsdk.State) (*instanaapi.CustomPayloadConfiguration, diag.Diagnostics) {
+	var model CustomPayloadConfigModel
+	var diags diag.Diagnostics
+
+	if plan != nil {
+		diags = plan.Get(ctx, &model)
+	} else {
+		diags = state.Get(ctx, &model)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	fields, fieldDiags := shared.MapCustomPayloadFieldsToAPIObject(ctx, model.CustomPayloadFields)
+	diags.Append(fieldDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &instanaapi.CustomPayloadConfiguration{
+		Fields: fields,
+	}, diags
+}
+
+// UpdateState updates the Terraform state with the API object.
+func (h *customPayloadConfigResourceHandle) UpdateState(ctx context.Context, state *tfsdk.State, _ *tfsdk.Plan, config *instanaapi.CustomPayloadConfiguration) diag.Diagnostics {
+	fields, diags := shared.CustomPayloadFieldsToTerraform(ctx, config.Fields)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(state.Set(ctx, CustomPayloadConfigModel{
+		CustomPayloadFields: fields,
+	})...)
+	return diags
+}
+
+// GetStateUpgraders returns nil — no state schema migrations are needed for this resource.
+func (h *customPayloadConfigResourceHandle) GetStateUpgraders(_ context.Context) map[int64]resource.StateUpgrader {
+	return nil
+}
@@ -0,0 +1,146 @@
+package custompayloadconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/shared/rest"
+	model "github.com/instana/instana-go-client/shared/types"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func strPtr(s string) *string { return &s }
+
+type mockCustomPayloadConfigAPI struct {
+	testutils.MockInstanaAPI
+	restResource rest.SingletonRestResource[*instanaapi.CustomPayloadConfiguration]
+}
+
+func (m *mockCustomPayloadConfigAPI) CustomPayloadConfiguration() rest.SingletonRestResource[*instanaapi.CustomPayloadConfiguration] {
+	return m.restResource
+}
+
+func newFieldsList(t *testing.T) types.List {
+	dynamicValue := types.ObjectValueMust(shared.GetDynamicValueType().AttrTypes, map[string]attr.Value{
+		"key":      types.StringNull(),
+		"tag_name": types.StringValue("kubernetes.cluster.name"),
+	})
+	list, diags := types.ListValue(shared.GetCustomPayloadFieldType(), []attr.Value{
+		types.ObjectValueMust(shared.CustomPayloadFieldAttributeTypes(), map[string]attr.Value{
+			"key":           types.StringValue("team"),
+			"value":         types.StringValue("platform"),
+			"dynamic_value": types.ObjectNull(shared.GetDynamicValueType().AttrTypes),
+		}),
+		types.ObjectValueMust(shared.CustomPayloadFieldAttributeTypes(), map[string]attr.Value{
+			"key":           types.StringValue("cluster"),
+			"value":         types.StringNull(),
+			"dynamic_value": dynamicValue,
+		}),
+	})
+	require.False(t, diags.HasError())
+	return list
+}
+
+func newAPIObject() *instanaapi.CustomPayloadConfiguration {
+	return &instanaapi.CustomPayloadConfiguration{
+		Fields: []model.CustomPayloadField[any]{
+			{Type: model.StaticStringCustomPayloadType, Key: "team", Value: "platform"},
+			{Type: model.DynamicCustomPayloadType, Key: "cluster", Value: model.DynamicCustomPayloadFieldValue{TagName: "kubernetes.cluster.name"}},
+		},
+	}
+}
+
+func TestNewGlobalCustomPayloadConfigResourceHandle(t *testing.T) {
+	handle := NewGlobalCustomPayloadConfigResourceHandle()
+
+	require.NotNil(t, handle)
+	assert.Equal(t, ResourceInstanaGlobalCustomPayloadConfig, handle.MetaData().ResourceName)
+	require.Contains(t, handle.MetaData().Schema.Attributes, shared.DefaultCustomPayloadFieldsName)
+	assert.True(t, handle.MetaData().Schema.Attributes[shared.DefaultCustomPayloadFieldsName].IsOptional())
+	assert.Nil(t, handle.GetStateUpgraders(context.Background()))
+}
+
+func TestGlobalCustomPayloadConfigGetSingletonRestResource(t *testing.T) {
+	restResource := instanaapi.NewSingletonRestResource[*instanaapi.CustomPayloadConfiguration](instanaapi.CustomPayloadConfigurationResourcePath, nil)
+
+	result := NewGlobalCustomPayloadConfigResourceHandle().GetSingletonRestResource(&mockCustomPayloadConfigAPI{restResource: restResource})
+
+	assert.Same(t, restResource, result)
+}
+
+func TestGlobalCustomPayloadConfigMapStateToDataObject(t *testing.T) {
+	ctx := context.Background()
+	handle := NewGlobalCustomPayloadConfigResourceHandle()
+
+	t.Run("should map static and dynamic fields", func(t *testing.T) {
+		plan := &tfsdk.Plan{Schema: handle.MetaData().Schema}
+		require.False(t, plan.Set(ctx, CustomPayloadConfigModel{CustomPayloadFields: newFieldsList(t)}).HasError())
+
+		result, diags := handle.MapStateToDataObject(ctx, plan, nil)
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, newAPIObject(), result)
+	})
+
+	t.Run("should map null fields to empty list", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+		require.False(t, state.Set(ctx, CustomPayloadConfigModel{CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType())}).HasError())
+
+		result, diags := handle.MapStateToDataObject(ctx, nil, state)
+
+		require.False(t, diags.HasError())
+		assert.NotNil(t, result.Fields)
+		assert.Empty(t, result.Fields)
+	})
+}
+
+func TestGlobalCustomPayloadConfigUpdateState(t *testing.T) {
+	ctx := context.Background()
+	handle := NewGlobalCustomPayloadConfigResourceHandle()
+
+	t.Run("should map static and dynamic fields", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+
+		diags := handle.UpdateState(ctx, state, nil, newAPIObject())
+
+		require.False(t, diags.HasError())
+		var result CustomPayloadConfigModel
+		require.False(t, state.Get(ctx, &result).HasError())
+		assert.Equal(t, newFieldsList(t), result.CustomPayloadFields)
+	})
+
+	t.Run("should map dynamic field with key", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+		config := &instanaapi.CustomPayloadConfiguration{
+			Fields: []model.CustomPayloadField[any]{
+				{Type: model.DynamicCustomPayloadType, Key: "label", Value: model.DynamicCustomPayloadFieldValue{Key: strPtr("app"), TagName: "kubernetes.pod.label"}},
+			},
+		}
+
+		diags := handle.UpdateState(ctx, state, nil, config)
+
+		require.False(t, diags.HasError())
+		var result CustomPayloadConfigModel
+		require.False(t, state.Get(ctx, &result).HasError())
+		assert.Len(t, result.CustomPayloadFields.Elements(), 1)
+	})
+
+	t.Run("should map no fields to empty list", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+
+		diags := handle.UpdateState(ctx, state, nil, &instanaapi.CustomPayloadConfiguration{})
+
+		require.False(t, diags.HasError())
+		var result CustomPayloadConfigModel
+		require.False(t, state.Get(ctx, &result).HasError())
+		assert.False(t, result.CustomPayloadFields.IsNull())
+		assert.Empty(t, result.CustomPayloadFields.Elements())
+	})
+}
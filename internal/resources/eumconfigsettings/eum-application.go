@@ -0,0 +1,78 @@
+package eumconfigsettings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+)
+
+// eumApplication describes the type of end user monitoring application (website or mobile app) a configuration
+// belongs to. The website and mobile app variants of the resources only differ in the attributes derived from it.
+type eumApplication struct {
+	appType instanaapi.EUMApplicationType
+	label   string
+	idField string
+}
+
+var (
+	websiteApplication   = eumApplication{appType: instanaapi.EUMApplicationTypeWebsite, label: "website", idField: EUMConfigSettingsFieldWebsiteID}
+	mobileAppApplication = eumApplication{appType: instanaapi.EUMApplicationTypeMobileApp, label: "mobile app", idField: EUMConfigSettingsFieldMobileAppID}
+)
+
+// describe formats the given description template with the label of the application
+func (a eumApplication) describe(template string) string {
+	return fmt.Sprintf(template, a.label, a.label)
+}
+
+// schemaAttributes returns the ID attributes shared by all configuration resources merged with the given
+// resource specific attributes
+func (a eumApplication) schemaAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes[EUMConfigSettingsFieldID] = schema.StringAttribute{
+		Computed:    true,
+		Description: fmt.Sprintf(EUMConfigSettingsDescID, a.label),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes[a.idField] = schema.StringAttribute{
+		Required:    true,
+		Description: fmt.Sprintf(EUMConfigSettingsDescApplicationID, a.label),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+	return attributes
+}
+
+// getString reads the string attribute with the given name from the plan or, if no plan is provided, from the state
+func getString(ctx context.Context, plan *tfsdk.Plan, state *tfsdk.State, attribute string) (types.String, diag.Diagnostics) {
+	var value types.String
+	if plan != nil {
+		return value, plan.GetAttribute(ctx, path.Root(attribute), &value)
+	}
+	return value, state.GetAttribute(ctx, path.Root(attribute), &value)
+}
+
+// setState sets the ID attributes and the given resource specific attributes in the state
+func (a eumApplication) setState(ctx context.Context, state *tfsdk.State, id string, attributes map[string]types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.Append(state.SetAttribute(ctx, path.Root(EUMConfigSettingsFieldID), types.StringValue(id))...)
+	diags.Append(state.SetAttribute(ctx, path.Root(a.idField), types.StringValue(id))...)
+	for name, value := range attributes {
+		diags.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	return diags
+}
@@ -0,0 +1,59 @@
+package eumconfigsettings
+
+// Resource name constants
+const (
+	// ResourceInstanaWebsiteGeoLocationConfig the name of the terraform resource for the geo location configuration of websites
+	ResourceInstanaWebsiteGeoLocationConfig = "website_geo_location_config"
+	// ResourceInstanaMobileAppGeoLocationConfig the name of the terraform resource for the geo location configuration of mobile apps
+	ResourceInstanaMobileAppGeoLocationConfig = "mobile_app_geo_location_config"
+	// ResourceInstanaWebsiteGeoMappingRules the name of the terraform resource for the geo mapping rules of websites
+	ResourceInstanaWebsiteGeoMappingRules = "website_geo_mapping_rules"
+	// ResourceInstanaMobileAppGeoMappingRules the name of the terraform resource for the geo mapping rules of mobile apps
+	ResourceInstanaMobileAppGeoMappingRules = "mobile_app_geo_mapping_rules"
+	// ResourceInstanaWebsiteIPMaskingConfig the name of the terraform resource for the IP masking configuration of websites
+	ResourceInstanaWebsiteIPMaskingConfig = "website_ip_masking_config"
+	// ResourceInstanaMobileAppIPMaskingConfig the name of the terraform resource for the IP masking configuration of mobile apps
+	ResourceInstanaMobileAppIPMaskingConfig = "mobile_app_ip_masking_config"
+)
+
+// Field name constants
+const (
+	// EUMConfigSettingsFieldID the name of the ID field
+	EUMConfigSettingsFieldID = "id"
+	// EUMConfigSettingsFieldWebsiteID the name of the field referencing the website
+	EUMConfigSettingsFieldWebsiteID = "website_id"
+	// EUMConfigSettingsFieldMobileAppID the name of the field referencing the mobile app
+	EUMConfigSettingsFieldMobileAppID = "mobile_app_id"
+	// GeoLocationConfigFieldGeoDetailRemoval the name of the field for the geo detail removal level
+	GeoLocationConfigFieldGeoDetailRemoval = "geo_detail_removal"
+	// GeoMappingRulesFieldRulesCSV the name of the field for the CSV document of the geo mapping rules
+	GeoMappingRulesFieldRulesCSV = "rules_csv"
+	// IPMaskingConfigFieldIPMasking the name of the field for the IP masking level
+	IPMaskingConfigFieldIPMasking = "ip_masking"
+)
+
+// Description constants
+const (
+	// EUMConfigSettingsDescID description of the ID field
+	EUMConfigSettingsDescID = "The ID of the configuration, which is the ID of the %s it belongs to."
+	// EUMConfigSettingsDescApplicationID description of the field referencing the website or mobile app
+	EUMConfigSettingsDescApplicationID = "The ID of the %s the configuration belongs to. Changing the ID forces a new resource."
+	// GeoLocationConfigDescResource description of the geo location configuration resources
+	GeoLocationConfigDescResource = "Manages the geo location configuration of a %s in Instana. " +
+		"The configuration exists once per %s; deleting the resource restores the default of keeping all geo location details."
+	// GeoLocationConfigDescGeoDetailRemoval description of the geo_detail_removal field
+	GeoLocationConfigDescGeoDetailRemoval = "The level of geo location details removed from the collected beacons. " +
+		"Supported values: NO_REMOVAL, REMOVE_COORDINATES, REMOVE_CITY, REMOVE_ALL."
+	// GeoMappingRulesDescResource description of the geo mapping rules resources
+	GeoMappingRulesDescResource = "Manages the custom geo mapping rules of a %s in Instana. " +
+		"The rules exist once per %s; deleting the resource removes all custom rules."
+	// GeoMappingRulesDescRulesCSV description of the rules_csv field
+	GeoMappingRulesDescRulesCSV = "The geo mapping rules as CSV document in the format expected by the Instana API. " +
+		"Differences in line endings and trailing whitespace are ignored."
+	// IPMaskingConfigDescResource description of the IP masking configuration resources
+	IPMaskingConfigDescResource = "Manages the IP masking configuration of a %s in Instana. " +
+		"The configuration exists once per %s; deleting the resource restores the default masking."
+	// IPMaskingConfigDescIPMasking description of the ip_masking field
+	IPMaskingConfigDescIPMasking = "The masking level applied to the IP addresses of the collected beacons. " +
+		"Supported values: DEFAULT, STRICT, REMOVE_ALL_DETAILS."
+)
@@ -0,0 +1,105 @@
+package eumconfigsettings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+)
+
+// NewWebsiteGeoLocationConfigResourceHandle creates the resource handle for the geo location configuration of websites
+func NewWebsiteGeoLocationConfigResourceHandle() resourcehandle.ResourceHandle[*instanaapi.GeoLocationConfiguration] {
+	return newGeoLocationConfigResourceHandle(ResourceInstanaWebsiteGeoLocationConfig, websiteApplication)
+}
+
+// NewMobileAppGeoLocationConfigResourceHandle creates the resource handle for the geo location configuration of mobile apps
+func NewMobileAppGeoLocationConfigResourceHandle() resourcehandle.ResourceHandle[*instanaapi.GeoLocationConfiguration] {
+	return newGeoLocationConfigResourceHandle(ResourceInstanaMobileAppGeoLocationConfig, mobileAppApplication)
+}
+
+func newGeoLocationConfigResourceHandle(resourceName string, application eumApplication) resourcehandle.ResourceHandle[*instanaapi.GeoLocationConfiguration] {
+	idField := application.idField
+	return &geoLocationConfigResource{
+		application: application,
+		metaData: resourcehandle.ResourceMetaData{
+			ResourceName:          resourceName,
+			DisableListDataSource: true,
+			Schema: schema.Schema{
+				Description: application.describe(GeoLocationConfigDescResource),
+				Attributes: application.schemaAttributes(map[string]schema.Attribute{
+					GeoLocationConfigFieldGeoDetailRemoval: schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString(string(instanaapi.GeoDetailRemovalNoRemoval)),
+						Description: GeoLocationConfigDescGeoDetailRemoval,
+						Validators: []validator.String{
+							stringvalidator.OneOf(instanaapi.SupportedGeoDetailRemovals...),
+						},
+					},
+				}),
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			ResourceIDField:  &idField,
+		},
+	}
+}
+
+type geoLocationConfigResource struct {
+	application eumApplication
+	metaData    resourcehandle.ResourceMetaData
+}
+
+// MetaData returns the resource metadata
+func (r *geoLocationConfigResource) MetaData() *resourcehandle.ResourceMetaData {
+	return &r.metaData
+}
+
+// GetRestResource returns the REST resource for the geo location configurations of the application type
+func (r *geoLocationConfigResource) GetRestResource(api client.InstanaAPI) rest.RestResource[*instanaapi.GeoLocationConfiguration] {
+	return instanaapi.From(api).GeoLocationConfigurations(r.application.appType)
+}
+
+// SetComputedFields sets computed fields in the plan (none for this resource)
+func (r *geoLocationConfigResource) SetComputedFields(_ context.Context, _ *tfsdk.Plan) diag.Diagnostics {
+	return nil
+}
+
+// UpdateState converts API data object to Terraform state
+func (r *geoLocationConfigResource) UpdateState(ctx context.Context, state *tfsdk.State, _ *tfsdk.Plan, config *instanaapi.GeoLocationConfiguration) diag.Diagnostics {
+	return r.application.setState(ctx, state, config.ConfigID, map[string]types.String{
+		GeoLocationConfigFieldGeoDetailRemoval: types.StringValue(string(config.GeoDetailRemoval)),
+	})
+}
+
+// MapStateToDataObject converts Terraform state to API data object
+func (r *geoLocationConfigResource) MapStateToDataObject(ctx context.Context, plan *tfsdk.Plan, state *tfsdk.State) (*instanaapi.GeoLocationConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	id, idDiags := getString(ctx, plan, state, r.application.idField)
+	diags.Append(idDiags...)
+	geoDetailRemoval, valueDiags := getString(ctx, plan, state, GeoLocationConfigFieldGeoDetailRemoval)
+	diags.Append(valueDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &instanaapi.GeoLocationConfiguration{
+		ConfigID:         id.ValueString(),
+		GeoDetailRemoval: instanaapi.GeoDetailRemoval(geoDetailRemoval.ValueString()),
+	}, diags
+}
+
+// GetStateUpgraders returns the state upgraders for this resource (none needed for v0)
+func (r *geoLocationConfigResource) GetStateUpgraders(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
@@ -0,0 +1,65 @@
+package eumconfigsettings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGeoLocationConfigResourceHandles(t *testing.T) {
+	website := NewWebsiteGeoLocationConfigResourceHandle()
+	mobileApp := NewMobileAppGeoLocationConfigResourceHandle()
+
+	assert.Equal(t, ResourceInstanaWebsiteGeoLocationConfig, website.MetaData().ResourceName)
+	assert.Equal(t, EUMConfigSettingsFieldWebsiteID, *website.MetaData().ResourceIDField)
+	assert.Contains(t, website.MetaData().Schema.Attributes, EUMConfigSettingsFieldWebsiteID)
+	assert.NotContains(t, website.MetaData().Schema.Attributes, EUMConfigSettingsFieldMobileAppID)
+	assert.Contains(t, website.MetaData().Schema.Description, "website")
+
+	assert.Equal(t, ResourceInstanaMobileAppGeoLocationConfig, mobileApp.MetaData().ResourceName)
+	assert.Equal(t, EUMConfigSettingsFieldMobileAppID, *mobileApp.MetaData().ResourceIDField)
+	assert.Contains(t, mobileApp.MetaData().Schema.Attributes, EUMConfigSettingsFieldMobileAppID)
+	assert.NotContains(t, mobileApp.MetaData().Schema.Attributes, EUMConfigSettingsFieldWebsiteID)
+	assert.Contains(t, mobileApp.MetaData().Schema.Description, "mobile app")
+	assert.True(t, mobileApp.MetaData().SkipIDGeneration)
+	assert.Empty(t, mobileApp.GetStateUpgraders(context.Background()))
+}
+
+func TestGeoLocationConfigMapStateToDataObject(t *testing.T) {
+	ctx := context.Background()
+	handle := NewMobileAppGeoLocationConfigResourceHandle()
+	plan := &tfsdk.Plan{Schema: handle.MetaData().Schema, Raw: tftypes.NewValue(handle.MetaData().Schema.Type().TerraformType(ctx), nil)}
+	require.False(t, plan.SetAttribute(ctx, path.Root(EUMConfigSettingsFieldMobileAppID), types.StringValue("mobile-app-id")).HasError())
+	require.False(t, plan.SetAttribute(ctx, path.Root(GeoLocationConfigFieldGeoDetailRemoval), types.StringValue("REMOVE_ALL")).HasError())
+
+	result, diags := handle.MapStateToDataObject(ctx, plan, nil)
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, &instanaapi.GeoLocationConfiguration{ConfigID: "mobile-app-id", GeoDetailRemoval: instanaapi.GeoDetailRemovalRemoveAll}, result)
+}
+
+func TestGeoLocationConfigUpdateState(t *testing.T) {
+	ctx := context.Background()
+	handle := NewWebsiteGeoLocationConfigResourceHandle()
+	state := &tfsdk.State{Schema: handle.MetaData().Schema, Raw: tftypes.NewValue(handle.MetaData().Schema.Type().TerraformType(ctx), nil)}
+
+	diags := handle.UpdateState(ctx, state, nil, &instanaapi.GeoLocationConfiguration{ConfigID: "website-id", GeoDetailRemoval: instanaapi.GeoDetailRemovalRemoveCoordinates})
+
+	require.False(t, diags.HasError())
+	assertStringAttribute(t, state, EUMConfigSettingsFieldID, "website-id")
+	assertStringAttribute(t, state, EUMConfigSettingsFieldWebsiteID, "website-id")
+	assertStringAttribute(t, state, GeoLocationConfigFieldGeoDetailRemoval, "REMOVE_COORDINATES")
+}
+
+func assertStringAttribute(t *testing.T, state *tfsdk.State, attribute string, expected string) {
+	var value types.String
+	require.False(t, state.GetAttribute(context.Background(), path.Root(attribute), &value).HasError())
+	assert.Equal(t, expected, value.ValueString())
+}
@@ -0,0 +1,129 @@
+package eumconfigsettings
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+)
+
+// NewWebsiteGeoMappingRulesResourceHandle creates the resource handle for the geo mapping rules of websites
+func NewWebsiteGeoMappingRulesResourceHandle() resourcehandle.ResourceHandle[*instanaapi.GeoMappingRules] {
+	return newGeoMappingRulesResourceHandle(ResourceInstanaWebsiteGeoMappingRules, websiteApplication)
+}
+
+// NewMobileAppGeoMappingRulesResourceHandle creates the resource handle for the geo mapping rules of mobile apps
+func NewMobileAppGeoMappingRulesResourceHandle() resourcehandle.ResourceHandle[*instanaapi.GeoMappingRules] {
+	return newGeoMappingRulesResourceHandle(ResourceInstanaMobileAppGeoMappingRules, mobileAppApplication)
+}
+
+func newGeoMappingRulesResourceHandle(resourceName string, application eumApplication) resourcehandle.ResourceHandle[*instanaapi.GeoMappingRules] {
+	idField := application.idField
+	return &geoMappingRulesResource{
+		application: application,
+		metaData: resourcehandle.ResourceMetaData{
+			ResourceName:          resourceName,
+			DisableListDataSource: true,
+			Schema: schema.Schema{
+				Description: application.describe(GeoMappingRulesDescResource),
+				Attributes: application.schemaAttributes(map[string]schema.Attribute{
+					GeoMappingRulesFieldRulesCSV: schema.StringAttribute{
+						Required:    true,
+						Description: GeoMappingRulesDescRulesCSV,
+					},
+				}),
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			ResourceIDField:  &idField,
+		},
+	}
+}
+
+type geoMappingRulesResource struct {
+	application eumApplication
+	metaData    resourcehandle.ResourceMetaData
+}
+
+// MetaData returns the resource metadata
+func (r *geoMappingRulesResource) MetaData() *resourcehandle.ResourceMetaData {
+	return &r.metaData
+}
+
+// GetRestResource returns the REST resource for the geo mapping rules of the application type
+func (r *geoMappingRulesResource) GetRestResource(api client.InstanaAPI) rest.RestResource[*instanaapi.GeoMappingRules] {
+	return instanaapi.From(api).GeoMappingRules(r.application.appType)
+}
+
+// SetComputedFields sets computed fields in the plan (none for this resource)
+func (r *geoMappingRulesResource) SetComputedFields(_ context.Context, _ *tfsdk.Plan) diag.Diagnostics {
+	return nil
+}
+
+// UpdateState converts API data object to Terraform state. The configured CSV document is kept when it only
+// differs from the API response in line endings or trailing whitespace.
+func (r *geoMappingRulesResource) UpdateState(ctx context.Context, state *tfsdk.State, plan *tfsdk.Plan, rules *instanaapi.GeoMappingRules) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var prior types.String
+	if plan != nil {
+		diags.Append(plan.GetAttribute(ctx, path.Root(GeoMappingRulesFieldRulesCSV), &prior)...)
+	} else if state != nil && !state.Raw.IsNull() {
+		diags.Append(state.GetAttribute(ctx, path.Root(GeoMappingRulesFieldRulesCSV), &prior)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	rulesCSV := types.StringValue(rules.CSV)
+	if !prior.IsNull() && !prior.IsUnknown() && normalizeCSV(prior.ValueString()) == normalizeCSV(rules.CSV) {
+		rulesCSV = prior
+	}
+
+	diags.Append(r.application.setState(ctx, state, rules.ConfigID, map[string]types.String{
+		GeoMappingRulesFieldRulesCSV: rulesCSV,
+	})...)
+	return diags
+}
+
+// MapStateToDataObject converts Terraform state to API data object
+func (r *geoMappingRulesResource) MapStateToDataObject(ctx context.Context, plan *tfsdk.Plan, state *tfsdk.State) (*instanaapi.GeoMappingRules, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	id, idDiags := getString(ctx, plan, state, r.application.idField)
+	diags.Append(idDiags...)
+	rulesCSV, valueDiags := getString(ctx, plan, state, GeoMappingRulesFieldRulesCSV)
+	diags.Append(valueDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &instanaapi.GeoMappingRules{
+		ConfigID: id.ValueString(),
+		CSV:      rulesCSV.ValueString(),
+	}, diags
+}
+
+// GetStateUpgraders returns the state upgraders for this resource (none needed for v0)
+func (r *geoMappingRulesResource) GetStateUpgraders(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+// normalizeCSV normalizes the line endings and removes trailing whitespace and empty lines of the CSV document
+func normalizeCSV(csv string) string {
+	lines := strings.Split(strings.ReplaceAll(csv, "\r\n", "\n"), "\n")
+	normalized := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if line = strings.TrimRight(line, " \t\r"); line != "" {
+			normalized = append(normalized, line)
+		}
+	}
+	return strings.Join(normalized, "\n")
+}
@@ -0,0 +1,64 @@
+package eumconfigsettings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const rulesCSV = "10.0.0.0/8,EU,Europe,CH,Switzerland\n192.168.0.0/16,EU,Europe,DE,Germany\n"
+
+func newGeoMappingRulesPlan(ctx context.Context, t *testing.T, csv string) *tfsdk.Plan {
+	handle := NewWebsiteGeoMappingRulesResourceHandle()
+	plan := &tfsdk.Plan{Schema: handle.MetaData().Schema, Raw: tftypes.NewValue(handle.MetaData().Schema.Type().TerraformType(ctx), nil)}
+	require.False(t, plan.SetAttribute(ctx, path.Root(EUMConfigSettingsFieldWebsiteID), types.StringValue("website-id")).HasError())
+	require.False(t, plan.SetAttribute(ctx, path.Root(GeoMappingRulesFieldRulesCSV), types.StringValue(csv)).HasError())
+	return plan
+}
+
+func TestNewGeoMappingRulesResourceHandles(t *testing.T) {
+	assert.Equal(t, ResourceInstanaWebsiteGeoMappingRules, NewWebsiteGeoMappingRulesResourceHandle().MetaData().ResourceName)
+	assert.Equal(t, ResourceInstanaMobileAppGeoMappingRules, NewMobileAppGeoMappingRulesResourceHandle().MetaData().ResourceName)
+}
+
+func TestGeoMappingRulesMapStateToDataObject(t *testing.T) {
+	ctx := context.Background()
+
+	result, diags := NewWebsiteGeoMappingRulesResourceHandle().MapStateToDataObject(ctx, newGeoMappingRulesPlan(ctx, t, rulesCSV), nil)
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, &instanaapi.GeoMappingRules{ConfigID: "website-id", CSV: rulesCSV}, result)
+}
+
+func TestGeoMappingRulesUpdateState(t *testing.T) {
+	ctx := context.Background()
+	handle := NewWebsiteGeoMappingRulesResourceHandle()
+
+	t.Run("should keep planned CSV when it only differs in formatting", func(t *testing.T) {
+		planned := "10.0.0.0/8,EU,Europe,CH,Switzerland  \r\n192.168.0.0/16,EU,Europe,DE,Germany"
+		state := &tfsdk.State{Schema: handle.MetaData().Schema, Raw: tftypes.NewValue(handle.MetaData().Schema.Type().TerraformType(ctx), nil)}
+
+		diags := handle.UpdateState(ctx, state, newGeoMappingRulesPlan(ctx, t, planned), &instanaapi.GeoMappingRules{ConfigID: "website-id", CSV: rulesCSV})
+
+		require.False(t, diags.HasError())
+		assertStringAttribute(t, state, GeoMappingRulesFieldRulesCSV, planned)
+	})
+
+	t.Run("should use CSV of API when rules differ", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema, Raw: tftypes.NewValue(handle.MetaData().Schema.Type().TerraformType(ctx), nil)}
+		require.False(t, state.SetAttribute(ctx, path.Root(GeoMappingRulesFieldRulesCSV), types.StringValue("10.0.0.0/8,EU,Europe,CH,Switzerland")).HasError())
+
+		diags := handle.UpdateState(ctx, state, nil, &instanaapi.GeoMappingRules{ConfigID: "website-id", CSV: rulesCSV})
+
+		require.False(t, diags.HasError())
+		assertStringAttribute(t, state, GeoMappingRulesFieldRulesCSV, rulesCSV)
+		assertStringAttribute(t, state, EUMConfigSettingsFieldWebsiteID, "website-id")
+	})
+}
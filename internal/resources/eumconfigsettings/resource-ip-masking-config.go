@@ -0,0 +1,105 @@
+package eumconfigsettings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+)
+
+// NewWebsiteIPMaskingConfigResourceHandle creates the resource handle for the IP masking configuration of websites
+func NewWebsiteIPMaskingConfigResourceHandle() resourcehandle.ResourceHandle[*instanaapi.IPMaskingConfiguration] {
+	return newIPMaskingConfigResourceHandle(ResourceInstanaWebsiteIPMaskingConfig, websiteApplication)
+}
+
+// NewMobileAppIPMaskingConfigResourceHandle creates the resource handle for the IP masking configuration of mobile apps
+func NewMobileAppIPMaskingConfigResourceHandle() resourcehandle.ResourceHandle[*instanaapi.IPMaskingConfiguration] {
+	return newIPMaskingConfigResourceHandle(ResourceInstanaMobileAppIPMaskingConfig, mobileAppApplication)
+}
+
+func newIPMaskingConfigResourceHandle(resourceName string, application eumApplication) resourcehandle.ResourceHandle[*instanaapi.IPMaskingConfiguration] {
+	idField := application.idField
+	return &ipMaskingConfigResource{
+		application: application,
+		metaData: resourcehandle.ResourceMetaData{
+			ResourceName:          resourceName,
+			DisableListDataSource: true,
+			Schema: schema.Schema{
+				Description: application.describe(IPMaskingConfigDescResource),
+				Attributes: application.schemaAttributes(map[string]schema.Attribute{
+					IPMaskingConfigFieldIPMasking: schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString(string(instanaapi.IPMaskingDefault)),
+						Description: IPMaskingConfigDescIPMasking,
+						Validators: []validator.String{
+							stringvalidator.OneOf(instanaapi.SupportedIPMaskings...),
+						},
+					},
+				}),
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			ResourceIDField:  &idField,
+		},
+	}
+}
+
+type ipMaskingConfigResource struct {
+	application eumApplication
+	metaData    resourcehandle.ResourceMetaData
+}
+
+// MetaData returns the resource metadata
+func (r *ipMaskingConfigResource) MetaData() *resourcehandle.ResourceMetaData {
+	return &r.metaData
+}
+
+// GetRestResource returns the REST resource for the IP masking configurations of the application type
+func (r *ipMaskingConfigResource) GetRestResource(api client.InstanaAPI) rest.RestResource[*instanaapi.IPMaskingConfiguration] {
+	return instanaapi.From(api).IPMaskingConfigurations(r.application.appType)
+}
+
+// SetComputedFields sets computed fields in the plan (none for this resource)
+func (r *ipMaskingConfigResource) SetComputedFields(_ context.Context, _ *tfsdk.Plan) diag.Diagnostics {
+	return nil
+}
+
+// UpdateState converts API data object to Terraform state
+func (r *ipMaskingConfigResource) UpdateState(ctx context.Context, state *tfsdk.State, _ *tfsdk.Plan, config *instanaapi.IPMaskingConfiguration) diag.Diagnostics {
+	return r.application.setState(ctx, state, config.ConfigID, map[string]types.String{
+		IPMaskingConfigFieldIPMasking: types.StringValue(string(config.IPMasking)),
+	})
+}
+
+// MapStateToDataObject converts Terraform state to API data object
+func (r *ipMaskingConfigResource) MapStateToDataObject(ctx context.Context, plan *tfsdk.Plan, state *tfsdk.State) (*instanaapi.IPMaskingConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	id, idDiags := getString(ctx, plan, state, r.application.idField)
+	diags.Append(idDiags...)
+	ipMasking, valueDiags := getString(ctx, plan, state, IPMaskingConfigFieldIPMasking)
+	diags.Append(valueDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &instanaapi.IPMaskingConfiguration{
+		ConfigID:  id.ValueString(),
+		IPMasking: instanaapi.IPMasking(ipMasking.ValueString()),
+	}, diags
+}
+
+// GetStateUpgraders returns the state upgraders for this resource (none needed for v0)
+func (r *ipMaskingConfigResource) GetStateUpgraders(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
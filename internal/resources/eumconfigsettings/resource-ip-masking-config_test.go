@@ -0,0 +1,38 @@
+package eumconfigsettings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIPMaskingConfigResourceHandles(t *testing.T) {
+	assert.Equal(t, ResourceInstanaWebsiteIPMaskingConfig, NewWebsiteIPMaskingConfigResourceHandle().MetaData().ResourceName)
+	assert.Equal(t, ResourceInstanaMobileAppIPMaskingConfig, NewMobileAppIPMaskingConfigResourceHandle().MetaData().ResourceName)
+	assert.Contains(t, NewMobileAppIPMaskingConfigResourceHandle().MetaData().Schema.Attributes, IPMaskingConfigFieldIPMasking)
+}
+
+func TestIPMaskingConfigShouldMapStateToDataObjectAndBack(t *testing.T) {
+	ctx := context.Background()
+	handle := NewWebsiteIPMaskingConfigResourceHandle()
+	state := &tfsdk.State{Schema: handle.MetaData().Schema, Raw: tftypes.NewValue(handle.MetaData().Schema.Type().TerraformType(ctx), nil)}
+	require.False(t, state.SetAttribute(ctx, path.Root(EUMConfigSettingsFieldWebsiteID), types.StringValue("website-id")).HasError())
+	require.False(t, state.SetAttribute(ctx, path.Root(IPMaskingConfigFieldIPMasking), types.StringValue("STRICT")).HasError())
+
+	result, diags := handle.MapStateToDataObject(ctx, nil, state)
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, &instanaapi.IPMaskingConfiguration{ConfigID: "website-id", IPMasking: instanaapi.IPMaskingStrict}, result)
+
+	result.IPMasking = instanaapi.IPMaskingRemoveAllDetails
+	require.False(t, handle.UpdateState(ctx, state, nil, result).HasError())
+	assertStringAttribute(t, state, EUMConfigSettingsFieldID, "website-id")
+	assertStringAttribute(t, state, IPMaskingConfigFieldIPMasking, "REMOVE_ALL_DETAILS")
+}
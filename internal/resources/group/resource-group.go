@@ -17,6 +17,9 @@ import (
 	"github.com/instana/instana-go-client/client"
 	"github.com/instana/instana-go-client/shared/rest"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/applicationconfig"
+	"github.com/instana/terraform-provider-instana/internal/resources/mobileappconfig"
+	"github.com/instana/terraform-provider-instana/internal/resources/websitemonitoringconfig"
 	"github.com/instana/terraform-provider-instana/internal/util"
 )
 
@@ -24,9 +27,15 @@ import (
 func NewGroupResourceHandle() resourcehandle.ResourceHandle[*api.Group] {
 	return &groupResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName:  ResourceInstanaGroup,
-			Schema:        buildGroupSchema(),
-			SchemaVersion: 2,
+			ResourceName:        ResourceInstanaGroup,
+			ImportNameAttribute: GroupFieldName,
+			Schema:              buildGroupSchema(),
+			SchemaVersion:       2,
+			ReferenceAttributes: map[string]string{
+				GroupFieldPermissionSetApplicationIDs: applicationconfig.ResourceInstanaApplicationConfig,
+				GroupFieldPermissionSetMobileAppIDs:   mobileappconfig.ResourceInstanaMobileAppConfig,
+				GroupFieldPermissionSetWebsiteIDs:     websitemonitoringconfig.ResourceInstanaWebsiteMonitoringConfig,
+			},
 		},
 	}
 }
@@ -8,6 +8,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/instana/instana-go-client/api"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/applicationconfig"
+	"github.com/instana/terraform-provider-instana/internal/resources/mobileappconfig"
+	"github.com/instana/terraform-provider-instana/internal/resources/websitemonitoringconfig"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -21,6 +24,11 @@ func TestNewGroupResourceHandle(t *testing.T) {
 	assert.Equal(t, ResourceInstanaGroup, metadata.ResourceName)
 	assert.Equal(t, int64(2), metadata.SchemaVersion)
 	assert.NotNil(t, metadata.Schema)
+	assert.Equal(t, map[string]string{
+		GroupFieldPermissionSetApplicationIDs: applicationconfig.ResourceInstanaApplicationConfig,
+		GroupFieldPermissionSetMobileAppIDs:   mobileappconfig.ResourceInstanaMobileAppConfig,
+		GroupFieldPermissionSetWebsiteIDs:     websitemonitoringconfig.ResourceInstanaWebsiteMonitoringConfig,
+	}, metadata.ReferenceAttributes)
 }
 
 func TestMetaData(t *testing.T) {
@@ -14,6 +14,8 @@ import (
 	"github.com/instana/instana-go-client/client"
 	"github.com/instana/instana-go-client/shared/rest"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/group"
+	"github.com/instana/terraform-provider-instana/internal/resources/team"
 	"github.com/instana/terraform-provider-instana/internal/util"
 )
 
@@ -24,6 +26,10 @@ func NewGroupMappingResourceHandle() resourcehandle.ResourceHandle[*api.GroupMap
 			ResourceName:  ResourceInstanaGroupMapping,
 			Schema:        buildGroupMappingSchema(),
 			SchemaVersion: 0,
+			ReferenceAttributes: map[string]string{
+				GroupMappingFieldGroupID: group.ResourceInstanaGroup,
+				GroupMappingFieldTeamID:  team.ResourceInstanaTeam,
+			},
 		},
 	}
 }
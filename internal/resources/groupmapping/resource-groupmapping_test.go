@@ -9,6 +9,8 @@ import (
 	"github.com/instana/instana-go-client/api"
 	"github.com/instana/instana-go-client/shared/rest"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/group"
+	"github.com/instana/terraform-provider-instana/internal/resources/team"
 	"github.com/instana/terraform-provider-instana/testutils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -25,6 +27,10 @@ func TestNewGroupMappingResourceHandle(t *testing.T) {
 		require.NotNil(t, metadata)
 		assert.Equal(t, ResourceInstanaGroupMapping, metadata.ResourceName)
 		assert.Equal(t, int64(0), metadata.SchemaVersion)
+		assert.Equal(t, map[string]string{
+			GroupMappingFieldGroupID: group.ResourceInstanaGroup,
+			GroupMappingFieldTeamID:  team.ResourceInstanaTeam,
+		}, metadata.ReferenceAttributes)
 	})
 
 	t.Run("should have correct schema attributes", func(t *testing.T) {
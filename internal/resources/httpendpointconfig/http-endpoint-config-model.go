@@ -0,0 +1,25 @@
+package httpendpointconfig
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// HTTPEndpointConfigModel represents the data model for the HTTP endpoint configuration of a service
+type HTTPEndpointConfigModel struct {
+	ID                                             types.String            `tfsdk:"id"`
+	ServiceID                                      types.String            `tfsdk:"service_id"`
+	EndpointNameByFirstPathSegmentRuleEnabled      types.Bool              `tfsdk:"endpoint_name_by_first_path_segment_rule_enabled"`
+	EndpointNameByCollectedPathTemplateRuleEnabled types.Bool              `tfsdk:"endpoint_name_by_collected_path_template_rule_enabled"`
+	Rules                                          []HTTPEndpointRuleModel `tfsdk:"rules"`
+}
+
+// HTTPEndpointRuleModel represents a single endpoint naming rule
+type HTTPEndpointRuleModel struct {
+	Enabled      types.Bool             `tfsdk:"enabled"`
+	PathSegments []HTTPPathSegmentModel `tfsdk:"path_segments"`
+	TestCases    types.List             `tfsdk:"test_cases"`
+}
+
+// HTTPPathSegmentModel represents the matching rule of a single path segment
+type HTTPPathSegmentModel struct {
+	Type types.String `tfsdk:"type"`
+	Name types.String `tfsdk:"name"`
+}
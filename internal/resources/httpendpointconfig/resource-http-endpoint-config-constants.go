@@ -0,0 +1,50 @@
+package httpendpointconfig
+
+// ResourceInstanaHTTPEndpointConfig the name of the terraform-provider-instana resource to manage HTTP endpoint configurations
+const ResourceInstanaHTTPEndpointConfig = "http_endpoint_config"
+
+// Description constants for HTTP Endpoint Config resource
+const (
+	HTTPEndpointConfigDescResource                                       = "This resource manages the HTTP endpoint configuration of a service in Instana. The configuration defines the rules how HTTP calls of the service are grouped into endpoints."
+	HTTPEndpointConfigDescID                                             = "The ID of the HTTP endpoint configuration. Equal to the service_id."
+	HTTPEndpointConfigDescServiceID                                      = "The ID of the service the HTTP endpoint configuration belongs to."
+	HTTPEndpointConfigDescEndpointNameByFirstPathSegmentRuleEnabled      = "Flag indicating if endpoints should be named by the first path segment when no rule matches."
+	HTTPEndpointConfigDescEndpointNameByCollectedPathTemplateRuleEnabled = "Flag indicating if endpoints should be named by the path template collected by the tracer (e.g. from the web framework) when available."
+	HTTPEndpointConfigDescRules                                          = "The ordered list of rules to derive endpoint names from the path of HTTP calls. The first matching rule wins."
+	HTTPEndpointConfigDescRuleEnabled                                    = "Flag indicating if the rule is enabled."
+	HTTPEndpointConfigDescRulePathSegments                               = "The ordered list of matching rules for the path segments of the HTTP call (1 to 16 items)."
+	HTTPEndpointConfigDescRulePathSegmentType                            = "The type of the path segment matching rule. Supported values: FIXED (segment must equal name), PARAMETER (any segment, exposed as path parameter name) and MATCH_ALL (all remaining segments)."
+	HTTPEndpointConfigDescRulePathSegmentName                            = "The name of the path segment. Required for the types FIXED and PARAMETER, not allowed for MATCH_ALL."
+	HTTPEndpointConfigDescRuleTestCases                                  = "Example paths which are expected to match the rule (max. 32)."
+)
+
+// Error message constants
+const (
+	HTTPEndpointConfigErrInvalidPathSegment        = "Invalid path segment"
+	HTTPEndpointConfigErrNameRequired              = "name is required for path segments of type %s (rule %d, segment %d)"
+	HTTPEndpointConfigErrNameNotAllowedForMatchAll = "name is not allowed for path segments of type MATCH_ALL (rule %d, segment %d)"
+)
+
+// Field name constants
+const (
+	//HTTPEndpointConfigFieldID field name for id
+	HTTPEndpointConfigFieldID = "id"
+	//HTTPEndpointConfigFieldServiceID field name for service_id
+	HTTPEndpointConfigFieldServiceID = "service_id"
+	//HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled field name for endpoint_name_by_first_path_segment_rule_enabled
+	HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled = "endpoint_name_by_first_path_segment_rule_enabled"
+	//HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled field name for endpoint_name_by_collected_path_template_rule_enabled
+	HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled = "endpoint_name_by_collected_path_template_rule_enabled"
+	//HTTPEndpointConfigFieldRules field name for rules
+	HTTPEndpointConfigFieldRules = "rules"
+	//HTTPEndpointConfigFieldRuleEnabled field name for rules.enabled
+	HTTPEndpointConfigFieldRuleEnabled = "enabled"
+	//HTTPEndpointConfigFieldRulePathSegments field name for rules.path_segments
+	HTTPEndpointConfigFieldRulePathSegments = "path_segments"
+	//HTTPEndpointConfigFieldRulePathSegmentType field name for rules.path_segments.type
+	HTTPEndpointConfigFieldRulePathSegmentType = "type"
+	//HTTPEndpointConfigFieldRulePathSegmentName field name for rules.path_segments.name
+	HTTPEndpointConfigFieldRulePathSegmentName = "name"
+	//HTTPEndpointConfigFieldRuleTestCases field name for rules.test_cases
+	HTTPEndpointConfigFieldRuleTestCases = "test_cases"
+)
@@ -0,0 +1,276 @@
+package httpendpointconfig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/util"
+)
+
+// ============================================================================
+// Resource Factory
+// ============================================================================
+
+// NewHTTPEndpointConfigResourceHandle creates the resource handle for HTTP endpoint configurations
+func NewHTTPEndpointConfigResourceHandle() resourcehandle.ResourceHandle[*instanaapi.HTTPEndpointConfig] {
+	serviceIDField := HTTPEndpointConfigFieldServiceID
+	return &httpEndpointConfigResource{
+		metaData: resourcehandle.ResourceMetaData{
+			ResourceName: ResourceInstanaHTTPEndpointConfig,
+			Schema: schema.Schema{
+				Description: HTTPEndpointConfigDescResource,
+				Attributes: map[string]schema.Attribute{
+					HTTPEndpointConfigFieldID: schema.StringAttribute{
+						Computed:    true,
+						Description: HTTPEndpointConfigDescID,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					HTTPEndpointConfigFieldServiceID: schema.StringAttribute{
+						Required:    true,
+						Description: HTTPEndpointConfigDescServiceID,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled: schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: HTTPEndpointConfigDescEndpointNameByFirstPathSegmentRuleEnabled,
+					},
+					HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled: schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: HTTPEndpointConfigDescEndpointNameByCollectedPathTemplateRuleEnabled,
+					},
+					HTTPEndpointConfigFieldRules: schema.ListNestedAttribute{
+						Optional:    true,
+						Description: HTTPEndpointConfigDescRules,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(500),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								HTTPEndpointConfigFieldRuleEnabled: schema.BoolAttribute{
+									Optional:    true,
+									Computed:    true,
+									Default:     booldefault.StaticBool(true),
+									Description: HTTPEndpointConfigDescRuleEnabled,
+								},
+								HTTPEndpointConfigFieldRulePathSegments: schema.ListNestedAttribute{
+									Required:    true,
+									Description: HTTPEndpointConfigDescRulePathSegments,
+									Validators: []validator.List{
+										listvalidator.SizeBetween(1, 16),
+									},
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											HTTPEndpointConfigFieldRulePathSegmentType: schema.StringAttribute{
+												Required:    true,
+												Description: HTTPEndpointConfigDescRulePathSegmentType,
+												Validators: []validator.String{
+													stringvalidator.OneOf(instanaapi.SupportedHTTPPathSegmentMatchingTypes...),
+												},
+											},
+											HTTPEndpointConfigFieldRulePathSegmentName: schema.StringAttribute{
+												Optional:    true,
+												Description: HTTPEndpointConfigDescRulePathSegmentName,
+											},
+										},
+									},
+								},
+								HTTPEndpointConfigFieldRuleTestCases: schema.ListAttribute{
+									Optional:    true,
+									ElementType: types.StringType,
+									Description: HTTPEndpointConfigDescRuleTestCases,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(32),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			ResourceIDField:  &serviceIDField,
+		},
+	}
+}
+
+// ============================================================================
+// Resource Implementation
+// ============================================================================
+
+type httpEndpointConfigResource struct {
+	metaData resourcehandle.ResourceMetaData
+}
+
+// MetaData returns the resource metadata
+func (r *httpEndpointConfigResource) MetaData() *resourcehandle.ResourceMetaData {
+	return &r.metaData
+}
+
+// GetRestResource returns the REST resource for HTTP endpoint configurations
+func (r *httpEndpointConfigResource) GetRestResource(api client.InstanaAPI) rest.RestResource[*instanaapi.HTTPEndpointConfig] {
+	return instanaapi.From(api).HTTPEndpointConfigs()
+}
+
+// SetComputedFields sets computed fields in the plan (none for this resource)
+func (r *httpEndpointConfigResource) SetComputedFields(_ context.Context, _ *tfsdk.Plan) diag.Diagnostics {
+	return nil
+}
+
+// ============================================================================
+// API to State Mapping
+// ============================================================================
+
+// UpdateState converts API data object to Terraform state
+func (r *httpEndpointConfigResource) UpdateState(ctx context.Context, state *tfsdk.State, _ *tfsdk.Plan, config *instanaapi.HTTPEndpointConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	rules, rulesDiags := r.mapRulesToState(ctx, config.Rules)
+	diags.Append(rulesDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	model := HTTPEndpointConfigModel{
+		ID:        types.StringValue(config.ServiceID),
+		ServiceID: types.StringValue(config.ServiceID),
+		EndpointNameByFirstPathSegmentRuleEnabled:      types.BoolValue(config.EndpointNameByFirstPathSegmentRuleEnabled),
+		EndpointNameByCollectedPathTemplateRuleEnabled: types.BoolValue(config.EndpointNameByCollectedPathTemplateRuleEnabled),
+		Rules: rules,
+	}
+
+	diags.Append(state.Set(ctx, model)...)
+	return diags
+}
+
+// mapRulesToState converts the endpoint rules from API to state format
+func (r *httpEndpointConfigResource) mapRulesToState(ctx context.Context, rules []instanaapi.HTTPEndpointRule) ([]HTTPEndpointRuleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if len(rules) == 0 {
+		return nil, diags
+	}
+
+	result := make([]HTTPEndpointRuleModel, len(rules))
+	for i, rule := range rules {
+		segments := make([]HTTPPathSegmentModel, len(rule.PathSegments))
+		for j, segment := range rule.PathSegments {
+			segments[j] = HTTPPathSegmentModel{
+				Type: types.StringValue(string(segment.Type)),
+				Name: util.SetStringPointerToState(segment.Name),
+			}
+		}
+
+		testCases := types.ListNull(types.StringType)
+		if len(rule.TestCases) > 0 {
+			var listDiags diag.Diagnostics
+			testCases, listDiags = types.ListValueFrom(ctx, types.StringType, rule.TestCases)
+			diags.Append(listDiags...)
+		}
+
+		result[i] = HTTPEndpointRuleModel{
+			Enabled:      types.BoolValue(rule.Enabled),
+			PathSegments: segments,
+			TestCases:    testCases,
+		}
+	}
+	return result, diags
+}
+
+// ============================================================================
+// State to API Mapping
+// ============================================================================
+
+// MapStateToDataObject converts Terraform state to API data object
+func (r *httpEndpointConfigResource) MapStateToDataObject(ctx context.Context, plan *tfsdk.Plan, state *tfsdk.State) (*instanaapi.HTTPEndpointConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var model HTTPEndpointConfigModel
+
+	if plan != nil {
+		diags.Append(plan.Get(ctx, &model)...)
+	} else if state != nil {
+		diags.Append(state.Get(ctx, &model)...)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	rules, rulesDiags := r.mapRulesFromState(ctx, model.Rules)
+	diags.Append(rulesDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &instanaapi.HTTPEndpointConfig{
+		ServiceID: model.ServiceID.ValueString(),
+		EndpointNameByFirstPathSegmentRuleEnabled:      model.EndpointNameByFirstPathSegmentRuleEnabled.ValueBool(),
+		EndpointNameByCollectedPathTemplateRuleEnabled: model.EndpointNameByCollectedPathTemplateRuleEnabled.ValueBool(),
+		Rules: rules,
+	}, diags
+}
+
+// mapRulesFromState converts the endpoint rules from state to API format and validates the path segments
+func (r *httpEndpointConfigResource) mapRulesFromState(ctx context.Context, rules []HTTPEndpointRuleModel) ([]instanaapi.HTTPEndpointRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := make([]instanaapi.HTTPEndpointRule, len(rules))
+	for i, rule := range rules {
+		segments := make([]instanaapi.HTTPPathSegmentMatchingRule, len(rule.PathSegments))
+		for j, segment := range rule.PathSegments {
+			segmentType := instanaapi.HTTPPathSegmentMatchingType(segment.Type.ValueString())
+			name := util.SetStringPointerFromState(segment.Name)
+			if segmentType == instanaapi.HTTPPathSegmentMatchingTypeMatchAll && name != nil {
+				diags.AddError(HTTPEndpointConfigErrInvalidPathSegment, fmt.Sprintf(HTTPEndpointConfigErrNameNotAllowedForMatchAll, i, j))
+			} else if segmentType != instanaapi.HTTPPathSegmentMatchingTypeMatchAll && (name == nil || *name == "") {
+				diags.AddError(HTTPEndpointConfigErrInvalidPathSegment, fmt.Sprintf(HTTPEndpointConfigErrNameRequired, segmentType, i, j))
+			}
+			segments[j] = instanaapi.HTTPPathSegmentMatchingRule{
+				Type: segmentType,
+				Name: name,
+			}
+		}
+
+		var testCases []string
+		if !rule.TestCases.IsNull() && !rule.TestCases.IsUnknown() {
+			diags.Append(rule.TestCases.ElementsAs(ctx, &testCases, false)...)
+		}
+
+		result[i] = instanaapi.HTTPEndpointRule{
+			Enabled:      rule.Enabled.ValueBool(),
+			PathSegments: segments,
+			TestCases:    testCases,
+		}
+	}
+	return result, diags
+}
+
+// GetStateUpgraders returns the state upgraders for this resource (none needed for v0)
+func (r *httpEndpointConfigResource) GetStateUpgraders(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
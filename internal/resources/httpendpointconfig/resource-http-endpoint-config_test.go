@@ -0,0 +1,188 @@
+package httpendpointconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testServiceID = "service-id"
+
+func strPtr(s string) *string { return &s }
+
+func TestNewHTTPEndpointConfigResourceHandle(t *testing.T) {
+	t.Run("should create resource handle with correct metadata", func(t *testing.T) {
+		handle := NewHTTPEndpointConfigResourceHandle()
+
+		require.NotNil(t, handle)
+		metadata := handle.MetaData()
+		assert.Equal(t, ResourceInstanaHTTPEndpointConfig, metadata.ResourceName)
+		assert.Equal(t, int64(0), metadata.SchemaVersion)
+		assert.True(t, metadata.SkipIDGeneration)
+		require.NotNil(t, metadata.ResourceIDField)
+		assert.Equal(t, HTTPEndpointConfigFieldServiceID, *metadata.ResourceIDField)
+	})
+
+	t.Run("should have correct schema attributes", func(t *testing.T) {
+		s := NewHTTPEndpointConfigResourceHandle().MetaData().Schema
+
+		assert.True(t, s.Attributes[HTTPEndpointConfigFieldID].IsComputed())
+		assert.True(t, s.Attributes[HTTPEndpointConfigFieldServiceID].IsRequired())
+		assert.True(t, s.Attributes[HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled].IsOptional())
+		assert.True(t, s.Attributes[HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled].IsOptional())
+		assert.True(t, s.Attributes[HTTPEndpointConfigFieldRules].IsOptional())
+	})
+
+	t.Run("should return no state upgraders", func(t *testing.T) {
+		assert.Empty(t, NewHTTPEndpointConfigResourceHandle().GetStateUpgraders(context.Background()))
+	})
+}
+
+type mockHTTPEndpointConfigAPI struct {
+	testutils.MockInstanaAPI
+	restResource rest.RestResource[*instanaapi.HTTPEndpointConfig]
+}
+
+func (m *mockHTTPEndpointConfigAPI) HTTPEndpointConfigs() rest.RestResource[*instanaapi.HTTPEndpointConfig] {
+	return m.restResource
+}
+
+func TestHTTPEndpointConfigGetRestResource(t *testing.T) {
+	restResource := instanaapi.NewRestResource[*instanaapi.HTTPEndpointConfig](instanaapi.HTTPEndpointConfigsResourcePath, instanaapi.CreateModePOST, instanaapi.UpdateModePUT, nil)
+
+	result := NewHTTPEndpointConfigResourceHandle().GetRestResource(&mockHTTPEndpointConfigAPI{restResource: restResource})
+
+	assert.Same(t, restResource, result)
+}
+
+func newFullModel() HTTPEndpointConfigModel {
+	return HTTPEndpointConfigModel{
+		ID:        types.StringValue(testServiceID),
+		ServiceID: types.StringValue(testServiceID),
+		EndpointNameByFirstPathSegmentRuleEnabled:      types.BoolValue(true),
+		EndpointNameByCollectedPathTemplateRuleEnabled: types.BoolValue(false),
+		Rules: []HTTPEndpointRuleModel{
+			{
+				Enabled: types.BoolValue(true),
+				PathSegments: []HTTPPathSegmentModel{
+					{Type: types.StringValue("FIXED"), Name: types.StringValue("api")},
+					{Type: types.StringValue("PARAMETER"), Name: types.StringValue("version")},
+					{Type: types.StringValue("MATCH_ALL"), Name: types.StringNull()},
+				},
+				TestCases: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("/api/v2/users")}),
+			},
+		},
+	}
+}
+
+func newFullAPIObject() *instanaapi.HTTPEndpointConfig {
+	return &instanaapi.HTTPEndpointConfig{
+		ServiceID: testServiceID,
+		EndpointNameByFirstPathSegmentRuleEnabled:      true,
+		EndpointNameByCollectedPathTemplateRuleEnabled: false,
+		Rules: []instanaapi.HTTPEndpointRule{
+			{
+				Enabled: true,
+				PathSegments: []instanaapi.HTTPPathSegmentMatchingRule{
+					{Type: instanaapi.HTTPPathSegmentMatchingTypeFixed, Name: strPtr("api")},
+					{Type: instanaapi.HTTPPathSegmentMatchingTypeParameter, Name: strPtr("version")},
+					{Type: instanaapi.HTTPPathSegmentMatchingTypeMatchAll},
+				},
+				TestCases: []string{"/api/v2/users"},
+			},
+		},
+	}
+}
+
+func TestHTTPEndpointConfigMapStateToDataObject(t *testing.T) {
+	ctx := context.Background()
+	handle := NewHTTPEndpointConfigResourceHandle()
+
+	t.Run("should map complete model from plan", func(t *testing.T) {
+		plan := &tfsdk.Plan{Schema: handle.MetaData().Schema}
+		require.False(t, plan.Set(ctx, newFullModel()).HasError())
+
+		result, diags := handle.MapStateToDataObject(ctx, plan, nil)
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, newFullAPIObject(), result)
+	})
+
+	t.Run("should map model without rules to empty rule list", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+		require.False(t, state.Set(ctx, HTTPEndpointConfigModel{
+			ID:        types.StringValue(testServiceID),
+			ServiceID: types.StringValue(testServiceID),
+			EndpointNameByFirstPathSegmentRuleEnabled:      types.BoolValue(false),
+			EndpointNameByCollectedPathTemplateRuleEnabled: types.BoolValue(true),
+		}).HasError())
+
+		result, diags := handle.MapStateToDataObject(ctx, nil, state)
+
+		require.False(t, diags.HasError())
+		assert.NotNil(t, result.Rules)
+		assert.Empty(t, result.Rules)
+		assert.True(t, result.EndpointNameByCollectedPathTemplateRuleEnabled)
+	})
+
+	t.Run("should fail when name is missing for fixed segment", func(t *testing.T) {
+		model := newFullModel()
+		model.Rules[0].PathSegments[0].Name = types.StringNull()
+		plan := &tfsdk.Plan{Schema: handle.MetaData().Schema}
+		require.False(t, plan.Set(ctx, model).HasError())
+
+		_, diags := handle.MapStateToDataObject(ctx, plan, nil)
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, HTTPEndpointConfigErrInvalidPathSegment, diags[0].Summary())
+		assert.Contains(t, diags[0].Detail(), "FIXED")
+	})
+
+	t.Run("should fail when name is set for match all segment", func(t *testing.T) {
+		model := newFullModel()
+		model.Rules[0].PathSegments[2].Name = types.StringValue("rest")
+		plan := &tfsdk.Plan{Schema: handle.MetaData().Schema}
+		require.False(t, plan.Set(ctx, model).HasError())
+
+		_, diags := handle.MapStateToDataObject(ctx, plan, nil)
+
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Detail(), "MATCH_ALL")
+	})
+}
+
+func TestHTTPEndpointConfigUpdateState(t *testing.T) {
+	ctx := context.Background()
+	handle := NewHTTPEndpointConfigResourceHandle()
+
+	t.Run("should map complete API object to state", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+
+		diags := handle.UpdateState(ctx, state, nil, newFullAPIObject())
+
+		require.False(t, diags.HasError())
+		var model HTTPEndpointConfigModel
+		require.False(t, state.Get(ctx, &model).HasError())
+		assert.Equal(t, newFullModel(), model)
+	})
+
+	t.Run("should map rules without test cases and empty rule list to null", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+
+		diags := handle.UpdateState(ctx, state, nil, &instanaapi.HTTPEndpointConfig{ServiceID: testServiceID, Rules: []instanaapi.HTTPEndpointRule{}})
+
+		require.False(t, diags.HasError())
+		var model HTTPEndpointConfigModel
+		require.False(t, state.Get(ctx, &model).HasError())
+		assert.Nil(t, model.Rules)
+		assert.Equal(t, testServiceID, model.ID.ValueString())
+	})
+}
@@ -10,7 +10,7 @@ type InfraAlertConfigModel struct {
 	ID                 types.String             `tfsdk:"id"`
 	Name               types.String             `tfsdk:"name"`
 	Description        types.String             `tfsdk:"description"`
-	TagFilter          types.String             `tfsdk:"tag_filter"`
+	TagFilter          shared.TagFilterValue    `tfsdk:"tag_filter"`
 	GroupBy            types.Set                `tfsdk:"group_by"`
 	AlertChannels      *InfraAlertChannelsModel `tfsdk:"alert_channels"`
 	Granularity        types.Int64              `tfsdk:"granularity"`
@@ -20,6 +20,7 @@ type InfraAlertConfigModel struct {
 	Rules              *InfraRulesModel         `tfsdk:"rules"`
 	EvaluationType     types.String             `tfsdk:"evaluation_type"`
 	Triggering         types.Bool               `tfsdk:"triggering"`
+	Enabled            types.Bool               `tfsdk:"enabled"`
 }
 
 // InfraAlertChannelsModel represents the alert channels model
@@ -23,6 +23,8 @@ const (
 	InfraAlertConfigFieldEvaluationType = "evaluation_type"
 	// InfraAlertConfigFieldTriggering constant value for the schema field triggering
 	InfraAlertConfigFieldTriggering = "triggering"
+	// InfraAlertConfigFieldEnabled constant value for the schema field enabled
+	InfraAlertConfigFieldEnabled = "enabled"
 	// InfraAlertConfigFieldCustomPayloadField constant value for the schema field custom_payload_field
 	InfraAlertConfigFieldCustomPayloadField = "custom_payload_field"
 	// InfraAlertConfigFieldAlertChannels constant value for the schema field alert_channels
@@ -85,6 +87,8 @@ const (
 	InfraAlertConfigDescEvaluationType = "The evaluation type of the infrastructure alert configuration"
 	// InfraAlertConfigDescTriggering description for the triggering field
 	InfraAlertConfigDescTriggering = "Indicates whether the alert should be triggered"
+	// InfraAlertConfigDescEnabled description for the enabled field
+	InfraAlertConfigDescEnabled = "Flag to enable or disable the alert configuration. Changes are applied via the enable and disable endpoints without a full update."
 	// InfraAlertConfigDescRules description for the rules field
 	InfraAlertConfigDescRules = "The rules configuration"
 	// InfraAlertConfigDescGenericRule description for the generic_rule field
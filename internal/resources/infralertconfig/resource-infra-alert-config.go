@@ -22,19 +22,24 @@ import (
 	"github.com/instana/instana-go-client/shared/rest"
 	tag "github.com/instana/instana-go-client/shared/tagfilter"
 	common "github.com/instana/instana-go-client/shared/types"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/alertingchannel"
 	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
-	"github.com/instana/terraform-provider-instana/internal/util"
 )
 
 // NewInfraAlertConfigResourceHandle creates a new instance of the infrastructure alert configuration resource
 func NewInfraAlertConfigResourceHandle() resourcehandle.ResourceHandle[*api.InfraAlertConfig] {
 	return &infraAlertConfigResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName:  ResourceInstanaInfraAlertConfig,
-			Schema:        buildInfraAlertConfigSchema(),
-			SchemaVersion: 2,
+			ResourceName:        ResourceInstanaInfraAlertConfig,
+			ImportNameAttribute: InfraAlertConfigFieldName,
+			Schema:              buildInfraAlertConfigSchema(),
+			SchemaVersion:       2,
+			ReferenceAttributes: map[string]string{
+				InfraAlertConfigFieldAlertChannels: alertingchannel.ResourceInstanaAlertingChannel,
+			},
 		},
 	}
 }
@@ -62,6 +67,13 @@ func buildInfraAlertConfigSchema() schema.Schema {
 			InfraAlertConfigFieldTagFilter: schema.StringAttribute{
 				Description: InfraAlertConfigDescTagFilter,
 				Optional:    true,
+				CustomType:  shared.TagFilterType{},
+				Validators: []validator.String{
+					shared.TagFilterValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					shared.TagFilterPlanModifier(),
+				},
 			},
 			InfraAlertConfigFieldGroupBy: schema.SetAttribute{
 				Description: InfraAlertConfigDescGroupBy,
@@ -89,6 +101,12 @@ func buildInfraAlertConfigSchema() schema.Schema {
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			InfraAlertConfigFieldEnabled: schema.BoolAttribute{
+				Description: InfraAlertConfigDescEnabled,
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
 			InfraAlertConfigFieldCustomPayloadField: shared.GetCustomPayloadFieldsSchema(),
 			InfraAlertConfigFieldRules:              buildRulesSchema(),
 			InfraAlertConfigFieldAlertChannels:      buildAlertChannelsSchema(),
@@ -215,6 +233,21 @@ func (r *infraAlertConfigResource) GetRestResource(api client.InstanaAPI) rest.R
 	return api.InfraAlertConfigs()
 }
 
+// EnabledAttribute implementation of resourcehandle.EnabledStateToggler
+func (r *infraAlertConfigResource) EnabledAttribute() string {
+	return InfraAlertConfigFieldEnabled
+}
+
+// SetEnabled enables or disables the infrastructure alert configuration via the dedicated endpoints
+func (r *infraAlertConfigResource) SetEnabled(instanaAPI client.InstanaAPI, id string, enabled bool) error {
+	return instanaapi.From(instanaAPI).EnablementToggle(instanaapi.InfraAlertConfigsResourcePath).SetEnabled(id, enabled)
+}
+
+// IsEnabled reads the enabled flag of the infrastructure alert configuration from the Instana API
+func (r *infraAlertConfigResource) IsEnabled(instanaAPI client.InstanaAPI, config *api.InfraAlertConfig) (bool, error) {
+	return instanaapi.From(instanaAPI).EnablementToggle(instanaapi.InfraAlertConfigsResourcePath).IsEnabled(config.ID)
+}
+
 func (r *infraAlertConfigResource) SetComputedFields(_ context.Context, _ *tfsdk.Plan) diag.Diagnostics {
 	return nil
 }
@@ -276,11 +309,11 @@ func (r *infraAlertConfigResource) UpdateState(ctx context.Context, state *tfsdk
 }
 
 // mapTagFilterToModel converts API tag filter to model representation
-func (r *infraAlertConfigResource) mapTagFilterToModel(tagFilterExpression *tag.TagFilter) (types.String, diag.Diagnostics) {
+func (r *infraAlertConfigResource) mapTagFilterToModel(tagFilterExpression *tag.TagFilter) (shared.TagFilterValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	if tagFilterExpression == nil {
-		return types.StringNull(), diags
+		return shared.NewTagFilterNull(), diags
 	}
 
 	tagFilterString, err := tagfilter.MapTagFilterToNormalizedString(tagFilterExpression)
@@ -289,10 +322,10 @@ func (r *infraAlertConfigResource) mapTagFilterToModel(tagFilterExpression *tag.
 			InfraAlertConfigErrMappingTagFilter,
 			fmt.Sprintf(InfraAlertConfigErrMappingTagFilterMsg, err),
 		)
-		return types.StringNull(), diags
+		return shared.NewTagFilterNull(), diags
 	}
 
-	return util.SetStringPointerToState(tagFilterString), diags
+	return shared.NewTagFilterPointerValue(tagFilterString), diags
 }
 
 // mapGroupByToModel converts API group by slice to model representation
@@ -483,7 +516,7 @@ func (r *infraAlertConfigResource) extractConfigID(model InfraAlertConfigModel)
 }
 
 // mapModelTagFilterToAPI converts model tag filter to API representation
-func (r *infraAlertConfigResource) mapModelTagFilterToAPI(tagFilter types.String) (*tag.TagFilter, diag.Diagnostics) {
+func (r *infraAlertConfigResource) mapModelTagFilterToAPI(tagFilter shared.TagFilterValue) (*tag.TagFilter, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	if tagFilter.IsNull() || tagFilter.ValueString() == EmptyString {
@@ -117,7 +117,7 @@ func TestUpdateState_BasicConfig(t *testing.T) {
 		ID:                 types.StringNull(),
 		Name:               types.StringNull(),
 		Description:        types.StringNull(),
-		TagFilter:          types.StringNull(),
+		TagFilter:          shared.NewTagFilterNull(),
 		GroupBy:            types.SetNull(types.StringType),
 		Granularity:        types.Int64Null(),
 		EvaluationType:     types.StringNull(),
@@ -180,7 +180,7 @@ func TestUpdateState_WithTagFilter(t *testing.T) {
 		ID:                 types.StringNull(),
 		Name:               types.StringNull(),
 		Description:        types.StringNull(),
-		TagFilter:          types.StringNull(),
+		TagFilter:          shared.NewTagFilterNull(),
 		GroupBy:            types.SetNull(types.StringType),
 		Granularity:        types.Int64Null(),
 		EvaluationType:     types.StringNull(),
@@ -224,7 +224,7 @@ func TestUpdateState_WithGroupBy(t *testing.T) {
 		ID:                 types.StringNull(),
 		Name:               types.StringNull(),
 		Description:        types.StringNull(),
-		TagFilter:          types.StringNull(),
+		TagFilter:          shared.NewTagFilterNull(),
 		GroupBy:            types.SetNull(types.StringType),
 		Granularity:        types.Int64Null(),
 		EvaluationType:     types.StringNull(),
@@ -499,7 +499,7 @@ func TestMapStateToDataObject_BasicConfig(t *testing.T) {
 		Description:        types.StringValue("Test Description"),
 		Granularity:        types.Int64Value(600000),
 		EvaluationType:     types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:          types.StringNull(),
+		TagFilter:          shared.NewTagFilterNull(),
 		GroupBy:            types.SetNull(types.StringType),
 		CustomPayloadField: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
@@ -525,7 +525,7 @@ func TestMapStateToDataObject_WithTagFilter(t *testing.T) {
 		Description:        types.StringValue("Test Description"),
 		Granularity:        types.Int64Value(600000),
 		EvaluationType:     types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:          types.StringValue("entity.type EQUALS 'host'"),
+		TagFilter:          shared.NewTagFilterValue("entity.type EQUALS 'host'"),
 		GroupBy:            types.SetNull(types.StringType),
 		CustomPayloadField: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
@@ -546,7 +546,7 @@ func TestMapStateToDataObject_WithInvalidTagFilter(t *testing.T) {
 		Description:        types.StringValue("Test Description"),
 		Granularity:        types.Int64Value(600000),
 		EvaluationType:     types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:          types.StringValue("invalid tag filter syntax"),
+		TagFilter:          shared.NewTagFilterValue("invalid tag filter syntax"),
 		GroupBy:            types.SetNull(types.StringType),
 		CustomPayloadField: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
@@ -570,7 +570,7 @@ func TestMapStateToDataObject_WithGroupBy(t *testing.T) {
 		Description:        types.StringValue("Test Description"),
 		Granularity:        types.Int64Value(600000),
 		EvaluationType:     types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:          types.StringNull(),
+		TagFilter:          shared.NewTagFilterNull(),
 		GroupBy:            groupBySet,
 		CustomPayloadField: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
@@ -601,7 +601,7 @@ func TestMapStateToDataObject_WithAlertChannels(t *testing.T) {
 		Description:    types.StringValue("Test Description"),
 		Granularity:    types.Int64Value(600000),
 		EvaluationType: types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:      types.StringNull(),
+		TagFilter:      shared.NewTagFilterNull(),
 		GroupBy:        types.SetNull(types.StringType),
 		AlertChannels: &InfraAlertChannelsModel{
 			Warning:  warningSet,
@@ -628,7 +628,7 @@ func TestMapStateToDataObject_WithTimeThreshold(t *testing.T) {
 		Description:    types.StringValue("Test Description"),
 		Granularity:    types.Int64Value(600000),
 		EvaluationType: types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:      types.StringNull(),
+		TagFilter:      shared.NewTagFilterNull(),
 		GroupBy:        types.SetNull(types.StringType),
 		TimeThreshold: &InfraTimeThresholdModel{
 			ViolationsInSequence: &InfraViolationsInSequenceModel{
@@ -674,7 +674,7 @@ func TestMapStateToDataObject_WithCustomPayloadFields(t *testing.T) {
 		Description:        types.StringValue("Test Description"),
 		Granularity:        types.Int64Value(600000),
 		EvaluationType:     types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:          types.StringNull(),
+		TagFilter:          shared.NewTagFilterNull(),
 		GroupBy:            types.SetNull(types.StringType),
 		CustomPayloadField: customPayloadFields,
 	})
@@ -698,7 +698,7 @@ func TestMapStateToDataObject_WithRulesAndStaticThreshold(t *testing.T) {
 		Description:    types.StringValue("Test Description"),
 		Granularity:    types.Int64Value(600000),
 		EvaluationType: types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:      types.StringNull(),
+		TagFilter:      shared.NewTagFilterNull(),
 		GroupBy:        types.SetNull(types.StringType),
 		Rules: &InfraRulesModel{
 			GenericRule: &InfraGenericRuleModel{
@@ -751,7 +751,7 @@ func TestMapStateToDataObject_WithRulesAndAdaptiveBaselineThreshold(t *testing.T
 		Description:    types.StringValue("Test Description"),
 		Granularity:    types.Int64Value(600000),
 		EvaluationType: types.StringValue(string(api.EvaluationTypeCustom)),
-		TagFilter:      types.StringNull(),
+		TagFilter:      shared.NewTagFilterNull(),
 		GroupBy:        types.SetNull(types.StringType),
 		Rules: &InfraRulesModel{
 			GenericRule: &InfraGenericRuleModel{
@@ -802,7 +802,7 @@ func TestMapStateToDataObject_WithBothThresholds(t *testing.T) {
 		Description:    types.StringValue("Test Description"),
 		Granularity:    types.Int64Value(600000),
 		EvaluationType: types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:      types.StringNull(),
+		TagFilter:      shared.NewTagFilterNull(),
 		GroupBy:        types.SetNull(types.StringType),
 		Rules: &InfraRulesModel{
 			GenericRule: &InfraGenericRuleModel{
@@ -852,7 +852,7 @@ func TestMapStateToDataObject_WithEmptyTagFilter(t *testing.T) {
 		Description:        types.StringValue("Test Description"),
 		Granularity:        types.Int64Value(600000),
 		EvaluationType:     types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:          types.StringValue(""),
+		TagFilter:          shared.NewTagFilterValue(""),
 		GroupBy:            types.SetNull(types.StringType),
 		CustomPayloadField: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
@@ -873,7 +873,7 @@ func TestMapStateToDataObject_WithNullGranularity(t *testing.T) {
 		Description:        types.StringValue("Test Description"),
 		Granularity:        types.Int64Null(),
 		EvaluationType:     types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:          types.StringNull(),
+		TagFilter:          shared.NewTagFilterNull(),
 		GroupBy:            types.SetNull(types.StringType),
 		CustomPayloadField: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
@@ -898,7 +898,7 @@ func TestMapStateToDataObject_FromPlan(t *testing.T) {
 		Description:        types.StringValue("Test Description"),
 		Granularity:        types.Int64Value(600000),
 		EvaluationType:     types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:          types.StringNull(),
+		TagFilter:          shared.NewTagFilterNull(),
 		GroupBy:            types.SetNull(types.StringType),
 		CustomPayloadField: types.ListNull(shared.GetCustomPayloadFieldType()),
 	}
@@ -1081,7 +1081,7 @@ func TestMapStateToDataObject_WithNullTimeThreshold(t *testing.T) {
 		Description:        types.StringValue("Test Description"),
 		Granularity:        types.Int64Value(600000),
 		EvaluationType:     types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:          types.StringNull(),
+		TagFilter:          shared.NewTagFilterNull(),
 		GroupBy:            types.SetNull(types.StringType),
 		TimeThreshold:      nil,
 		CustomPayloadField: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -1103,7 +1103,7 @@ func TestMapStateToDataObject_WithNullAlertChannels(t *testing.T) {
 		Description:        types.StringValue("Test Description"),
 		Granularity:        types.Int64Value(600000),
 		EvaluationType:     types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:          types.StringNull(),
+		TagFilter:          shared.NewTagFilterNull(),
 		GroupBy:            types.SetNull(types.StringType),
 		AlertChannels:      nil,
 		CustomPayloadField: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -1125,7 +1125,7 @@ func TestMapStateToDataObject_WithNullRules(t *testing.T) {
 		Description:        types.StringValue("Test Description"),
 		Granularity:        types.Int64Value(600000),
 		EvaluationType:     types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:          types.StringNull(),
+		TagFilter:          shared.NewTagFilterNull(),
 		GroupBy:            types.SetNull(types.StringType),
 		Rules:              nil,
 		CustomPayloadField: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -1219,7 +1219,7 @@ func TestMapStateToDataObject_WithOnlyWarningAlertChannel(t *testing.T) {
 		Description:    types.StringValue("Test Description"),
 		Granularity:    types.Int64Value(600000),
 		EvaluationType: types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:      types.StringNull(),
+		TagFilter:      shared.NewTagFilterNull(),
 		GroupBy:        types.SetNull(types.StringType),
 		AlertChannels: &InfraAlertChannelsModel{
 			Warning:  warningSet,
@@ -1255,7 +1255,7 @@ func TestMapStateToDataObject_AllEvaluationTypes(t *testing.T) {
 				Description:        types.StringValue("Test Description"),
 				Granularity:        types.Int64Value(600000),
 				EvaluationType:     types.StringValue(string(evalType)),
-				TagFilter:          types.StringNull(),
+				TagFilter:          shared.NewTagFilterNull(),
 				GroupBy:            types.SetNull(types.StringType),
 				CustomPayloadField: types.ListNull(shared.GetCustomPayloadFieldType()),
 			})
@@ -1309,7 +1309,7 @@ func initializeEmptyState(ctx context.Context, t *testing.T, state *tfsdk.State)
 		ID:                 types.StringNull(),
 		Name:               types.StringNull(),
 		Description:        types.StringNull(),
-		TagFilter:          types.StringNull(),
+		TagFilter:          shared.NewTagFilterNull(),
 		GroupBy:            types.SetNull(types.StringType),
 		Granularity:        types.Int64Null(),
 		EvaluationType:     types.StringNull(),
@@ -1428,7 +1428,7 @@ func TestMapStateToDataObject_WithEmptyCustomPayloadFields(t *testing.T) {
 		Description:        types.StringValue("Test Description"),
 		Granularity:        types.Int64Value(600000),
 		EvaluationType:     types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:          types.StringNull(),
+		TagFilter:          shared.NewTagFilterNull(),
 		GroupBy:            types.SetNull(types.StringType),
 		CustomPayloadField: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
@@ -1449,7 +1449,7 @@ func TestMapStateToDataObject_WithNullID(t *testing.T) {
 		Description:        types.StringValue("Test Description"),
 		Granularity:        types.Int64Value(600000),
 		EvaluationType:     types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:          types.StringNull(),
+		TagFilter:          shared.NewTagFilterNull(),
 		GroupBy:            types.SetNull(types.StringType),
 		CustomPayloadField: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
@@ -1513,7 +1513,7 @@ func TestMapStateToDataObject_WithOnlyCriticalAlertChannel(t *testing.T) {
 		Description:    types.StringValue("Test Description"),
 		Granularity:    types.Int64Value(600000),
 		EvaluationType: types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:      types.StringNull(),
+		TagFilter:      shared.NewTagFilterNull(),
 		GroupBy:        types.SetNull(types.StringType),
 		AlertChannels: &InfraAlertChannelsModel{
 			Warning:  types.SetNull(types.StringType),
@@ -1578,7 +1578,7 @@ func TestMapStateToDataObject_WithEmptyAlertChannelLists(t *testing.T) {
 		Description:    types.StringValue("Test Description"),
 		Granularity:    types.Int64Value(600000),
 		EvaluationType: types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:      types.StringNull(),
+		TagFilter:      shared.NewTagFilterNull(),
 		GroupBy:        types.SetNull(types.StringType),
 		AlertChannels: &InfraAlertChannelsModel{
 			Warning:  warningSet,
@@ -1637,7 +1637,7 @@ func TestMapStateToDataObject_WithNullTimeWindowInTimeThreshold(t *testing.T) {
 		Description:    types.StringValue("Test Description"),
 		Granularity:    types.Int64Value(600000),
 		EvaluationType: types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:      types.StringNull(),
+		TagFilter:      shared.NewTagFilterNull(),
 		GroupBy:        types.SetNull(types.StringType),
 		TimeThreshold: &InfraTimeThresholdModel{
 			ViolationsInSequence: &InfraViolationsInSequenceModel{
@@ -1700,7 +1700,7 @@ func TestMapStateToDataObject_WithRulesButNoThresholds(t *testing.T) {
 		Description:    types.StringValue("Test Description"),
 		Granularity:    types.Int64Value(600000),
 		EvaluationType: types.StringValue(string(api.EvaluationTypePerEntity)),
-		TagFilter:      types.StringNull(),
+		TagFilter:      shared.NewTagFilterNull(),
 		GroupBy:        types.SetNull(types.StringType),
 		Rules: &InfraRulesModel{
 			GenericRule: &InfraGenericRuleModel{
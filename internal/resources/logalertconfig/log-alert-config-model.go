@@ -7,17 +7,17 @@ import (
 
 // LogAlertConfigModel represents the data model for the log alert configuration resource
 type LogAlertConfigModel struct {
-	ID                  types.String        `tfsdk:"id"`
-	Name                types.String        `tfsdk:"name"`
-	Description         types.String        `tfsdk:"description"`
-	AlertChannels       *AlertChannelsModel `tfsdk:"alert_channels"`
-	GracePeriod         types.Int64         `tfsdk:"grace_period"`
-	GroupBy             []GroupByModel      `tfsdk:"group_by"`
-	Granularity         types.Int64         `tfsdk:"granularity"`
-	TagFilter           types.String        `tfsdk:"tag_filter"`
-	Rules               *LogAlertRuleModel  `tfsdk:"rules"`
-	TimeThreshold       *TimeThresholdModel `tfsdk:"time_threshold"`
-	CustomPayloadFields types.List          `tfsdk:"custom_payload_field"`
+	ID                  types.String          `tfsdk:"id"`
+	Name                types.String          `tfsdk:"name"`
+	Description         types.String          `tfsdk:"description"`
+	AlertChannels       *AlertChannelsModel   `tfsdk:"alert_channels"`
+	GracePeriod         types.Int64           `tfsdk:"grace_period"`
+	GroupBy             []GroupByModel        `tfsdk:"group_by"`
+	Granularity         types.Int64           `tfsdk:"granularity"`
+	TagFilter           shared.TagFilterValue `tfsdk:"tag_filter"`
+	Rules               *LogAlertRuleModel    `tfsdk:"rules"`
+	TimeThreshold       *TimeThresholdModel   `tfsdk:"time_threshold"`
+	CustomPayloadFields types.List            `tfsdk:"custom_payload_field"`
 }
 
 type TimeThresholdModel struct {
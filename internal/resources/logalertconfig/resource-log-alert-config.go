@@ -24,6 +24,7 @@ import (
 	tag "github.com/instana/instana-go-client/shared/tagfilter"
 	common "github.com/instana/instana-go-client/shared/types"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/alertingchannel"
 	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
 	"github.com/instana/terraform-provider-instana/internal/util"
@@ -33,9 +34,13 @@ import (
 func NewLogAlertConfigResourceHandle() resourcehandle.ResourceHandle[*api.LogAlertConfig] {
 	return &logAlertConfigResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName:  ResourceInstanaLogAlertConfig,
-			Schema:        buildLogAlertConfigSchema(),
-			SchemaVersion: 1,
+			ResourceName:        ResourceInstanaLogAlertConfig,
+			ImportNameAttribute: LogAlertConfigFieldName,
+			Schema:              buildLogAlertConfigSchema(),
+			SchemaVersion:       1,
+			ReferenceAttributes: map[string]string{
+				LogAlertConfigFieldAlertChannels: alertingchannel.ResourceInstanaAlertingChannel,
+			},
 		},
 	}
 }
@@ -89,6 +94,13 @@ func buildLogAlertConfigSchema() schema.Schema {
 			LogAlertConfigFieldTagFilter: schema.StringAttribute{
 				Optional:    true,
 				Description: LogAlertConfigDescTagFilter,
+				CustomType:  shared.TagFilterType{},
+				Validators: []validator.String{
+					shared.TagFilterValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					shared.TagFilterPlanModifier(),
+				},
 			},
 			shared.DefaultCustomPayloadFieldsName: shared.GetCustomPayloadFieldsSchema(),
 			LogAlertConfigFieldAlertChannels:      buildAlertChannelsSchema(),
@@ -293,11 +305,11 @@ func (r *logAlertConfigResource) mapGracePeriodToModel(gracePeriod int64) types.
 }
 
 // mapTagFilterToModel converts API tag filter to model representation
-func (r *logAlertConfigResource) mapTagFilterToModel(tagFilterExpression *tag.TagFilter) (types.String, diag.Diagnostics) {
+func (r *logAlertConfigResource) mapTagFilterToModel(tagFilterExpression *tag.TagFilter) (shared.TagFilterValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	if tagFilterExpression == nil {
-		return types.StringNull(), diags
+		return shared.NewTagFilterNull(), diags
 	}
 
 	normalizedTagFilterString, err := tagfilter.MapTagFilterToNormalizedString(tagFilterExpression)
@@ -306,10 +318,10 @@ func (r *logAlertConfigResource) mapTagFilterToModel(tagFilterExpression *tag.Ta
 			LogAlertConfigErrNormalizingTagFilter,
 			LogAlertConfigErrNormalizingTagFilterMsg+err.Error(),
 		)
-		return types.StringNull(), diags
+		return shared.NewTagFilterNull(), diags
 	}
 
-	return util.SetStringPointerToState(normalizedTagFilterString), diags
+	return shared.NewTagFilterPointerValue(normalizedTagFilterString), diags
 }
 
 // mapGroupByToModel converts API group by to model representation
@@ -556,7 +568,7 @@ func (r *logAlertConfigResource) extractGracePeriod(gracePeriod types.Int64) int
 }
 
 // mapModelTagFilterToAPI converts model tag filter to API representation
-func (r *logAlertConfigResource) mapModelTagFilterToAPI(tagFilter types.String) (*tag.TagFilter, diag.Diagnostics) {
+func (r *logAlertConfigResource) mapModelTagFilterToAPI(tagFilter shared.TagFilterValue) (*tag.TagFilter, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	if tagFilter.IsNull() {
@@ -424,7 +424,7 @@ func TestMapStateToDataObject_BasicConfig(t *testing.T) {
 		Name:                types.StringValue("Test Log Alert"),
 		Description:         types.StringValue("Test Description"),
 		Granularity:         types.Int64Value(600000),
-		TagFilter:           types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:           shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
 
@@ -448,7 +448,7 @@ func TestMapStateToDataObject_WithGracePeriod(t *testing.T) {
 		Description:         types.StringValue("Test Description"),
 		Granularity:         types.Int64Value(600000),
 		GracePeriod:         types.Int64Value(300000),
-		TagFilter:           types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:           shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
 
@@ -467,7 +467,7 @@ func TestMapStateToDataObject_WithNullGranularity(t *testing.T) {
 		Name:                types.StringValue("Test Log Alert"),
 		Description:         types.StringValue("Test Description"),
 		Granularity:         types.Int64Null(),
-		TagFilter:           types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:           shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
 
@@ -486,7 +486,7 @@ func TestMapStateToDataObject_WithTagFilter(t *testing.T) {
 		Name:                types.StringValue("Test Log Alert"),
 		Description:         types.StringValue("Test Description"),
 		Granularity:         types.Int64Value(600000),
-		TagFilter:           types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:           shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
 
@@ -505,7 +505,7 @@ func TestMapStateToDataObject_WithInvalidTagFilter(t *testing.T) {
 		Name:                types.StringValue("Test Log Alert"),
 		Description:         types.StringValue("Test Description"),
 		Granularity:         types.Int64Value(600000),
-		TagFilter:           types.StringValue("invalid tag filter syntax"),
+		TagFilter:           shared.NewTagFilterValue("invalid tag filter syntax"),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
 
@@ -522,7 +522,7 @@ func TestMapStateToDataObject_WithGroupBy(t *testing.T) {
 		Name:        types.StringValue("Test Log Alert"),
 		Description: types.StringValue("Test Description"),
 		Granularity: types.Int64Value(600000),
-		TagFilter:   types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:   shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		GroupBy: []GroupByModel{
 			{
 				TagName: types.StringValue("host.name"),
@@ -563,7 +563,7 @@ func TestMapStateToDataObject_WithAlertChannels(t *testing.T) {
 		Name:        types.StringValue("Test Log Alert"),
 		Description: types.StringValue("Test Description"),
 		Granularity: types.Int64Value(600000),
-		TagFilter:   types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:   shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		AlertChannels: &AlertChannelsModel{
 			Warning:  warningSet,
 			Critical: criticalSet,
@@ -588,7 +588,7 @@ func TestMapStateToDataObject_WithTimeThreshold(t *testing.T) {
 		Name:        types.StringValue("Test Log Alert"),
 		Description: types.StringValue("Test Description"),
 		Granularity: types.Int64Value(600000),
-		TagFilter:   types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:   shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		TimeThreshold: &TimeThresholdModel{
 			ViolationsInSequence: &ViolationsInSequenceModel{
 				TimeWindow: types.Int64Value(300000),
@@ -614,7 +614,7 @@ func TestMapStateToDataObject_WithRules(t *testing.T) {
 		Name:        types.StringValue("Test Log Alert"),
 		Description: types.StringValue("Test Description"),
 		Granularity: types.Int64Value(600000),
-		TagFilter:   types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:   shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		Rules: &LogAlertRuleModel{
 			MetricName:        types.StringValue("log.count"),
 			AlertType:         types.StringValue(LogAlertTypeLogCount),
@@ -810,7 +810,7 @@ func TestMapStateToDataObject_WithNullTimeThreshold(t *testing.T) {
 		Name:                types.StringValue("Test Log Alert"),
 		Description:         types.StringValue("Test Description"),
 		Granularity:         types.Int64Value(600000),
-		TagFilter:           types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:           shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		TimeThreshold:       nil,
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
@@ -830,7 +830,7 @@ func TestMapStateToDataObject_WithNullAlertChannels(t *testing.T) {
 		Name:                types.StringValue("Test Log Alert"),
 		Description:         types.StringValue("Test Description"),
 		Granularity:         types.Int64Value(600000),
-		TagFilter:           types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:           shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		AlertChannels:       nil,
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
@@ -850,7 +850,7 @@ func TestMapStateToDataObject_WithNullRules(t *testing.T) {
 		Name:                types.StringValue("Test Log Alert"),
 		Description:         types.StringValue("Test Description"),
 		Granularity:         types.Int64Value(600000),
-		TagFilter:           types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:           shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		Rules:               nil,
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
@@ -874,7 +874,7 @@ func TestMapStateToDataObject_FromPlan(t *testing.T) {
 		Name:                types.StringValue("Test Log Alert"),
 		Description:         types.StringValue("Test Description"),
 		Granularity:         types.Int64Value(600000),
-		TagFilter:           types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:           shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
 	}
 
@@ -897,7 +897,7 @@ func TestMapStateToDataObject_WithEmptyGroupBy(t *testing.T) {
 		Name:                types.StringValue("Test Log Alert"),
 		Description:         types.StringValue("Test Description"),
 		Granularity:         types.Int64Value(600000),
-		TagFilter:           types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:           shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		GroupBy:             []GroupByModel{},
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
@@ -917,7 +917,7 @@ func TestMapStateToDataObject_WithNullTimeWindowInTimeThreshold(t *testing.T) {
 		Name:        types.StringValue("Test Log Alert"),
 		Description: types.StringValue("Test Description"),
 		Granularity: types.Int64Value(600000),
-		TagFilter:   types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:   shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		TimeThreshold: &TimeThresholdModel{
 			ViolationsInSequence: &ViolationsInSequenceModel{
 				TimeWindow: types.Int64Null(),
@@ -1019,7 +1019,7 @@ func TestMapStateToDataObject_WithEmptyAlertChannelLists(t *testing.T) {
 		Name:        types.StringValue("Test Log Alert"),
 		Description: types.StringValue("Test Description"),
 		Granularity: types.Int64Value(600000),
-		TagFilter:   types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:   shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		AlertChannels: &AlertChannelsModel{
 			Warning:  warningSet,
 			Critical: criticalSet,
@@ -1045,7 +1045,7 @@ func TestMapStateToDataObject_WithNullID(t *testing.T) {
 		Name:                types.StringValue("Test Log Alert"),
 		Description:         types.StringValue("Test Description"),
 		Granularity:         types.Int64Value(600000),
-		TagFilter:           types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:           shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
 
@@ -1185,7 +1185,7 @@ func TestMapStateToDataObject_WithSingleWarningAlertChannel(t *testing.T) {
 		Name:        types.StringValue("Test Log Alert"),
 		Description: types.StringValue("Test Description"),
 		Granularity: types.Int64Value(600000),
-		TagFilter:   types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:   shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		AlertChannels: &AlertChannelsModel{
 			Warning:  warningSet,
 			Critical: types.SetNull(types.StringType),
@@ -1217,7 +1217,7 @@ func TestMapStateToDataObject_WithSingleCriticalAlertChannel(t *testing.T) {
 		Name:        types.StringValue("Test Log Alert"),
 		Description: types.StringValue("Test Description"),
 		Granularity: types.Int64Value(600000),
-		TagFilter:   types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:   shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		AlertChannels: &AlertChannelsModel{
 			Warning:  types.SetNull(types.StringType),
 			Critical: criticalSet,
@@ -1245,7 +1245,7 @@ func TestMapStateToDataObject_WithRulesWithoutThreshold(t *testing.T) {
 		Name:        types.StringValue("Test Log Alert"),
 		Description: types.StringValue("Test Description"),
 		Granularity: types.Int64Value(600000),
-		TagFilter:   types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:   shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		Rules: &LogAlertRuleModel{
 			MetricName:        types.StringValue("log.count"),
 			AlertType:         types.StringValue(LogAlertTypeLogCount),
@@ -1272,7 +1272,7 @@ func TestMapStateToDataObject_WithRulesWithOnlyWarningThreshold(t *testing.T) {
 		Name:        types.StringValue("Test Log Alert"),
 		Description: types.StringValue("Test Description"),
 		Granularity: types.Int64Value(600000),
-		TagFilter:   types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:   shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		Rules: &LogAlertRuleModel{
 			MetricName:        types.StringValue("log.count"),
 			AlertType:         types.StringValue(LogAlertTypeLogCount),
@@ -1308,7 +1308,7 @@ func TestMapStateToDataObject_WithRulesWithOnlyCriticalThreshold(t *testing.T) {
 		Name:        types.StringValue("Test Log Alert"),
 		Description: types.StringValue("Test Description"),
 		Granularity: types.Int64Value(600000),
-		TagFilter:   types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:   shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		Rules: &LogAlertRuleModel{
 			MetricName:        types.StringValue("log.count"),
 			AlertType:         types.StringValue(LogAlertTypeLogCount),
@@ -1344,7 +1344,7 @@ func TestMapStateToDataObject_WithRulesWithNullThresholdValues(t *testing.T) {
 		Name:        types.StringValue("Test Log Alert"),
 		Description: types.StringValue("Test Description"),
 		Granularity: types.Int64Value(600000),
-		TagFilter:   types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:   shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		Rules: &LogAlertRuleModel{
 			MetricName:        types.StringValue("log.count"),
 			AlertType:         types.StringValue(LogAlertTypeLogCount),
@@ -1478,7 +1478,7 @@ func TestMapStateToDataObject_WithNullAggregation(t *testing.T) {
 		Name:        types.StringValue("Test Log Alert"),
 		Description: types.StringValue("Test Description"),
 		Granularity: types.Int64Value(600000),
-		TagFilter:   types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:   shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		Rules: &LogAlertRuleModel{
 			MetricName:        types.StringValue("log.count"),
 			AlertType:         types.StringValue(LogAlertTypeLogCount),
@@ -1512,7 +1512,7 @@ func TestMapStateToDataObject_WithUnknownAlertChannels(t *testing.T) {
 		Name:        types.StringValue("Test Log Alert"),
 		Description: types.StringValue("Test Description"),
 		Granularity: types.Int64Value(600000),
-		TagFilter:   types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:   shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		AlertChannels: &AlertChannelsModel{
 			Warning:  types.SetUnknown(types.StringType),
 			Critical: types.SetUnknown(types.StringType),
@@ -1542,7 +1542,7 @@ func TestMapStateToDataObject_WithUnknownGroupBy(t *testing.T) {
 		Name:                types.StringValue("Test Log Alert"),
 		Description:         types.StringValue("Test Description"),
 		Granularity:         types.Int64Value(600000),
-		TagFilter:           types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:           shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
 	}
 
@@ -1591,7 +1591,7 @@ func TestMapStateToDataObject_WithCustomPayloadFields(t *testing.T) {
 		Name:                types.StringValue("Test Log Alert"),
 		Description:         types.StringValue("Test Description"),
 		Granularity:         types.Int64Value(600000),
-		TagFilter:           types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:           shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		CustomPayloadFields: customPayloadFieldsList,
 	})
 
@@ -1713,7 +1713,7 @@ func TestMapStateToDataObject_WithUnknownCustomPayloadFields(t *testing.T) {
 		Name:                types.StringValue("Test Log Alert"),
 		Description:         types.StringValue("Test Description"),
 		Granularity:         types.Int64Value(600000),
-		TagFilter:           types.StringValue("entity.type EQUALS 'log'"),
+		TagFilter:           shared.NewTagFilterValue("entity.type EQUALS 'log'"),
 		CustomPayloadFields: types.ListUnknown(shared.GetCustomPayloadFieldType()),
 	})
 
@@ -1732,7 +1732,7 @@ func TestMapStateToDataObject_WithNullTagFilter(t *testing.T) {
 		Name:                types.StringValue("Test Log Alert"),
 		Description:         types.StringValue("Test Description"),
 		Granularity:         types.Int64Value(600000),
-		TagFilter:           types.StringNull(),
+		TagFilter:           shared.NewTagFilterNull(),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
 
@@ -1751,7 +1751,7 @@ func TestMapStateToDataObject_WithEmptyTagFilter(t *testing.T) {
 		Name:                types.StringValue("Test Log Alert"),
 		Description:         types.StringValue("Test Description"),
 		Granularity:         types.Int64Value(600000),
-		TagFilter:           types.StringValue(""),
+		TagFilter:           shared.NewTagFilterValue(""),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
 	})
 
@@ -1837,7 +1837,7 @@ func initializeEmptyState(ctx context.Context, state *tfsdk.State) {
 		GracePeriod:         types.Int64Null(),
 		GroupBy:             nil,
 		Granularity:         types.Int64Null(),
-		TagFilter:           types.StringNull(),
+		TagFilter:           shared.NewTagFilterNull(),
 		Rules:               nil,
 		TimeThreshold:       nil,
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -1,15 +1,18 @@
 package maintenancewindowconfig
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+)
 
 // MaintenanceWindowConfigModel represents the data model for the maintenance window configuration resource
 type MaintenanceWindowConfigModel struct {
-	ID                         types.String `tfsdk:"id"`
-	Name                       types.String `tfsdk:"name"`
-	Query                      types.String `tfsdk:"query"`
-	Scheduling                 types.Object `tfsdk:"scheduling"`
-	TagFilterExpressionEnabled types.Bool   `tfsdk:"tag_filter_expression_enabled"`
-	TagFilterExpression        types.String `tfsdk:"tag_filter_expression"`
+	ID                         types.String          `tfsdk:"id"`
+	Name                       types.String          `tfsdk:"name"`
+	Query                      types.String          `tfsdk:"query"`
+	Scheduling                 types.Object          `tfsdk:"scheduling"`
+	TagFilterExpressionEnabled types.Bool            `tfsdk:"tag_filter_expression_enabled"`
+	TagFilterExpression        shared.TagFilterValue `tfsdk:"tag_filter_expression"`
 }
 
 // MaintenanceSchedulingModel represents the scheduling configuration
@@ -19,6 +19,7 @@ import (
 	"github.com/instana/instana-go-client/client"
 	"github.com/instana/instana-go-client/shared/rest"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
 )
 
@@ -26,7 +27,8 @@ import (
 func NewMaintenanceWindowConfigResourceHandle() resourcehandle.ResourceHandle[*api.MaintenanceWindow] {
 	return &maintenanceWindowConfigResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName: ResourceInstanaMaintenanceWindowConfig,
+			ResourceName:        ResourceInstanaMaintenanceWindowConfig,
+			ImportNameAttribute: MaintenanceWindowConfigFieldName,
 			Schema: schema.Schema{
 				Description: MaintenanceWindowConfigDescResource,
 				Attributes: map[string]schema.Attribute{
@@ -63,6 +65,13 @@ func NewMaintenanceWindowConfigResourceHandle() resourcehandle.ResourceHandle[*a
 					MaintenanceWindowConfigFieldTagFilterExpression: schema.StringAttribute{
 						Optional:    true,
 						Description: MaintenanceWindowConfigDescTagFilterExpression,
+						CustomType:  shared.TagFilterType{},
+						Validators: []validator.String{
+							shared.TagFilterValidator(),
+						},
+						PlanModifiers: []planmodifier.String{
+							shared.TagFilterPlanModifier(),
+						},
 					},
 				},
 			},
@@ -183,12 +192,12 @@ func (r *maintenanceWindowConfigResource) UpdateState(ctx context.Context, state
 			return diags
 		}
 		if tagFilterString != nil {
-			model.TagFilterExpression = types.StringValue(*tagFilterString)
+			model.TagFilterExpression = shared.NewTagFilterValue(*tagFilterString)
 		} else {
-			model.TagFilterExpression = types.StringNull()
+			model.TagFilterExpression = shared.NewTagFilterNull()
 		}
 	} else {
-		model.TagFilterExpression = types.StringNull()
+		model.TagFilterExpression = shared.NewTagFilterNull()
 	}
 
 	// Set state
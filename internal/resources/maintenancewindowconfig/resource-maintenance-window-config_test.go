@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/instana/instana-go-client/api"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -195,7 +196,7 @@ func TestMapStateToDataObject(t *testing.T) {
 			Query:                      types.StringValue("entity.type:host"),
 			Scheduling:                 schedulingObj,
 			TagFilterExpressionEnabled: types.BoolNull(),
-			TagFilterExpression:        types.StringNull(),
+			TagFilterExpression:        shared.NewTagFilterNull(),
 		}
 
 		handle := NewMaintenanceWindowConfigResourceHandle()
@@ -261,7 +262,7 @@ func TestMapStateToDataObject(t *testing.T) {
 			Query:                      types.StringValue(""),
 			Scheduling:                 schedulingObj,
 			TagFilterExpressionEnabled: types.BoolValue(false),
-			TagFilterExpression:        types.StringNull(),
+			TagFilterExpression:        shared.NewTagFilterNull(),
 		}
 
 		handle := NewMaintenanceWindowConfigResourceHandle()
@@ -323,7 +324,7 @@ func TestMapStateToDataObject(t *testing.T) {
 			Query:                      types.StringValue(""),
 			Scheduling:                 schedulingObj,
 			TagFilterExpressionEnabled: types.BoolValue(true),
-			TagFilterExpression:        types.StringValue("synthetic.locationLabelAggregated@na EQUALS 'us-east'"),
+			TagFilterExpression:        shared.NewTagFilterValue("synthetic.locationLabelAggregated@na EQUALS 'us-east'"),
 		}
 
 		handle := NewMaintenanceWindowConfigResourceHandle()
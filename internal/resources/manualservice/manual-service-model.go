@@ -0,0 +1,16 @@
+package manualservice
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+)
+
+// ManualServiceModel represents the data model for a manual service configuration
+type ManualServiceModel struct {
+	ID                     types.String          `tfsdk:"id"`
+	Description            types.String          `tfsdk:"description"`
+	Enabled                types.Bool            `tfsdk:"enabled"`
+	TagFilter              shared.TagFilterValue `tfsdk:"tag_filter"`
+	ExistingServiceID      types.String          `tfsdk:"existing_service_id"`
+	UnmonitoredServiceName types.String          `tfsdk:"unmonitored_service_name"`
+}
@@ -0,0 +1,49 @@
+package manualservice
+
+// ResourceInstanaManualService the name of the terraform-provider-instana resource to manage manual service configurations
+const ResourceInstanaManualService = "manual_service"
+
+const (
+	// Schema field names
+
+	// ManualServiceFieldID constant value for the schema field id
+	ManualServiceFieldID = "id"
+	// ManualServiceFieldDescription constant value for the schema field description
+	ManualServiceFieldDescription = "description"
+	// ManualServiceFieldEnabled constant value for the schema field enabled
+	ManualServiceFieldEnabled = "enabled"
+	// ManualServiceFieldTagFilter constant value for the schema field tag_filter
+	ManualServiceFieldTagFilter = "tag_filter"
+	// ManualServiceFieldExistingServiceID constant value for the schema field existing_service_id
+	ManualServiceFieldExistingServiceID = "existing_service_id"
+	// ManualServiceFieldUnmonitoredServiceName constant value for the schema field unmonitored_service_name
+	ManualServiceFieldUnmonitoredServiceName = "unmonitored_service_name"
+
+	// Resource description constants
+
+	// ManualServiceDescResource description for the manual service resource
+	ManualServiceDescResource = "This resource manages manual service configurations in Instana. A manual service configuration links the calls matching the tag filter to an existing service or to a new service for an unmonitored entity, e.g. a database which is not instrumented."
+	// ManualServiceDescID description for the ID field
+	ManualServiceDescID = "The ID of the manual service configuration."
+	// ManualServiceDescDescription description for the description field
+	ManualServiceDescDescription = "The description of the manual service configuration."
+	// ManualServiceDescEnabled description for the enabled field
+	ManualServiceDescEnabled = "Flag indicating if the manual service configuration is enabled."
+	// ManualServiceDescTagFilter description for the tag_filter field
+	ManualServiceDescTagFilter = "The tag filter expression selecting the calls which are mapped to the service."
+	// ManualServiceDescExistingServiceID description for the existing_service_id field
+	ManualServiceDescExistingServiceID = "The ID of the existing service the calls are mapped to. Exactly one of existing_service_id and unmonitored_service_name must be set."
+	// ManualServiceDescUnmonitoredServiceName description for the unmonitored_service_name field
+	ManualServiceDescUnmonitoredServiceName = "The name of the service which is created for the calls to an unmonitored entity. Exactly one of existing_service_id and unmonitored_service_name must be set."
+
+	// Error message constants
+
+	// ManualServiceErrParsingTagFilter error summary when the tag filter cannot be parsed
+	ManualServiceErrParsingTagFilter = "Error parsing tag filter"
+	// ManualServiceErrConvertingTagFilter error summary when the tag filter of the API cannot be converted
+	ManualServiceErrConvertingTagFilter = "Error converting tag filter"
+	// ManualServiceErrFailedToParse error detail when the tag filter cannot be parsed
+	ManualServiceErrFailedToParse = "Failed to parse tag filter: %s"
+	// ManualServiceErrFailedToConvert error detail when the tag filter of the API cannot be converted
+	ManualServiceErrFailedToConvert = "Failed to convert tag filter: %s"
+)
@@ -0,0 +1,226 @@
+package manualservice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/shared/rest"
+	tag "github.com/instana/instana-go-client/shared/tagfilter"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
+	"github.com/instana/terraform-provider-instana/internal/util"
+)
+
+// ============================================================================
+// Resource Factory
+// ============================================================================
+
+// NewManualServiceResourceHandle creates the resource handle for manual service configurations
+func NewManualServiceResourceHandle() resourcehandle.ResourceHandle[*instanaapi.ManualServiceConfig] {
+	serviceTarget := []path.Expression{
+		path.MatchRoot(ManualServiceFieldExistingServiceID),
+		path.MatchRoot(ManualServiceFieldUnmonitoredServiceName),
+	}
+	return &manualServiceResource{
+		metaData: resourcehandle.ResourceMetaData{
+			ResourceName: ResourceInstanaManualService,
+			Schema: schema.Schema{
+				Description: ManualServiceDescResource,
+				Attributes: map[string]schema.Attribute{
+					ManualServiceFieldID: schema.StringAttribute{
+						Computed:    true,
+						Description: ManualServiceDescID,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					ManualServiceFieldDescription: schema.StringAttribute{
+						Optional:    true,
+						Description: ManualServiceDescDescription,
+					},
+					ManualServiceFieldEnabled: schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(true),
+						Description: ManualServiceDescEnabled,
+					},
+					ManualServiceFieldTagFilter: schema.StringAttribute{
+						Required:    true,
+						Description: ManualServiceDescTagFilter,
+						CustomType:  shared.TagFilterType{},
+						Validators: []validator.String{
+							shared.TagFilterValidator(),
+						},
+						PlanModifiers: []planmodifier.String{
+							shared.TagFilterPlanModifier(),
+						},
+					},
+					ManualServiceFieldExistingServiceID: schema.StringAttribute{
+						Optional:    true,
+						Description: ManualServiceDescExistingServiceID,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.ExactlyOneOf(serviceTarget...),
+						},
+					},
+					ManualServiceFieldUnmonitoredServiceName: schema.StringAttribute{
+						Optional:    true,
+						Description: ManualServiceDescUnmonitoredServiceName,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.ExactlyOneOf(serviceTarget...),
+						},
+					},
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+// ============================================================================
+// Resource Implementation
+// ============================================================================
+
+type manualServiceResource struct {
+	metaData resourcehandle.ResourceMetaData
+}
+
+// MetaData returns the resource metadata
+func (r *manualServiceResource) MetaData() *resourcehandle.ResourceMetaData {
+	return &r.metaData
+}
+
+// GetRestResource returns the REST resource for manual service configurations
+func (r *manualServiceResource) GetRestResource(api client.InstanaAPI) rest.RestResource[*instanaapi.ManualServiceConfig] {
+	return instanaapi.From(api).ManualServiceConfigs()
+}
+
+// SetComputedFields sets computed fields in the plan (none for this resource)
+func (r *manualServiceResource) SetComputedFields(_ context.Context, _ *tfsdk.Plan) diag.Diagnostics {
+	return nil
+}
+
+// ============================================================================
+// API to State Mapping
+// ============================================================================
+
+// UpdateState converts API data object to Terraform state
+func (r *manualServiceResource) UpdateState(ctx context.Context, state *tfsdk.State, plan *tfsdk.Plan, config *instanaapi.ManualServiceConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var model ManualServiceModel
+
+	if plan != nil {
+		diags.Append(plan.Get(ctx, &model)...)
+	} else if state != nil && !state.Raw.IsNull() {
+		diags.Append(state.Get(ctx, &model)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	model.ID = types.StringValue(config.ID)
+	model.Description = util.SetStringPointerToState(config.Description)
+	model.Enabled = types.BoolValue(config.Enabled)
+	model.ExistingServiceID = util.SetStringPointerToState(config.ExistingServiceID)
+	model.UnmonitoredServiceName = util.SetStringPointerToState(config.UnmonitoredServiceName)
+
+	// Keep the configured tag filter expression; formatting-only differences are suppressed by the semantic equality of
+	// the tag filter type
+	if model.TagFilter.IsNull() || model.TagFilter.IsUnknown() {
+		tagFilter, tagFilterDiags := r.mapTagFilterToState(config.TagFilterExpression)
+		diags.Append(tagFilterDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		model.TagFilter = tagFilter
+	}
+
+	diags.Append(state.Set(ctx, model)...)
+	return diags
+}
+
+// mapTagFilterToState converts the tag filter expression of the API to its normalized string representation
+func (r *manualServiceResource) mapTagFilterToState(tagFilterExpression *tag.TagFilter) (shared.TagFilterValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if tagFilterExpression == nil {
+		return shared.NewTagFilterNull(), diags
+	}
+
+	normalizedTagFilterString, err := tagfilter.MapTagFilterToNormalizedString(tagFilterExpression)
+	if err != nil {
+		diags.AddError(ManualServiceErrConvertingTagFilter, fmt.Sprintf(ManualServiceErrFailedToConvert, err))
+		return shared.NewTagFilterNull(), diags
+	}
+	return shared.NewTagFilterPointerValue(normalizedTagFilterString), diags
+}
+
+// ============================================================================
+// State to API Mapping
+// ============================================================================
+
+// MapStateToDataObject converts Terraform state to API data object
+func (r *manualServiceResource) MapStateToDataObject(ctx context.Context, plan *tfsdk.Plan, state *tfsdk.State) (*instanaapi.ManualServiceConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var model ManualServiceModel
+
+	if plan != nil {
+		diags.Append(plan.Get(ctx, &model)...)
+	} else if state != nil {
+		diags.Append(state.Get(ctx, &model)...)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tagFilter, tagFilterDiags := r.mapTagFilterFromState(model.TagFilter)
+	diags.Append(tagFilterDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &instanaapi.ManualServiceConfig{
+		ID:                     model.ID.ValueString(),
+		Description:            util.SetStringPointerFromState(model.Description),
+		Enabled:                model.Enabled.ValueBool(),
+		ExistingServiceID:      util.SetStringPointerFromState(model.ExistingServiceID),
+		UnmonitoredServiceName: util.SetStringPointerFromState(model.UnmonitoredServiceName),
+		TagFilterExpression:    tagFilter,
+	}, diags
+}
+
+// mapTagFilterFromState parses the tag filter expression and converts it to the API format
+func (r *manualServiceResource) mapTagFilterFromState(tagFilterString shared.TagFilterValue) (*tag.TagFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if tagFilterString.IsNull() || tagFilterString.IsUnknown() {
+		return nil, diags
+	}
+
+	expr, err := tagfilter.NewParser().Parse(tagFilterString.ValueString())
+	if err != nil {
+		diags.AddError(ManualServiceErrParsingTagFilter, fmt.Sprintf(ManualServiceErrFailedToParse, err))
+		return nil, diags
+	}
+	return tagfilter.NewMapper().ToAPIModel(expr), diags
+}
+
+// GetStateUpgraders returns the state upgraders for this resource (none needed for v0)
+func (r *manualServiceResource) GetStateUpgraders(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
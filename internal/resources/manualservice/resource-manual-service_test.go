@@ -0,0 +1,163 @@
+package manualservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/shared/rest"
+	tag "github.com/instana/instana-go-client/shared/tagfilter"
+	common "github.com/instana/instana-go-client/shared/types"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testManualServiceID = "manual-service-id"
+	testTagFilter       = "call.database.connection@dest EQUALS 'jdbc:postgresql://db:5432/shop'"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestNewManualServiceResourceHandle(t *testing.T) {
+	t.Run("should create resource handle with correct metadata", func(t *testing.T) {
+		handle := NewManualServiceResourceHandle()
+
+		require.NotNil(t, handle)
+		metadata := handle.MetaData()
+		assert.Equal(t, ResourceInstanaManualService, metadata.ResourceName)
+		assert.Equal(t, int64(0), metadata.SchemaVersion)
+		assert.True(t, metadata.SkipIDGeneration)
+	})
+
+	t.Run("should have correct schema attributes", func(t *testing.T) {
+		s := NewManualServiceResourceHandle().MetaData().Schema
+
+		assert.True(t, s.Attributes[ManualServiceFieldID].IsComputed())
+		assert.True(t, s.Attributes[ManualServiceFieldDescription].IsOptional())
+		assert.True(t, s.Attributes[ManualServiceFieldEnabled].IsOptional())
+		assert.True(t, s.Attributes[ManualServiceFieldTagFilter].IsRequired())
+		assert.True(t, s.Attributes[ManualServiceFieldExistingServiceID].IsOptional())
+		assert.True(t, s.Attributes[ManualServiceFieldUnmonitoredServiceName].IsOptional())
+	})
+
+	t.Run("should return no state upgraders", func(t *testing.T) {
+		assert.Empty(t, NewManualServiceResourceHandle().GetStateUpgraders(context.Background()))
+	})
+}
+
+type mockManualServiceAPI struct {
+	testutils.MockInstanaAPI
+	restResource rest.RestResource[*instanaapi.ManualServiceConfig]
+}
+
+func (m *mockManualServiceAPI) ManualServiceConfigs() rest.RestResource[*instanaapi.ManualServiceConfig] {
+	return m.restResource
+}
+
+func TestManualServiceGetRestResource(t *testing.T) {
+	restResource := instanaapi.NewManualServiceConfigRestResource(nil)
+
+	result := NewManualServiceResourceHandle().GetRestResource(&mockManualServiceAPI{restResource: restResource})
+
+	assert.Same(t, restResource, result)
+}
+
+func newFullModel() ManualServiceModel {
+	return ManualServiceModel{
+		ID:                     types.StringValue(testManualServiceID),
+		Description:            types.StringValue("shop database"),
+		Enabled:                types.BoolValue(true),
+		TagFilter:              shared.NewTagFilterValue(testTagFilter),
+		ExistingServiceID:      types.StringNull(),
+		UnmonitoredServiceName: types.StringValue("shop-db"),
+	}
+}
+
+func newFullAPIObject() *instanaapi.ManualServiceConfig {
+	return &instanaapi.ManualServiceConfig{
+		ID:                     testManualServiceID,
+		Description:            strPtr("shop database"),
+		Enabled:                true,
+		UnmonitoredServiceName: strPtr("shop-db"),
+		TagFilterExpression:    tag.NewStringTagFilter(tag.TagFilterEntityDestination, "call.database.connection", common.EqualsOperator, "jdbc:postgresql://db:5432/shop"),
+	}
+}
+
+func TestManualServiceMapStateToDataObject(t *testing.T) {
+	ctx := context.Background()
+	handle := NewManualServiceResourceHandle()
+
+	t.Run("should map complete model from plan", func(t *testing.T) {
+		plan := &tfsdk.Plan{Schema: handle.MetaData().Schema}
+		require.False(t, plan.Set(ctx, newFullModel()).HasError())
+
+		result, diags := handle.MapStateToDataObject(ctx, plan, nil)
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, newFullAPIObject(), result)
+	})
+
+	t.Run("should map existing service id", func(t *testing.T) {
+		model := newFullModel()
+		model.UnmonitoredServiceName = types.StringNull()
+		model.ExistingServiceID = types.StringValue("service-id")
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+		require.False(t, state.Set(ctx, model).HasError())
+
+		result, diags := handle.MapStateToDataObject(ctx, nil, state)
+
+		require.False(t, diags.HasError())
+		assert.Nil(t, result.UnmonitoredServiceName)
+		assert.Equal(t, "service-id", *result.ExistingServiceID)
+	})
+
+	t.Run("should fail for invalid tag filter", func(t *testing.T) {
+		model := newFullModel()
+		model.TagFilter = shared.NewTagFilterValue("invalid tag filter")
+		plan := &tfsdk.Plan{Schema: handle.MetaData().Schema}
+		require.False(t, plan.Set(ctx, model).HasError())
+
+		_, diags := handle.MapStateToDataObject(ctx, plan, nil)
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, ManualServiceErrParsingTagFilter, diags[0].Summary())
+	})
+}
+
+func TestManualServiceUpdateState(t *testing.T) {
+	ctx := context.Background()
+	handle := NewManualServiceResourceHandle()
+
+	t.Run("should map API object with normalized tag filter when no tag filter is known", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+
+		diags := handle.UpdateState(ctx, state, nil, newFullAPIObject())
+
+		require.False(t, diags.HasError())
+		var model ManualServiceModel
+		require.False(t, state.Get(ctx, &model).HasError())
+		assert.Equal(t, newFullModel(), model)
+	})
+
+	t.Run("should keep configured tag filter", func(t *testing.T) {
+		configured := newFullModel()
+		configured.ID = types.StringUnknown()
+		configured.TagFilter = shared.NewTagFilterValue("call.database.connection@dest  EQUALS  'jdbc:postgresql://db:5432/shop'")
+		plan := &tfsdk.Plan{Schema: handle.MetaData().Schema}
+		require.False(t, plan.Set(ctx, configured).HasError())
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+
+		diags := handle.UpdateState(ctx, state, plan, newFullAPIObject())
+
+		require.False(t, diags.HasError())
+		var model ManualServiceModel
+		require.False(t, state.Get(ctx, &model).HasError())
+		assert.Equal(t, configured.TagFilter, model.TagFilter)
+		assert.Equal(t, testManualServiceID, model.ID.ValueString())
+	})
+}
@@ -7,19 +7,20 @@ import (
 
 // MobileAlertConfigModel represents the data model for the mobile alert configuration resource
 type MobileAlertConfigModel struct {
-	ID                  types.String                   `tfsdk:"id"`
-	Name                types.String                   `tfsdk:"name"`
-	Description         types.String                   `tfsdk:"description"`
-	MobileAppID         types.String                   `tfsdk:"mobile_app_id"`
-	Triggering          types.Bool                     `tfsdk:"triggering"`
-	Enabled             types.Bool                     `tfsdk:"enabled"`
-	TagFilter           types.String                   `tfsdk:"tag_filter"`
-	AlertChannels       types.Map                      `tfsdk:"alert_channels"`
-	Granularity         types.Int64                    `tfsdk:"granularity"`
-	GracePeriod         types.Int64                    `tfsdk:"grace_period"`
-	CustomPayloadFields types.List                     `tfsdk:"custom_payload_field"`
-	Rules               []MobileRuleWithThresholdModel `tfsdk:"rules"`
-	TimeThreshold       *MobileAlertTimeThresholdModel `tfsdk:"time_threshold"`
+	ID                     types.String                   `tfsdk:"id"`
+	Name                   types.String                   `tfsdk:"name"`
+	Description            types.String                   `tfsdk:"description"`
+	MobileAppID            types.String                   `tfsdk:"mobile_app_id"`
+	Triggering             types.Bool                     `tfsdk:"triggering"`
+	Enabled                types.Bool                     `tfsdk:"enabled"`
+	BaselineRefreshTrigger types.String                   `tfsdk:"baseline_refresh_trigger"`
+	TagFilter              shared.TagFilterValue          `tfsdk:"tag_filter"`
+	AlertChannels          types.Map                      `tfsdk:"alert_channels"`
+	Granularity            types.Int64                    `tfsdk:"granularity"`
+	GracePeriod            types.Int64                    `tfsdk:"grace_period"`
+	CustomPayloadFields    types.List                     `tfsdk:"custom_payload_field"`
+	Rules                  []MobileRuleWithThresholdModel `tfsdk:"rules"`
+	TimeThreshold          *MobileAlertTimeThresholdModel `tfsdk:"time_threshold"`
 }
 
 // MobileRuleWithThresholdModel represents a rule with multiple thresholds and severity levels
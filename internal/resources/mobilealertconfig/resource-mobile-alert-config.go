@@ -24,7 +24,10 @@ import (
 	"github.com/instana/instana-go-client/shared/rest"
 	tag "github.com/instana/instana-go-client/shared/tagfilter"
 	common "github.com/instana/instana-go-client/shared/types"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/alertingchannel"
+	"github.com/instana/terraform-provider-instana/internal/resources/mobileappconfig"
 	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
 	"github.com/instana/terraform-provider-instana/internal/util"
@@ -34,7 +37,8 @@ import (
 func NewMobileAlertConfigResourceHandle() resourcehandle.ResourceHandle[*api.MobileAlertConfig] {
 	return &mobileAlertConfigResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName: ResourceInstanaMobileAlertConfig,
+			ResourceName:        ResourceInstanaMobileAlertConfig,
+			ImportNameAttribute: MobileAlertConfigFieldName,
 			Schema: schema.Schema{
 				Description: MobileAlertConfigDescResource,
 				Attributes: map[string]schema.Attribute{
@@ -78,9 +82,17 @@ func NewMobileAlertConfigResourceHandle() resourcehandle.ResourceHandle[*api.Mob
 						Description: MobileAlertConfigDescEnabled,
 						Default:     booldefault.StaticBool(MobileAlertConfigDefaultEnabled),
 					},
+					shared.BaselineRefreshTriggerFieldName: shared.BaselineRefreshTriggerAttributeSchema(),
 					MobileAlertConfigFieldTagFilter: schema.StringAttribute{
 						Optional:    true,
 						Description: MobileAlertConfigDescTagFilter,
+						CustomType:  shared.TagFilterType{},
+						Validators: []validator.String{
+							shared.TagFilterValidator(),
+						},
+						PlanModifiers: []planmodifier.String{
+							shared.TagFilterPlanModifier(),
+						},
 					},
 					MobileAlertConfigFieldAlertChannels: schema.MapAttribute{
 						Optional:    true,
@@ -244,6 +256,10 @@ func NewMobileAlertConfigResourceHandle() resourcehandle.ResourceHandle[*api.Mob
 				},
 			},
 			SchemaVersion: 0,
+			ReferenceAttributes: map[string]string{
+				MobileAlertConfigFieldMobileAppID:   mobileappconfig.ResourceInstanaMobileAppConfig,
+				MobileAlertConfigFieldAlertChannels: alertingchannel.ResourceInstanaAlertingChannel,
+			},
 		},
 	}
 }
@@ -262,6 +278,34 @@ func (r *mobileAlertConfigResource) GetRestResource(api client.InstanaAPI) rest.
 	return api.MobileAlertConfigs()
 }
 
+// EnabledAttribute implementation of resourcehandle.EnabledStateToggler
+func (r *mobileAlertConfigResource) EnabledAttribute() string {
+	return MobileAlertConfigFieldEnabled
+}
+
+// SetEnabled enables or disables the mobile alert configuration via the dedicated endpoints
+func (r *mobileAlertConfigResource) SetEnabled(instanaAPI client.InstanaAPI, id string, enabled bool) error {
+	return instanaapi.From(instanaAPI).EnablementToggle(instanaapi.MobileAppAlertConfigsResourcePath).SetEnabled(id, enabled)
+}
+
+// IsEnabled returns the enabled flag of the mobile alert configuration
+func (r *mobileAlertConfigResource) IsEnabled(_ client.InstanaAPI, config *api.MobileAlertConfig) (bool, error) {
+	if config.Enabled == nil {
+		return MobileAlertConfigDefaultEnabled, nil
+	}
+	return *config.Enabled, nil
+}
+
+// BaselineRefreshTriggerAttribute implementation of resourcehandle.BaselineRefresher
+func (r *mobileAlertConfigResource) BaselineRefreshTriggerAttribute() string {
+	return shared.BaselineRefreshTriggerFieldName
+}
+
+// RefreshBaseline recalculates the historic baseline of the mobile alert configuration
+func (r *mobileAlertConfigResource) RefreshBaseline(instanaAPI client.InstanaAPI, id string) error {
+	return instanaapi.From(instanaAPI).BaselineUpdater(instanaapi.MobileAppAlertConfigsResourcePath).UpdateBaseline(id)
+}
+
 // SetComputedFields sets computed fields in the plan (currently no computed fields need to be set)
 func (r *mobileAlertConfigResource) SetComputedFields(_ context.Context, _ *tfsdk.Plan) diag.Diagnostics {
 	return nil
@@ -668,9 +712,9 @@ func (r *mobileAlertConfigResource) mapTagFilterExpressionToState(model *MobileA
 			)
 			return diags
 		}
-		model.TagFilter = util.SetStringPointerToState(filterExprStr)
+		model.TagFilter = shared.NewTagFilterPointerValue(filterExprStr)
 	} else {
-		model.TagFilter = types.StringNull()
+		model.TagFilter = shared.NewTagFilterNull()
 	}
 
 	return diags
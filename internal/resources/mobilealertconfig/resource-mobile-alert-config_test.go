@@ -158,7 +158,7 @@ func TestMapStateToDataObject_BasicConfig(t *testing.T) {
 		Enabled:     types.BoolValue(true),
 		Granularity: types.Int64Value(600000),
 
-		TagFilter:           types.StringNull(),
+		TagFilter:           shared.NewTagFilterNull(),
 		AlertChannels:       types.MapNull(types.SetType{ElemType: types.StringType}),
 		GracePeriod:         types.Int64Null(),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -197,7 +197,7 @@ func TestMapStateToDataObject_WithGracePeriod(t *testing.T) {
 		Granularity: types.Int64Value(600000),
 		GracePeriod: types.Int64Value(300000),
 
-		TagFilter:           types.StringNull(),
+		TagFilter:           shared.NewTagFilterNull(),
 		AlertChannels:       types.MapNull(types.SetType{ElemType: types.StringType}),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
 		Rules:               []MobileRuleWithThresholdModel{},
@@ -226,7 +226,7 @@ func TestMapStateToDataObject_WithTagFilter(t *testing.T) {
 		MobileAppID:         types.StringValue("mobile-app-123"),
 		Triggering:          types.BoolValue(false),
 		Granularity:         types.Int64Value(600000),
-		TagFilter:           types.StringValue("entity.type EQUALS 'mobileApp'"),
+		TagFilter:           shared.NewTagFilterValue("entity.type EQUALS 'mobileApp'"),
 		AlertChannels:       types.MapNull(types.SetType{ElemType: types.StringType}),
 		GracePeriod:         types.Int64Null(),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -267,7 +267,7 @@ func TestMapStateToDataObject_WithAlertChannels(t *testing.T) {
 		Granularity:   types.Int64Value(600000),
 		AlertChannels: types.MapValueMust(types.SetType{ElemType: types.StringType}, alertChannelsMap),
 
-		TagFilter:           types.StringNull(),
+		TagFilter:           shared.NewTagFilterNull(),
 		GracePeriod:         types.Int64Null(),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
 		Rules:               []MobileRuleWithThresholdModel{},
@@ -301,7 +301,7 @@ func TestMapStateToDataObject_WithRules(t *testing.T) {
 		Triggering:  types.BoolValue(false),
 		Granularity: types.Int64Value(600000),
 
-		TagFilter:           types.StringNull(),
+		TagFilter:           shared.NewTagFilterNull(),
 		AlertChannels:       types.MapNull(types.SetType{ElemType: types.StringType}),
 		GracePeriod:         types.Int64Null(),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -405,7 +405,7 @@ func TestMapStateToDataObject_TimeThresholdTypes(t *testing.T) {
 				Triggering:  types.BoolValue(false),
 				Granularity: types.Int64Value(600000),
 
-				TagFilter:           types.StringNull(),
+				TagFilter:           shared.NewTagFilterNull(),
 				AlertChannels:       types.MapNull(types.SetType{ElemType: types.StringType}),
 				GracePeriod:         types.Int64Null(),
 				CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -434,7 +434,7 @@ func TestMapStateToDataObject_MissingTimeThreshold(t *testing.T) {
 		Triggering:  types.BoolValue(false),
 		Granularity: types.Int64Value(600000),
 
-		TagFilter:           types.StringNull(),
+		TagFilter:           shared.NewTagFilterNull(),
 		AlertChannels:       types.MapNull(types.SetType{ElemType: types.StringType}),
 		GracePeriod:         types.Int64Null(),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -459,7 +459,7 @@ func TestMapStateToDataObject_InvalidTimeThresholdConfig(t *testing.T) {
 		Triggering:  types.BoolValue(false),
 		Granularity: types.Int64Value(600000),
 
-		TagFilter:           types.StringNull(),
+		TagFilter:           shared.NewTagFilterNull(),
 		AlertChannels:       types.MapNull(types.SetType{ElemType: types.StringType}),
 		GracePeriod:         types.Int64Null(),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -863,7 +863,7 @@ func TestMapStateToDataObject_FromPlan(t *testing.T) {
 		Triggering:  types.BoolValue(false),
 		Granularity: types.Int64Value(600000),
 
-		TagFilter:           types.StringNull(),
+		TagFilter:           shared.NewTagFilterNull(),
 		AlertChannels:       types.MapNull(types.SetType{ElemType: types.StringType}),
 		GracePeriod:         types.Int64Null(),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -893,7 +893,7 @@ func TestMapStateToDataObject_WithNullRule(t *testing.T) {
 		Triggering:  types.BoolValue(false),
 		Granularity: types.Int64Value(600000),
 
-		TagFilter:           types.StringNull(),
+		TagFilter:           shared.NewTagFilterNull(),
 		AlertChannels:       types.MapNull(types.SetType{ElemType: types.StringType}),
 		GracePeriod:         types.Int64Null(),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -949,7 +949,7 @@ func TestUpdateState_WithPlan(t *testing.T) {
 		Triggering:  types.BoolValue(false),
 		Granularity: types.Int64Value(600000),
 
-		TagFilter:           types.StringNull(),
+		TagFilter:           shared.NewTagFilterNull(),
 		AlertChannels:       types.MapNull(types.SetType{ElemType: types.StringType}),
 		GracePeriod:         types.Int64Null(),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -987,7 +987,7 @@ func TestMapStateToDataObject_WithNullEnabled(t *testing.T) {
 		Triggering:  types.BoolValue(false),
 		Granularity: types.Int64Value(600000),
 
-		TagFilter:           types.StringNull(),
+		TagFilter:           shared.NewTagFilterNull(),
 		AlertChannels:       types.MapNull(types.SetType{ElemType: types.StringType}),
 		GracePeriod:         types.Int64Null(),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -1055,7 +1055,7 @@ func TestMapStateToDataObject_WithRulesNullOptionalFields(t *testing.T) {
 		Triggering:  types.BoolValue(false),
 		Granularity: types.Int64Value(600000),
 
-		TagFilter:           types.StringNull(),
+		TagFilter:           shared.NewTagFilterNull(),
 		AlertChannels:       types.MapNull(types.SetType{ElemType: types.StringType}),
 		GracePeriod:         types.Int64Null(),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
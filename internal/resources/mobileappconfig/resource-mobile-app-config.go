@@ -79,9 +79,10 @@ func (r *mobileAppConfigResource) UpdateState(ctx context.Context, state *tfsdk.
 // createResourceMetaData creates the resource metadata with schema definition
 func createResourceMetaData() resourcehandle.ResourceMetaData {
 	return resourcehandle.ResourceMetaData{
-		ResourceName:  ResourceInstanaMobileAppConfig,
-		Schema:        createResourceSchema(),
-		SchemaVersion: MobileAppConfigSchemaVersion,
+		ResourceName:        ResourceInstanaMobileAppConfig,
+		ImportNameAttribute: MobileAppConfigFieldName,
+		Schema:              createResourceSchema(),
+		SchemaVersion:       MobileAppConfigSchemaVersion,
 	}
 }
 
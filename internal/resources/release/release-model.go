@@ -0,0 +1,18 @@
+package release
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// ReleaseModel represents the data model for a release
+type ReleaseModel struct {
+	ID           types.String          `tfsdk:"id"`
+	Name         types.String          `tfsdk:"name"`
+	Start        types.Int64           `tfsdk:"start"`
+	Applications types.Set             `tfsdk:"applications"`
+	Services     []ReleaseServiceModel `tfsdk:"services"`
+}
+
+// ReleaseServiceModel represents a service scope of a release
+type ReleaseServiceModel struct {
+	Name         types.String `tfsdk:"name"`
+	Applications types.Set    `tfsdk:"applications"`
+}
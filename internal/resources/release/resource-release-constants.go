@@ -0,0 +1,42 @@
+package release
+
+// ResourceInstanaRelease the name of the terraform-provider-instana resource to manage releases
+const ResourceInstanaRelease = "release"
+
+const (
+	// Schema field names
+
+	// ReleaseFieldID constant value for the schema field id
+	ReleaseFieldID = "id"
+	// ReleaseFieldName constant value for the schema field name
+	ReleaseFieldName = "name"
+	// ReleaseFieldStart constant value for the schema field start
+	ReleaseFieldStart = "start"
+	// ReleaseFieldApplications constant value for the schema field applications
+	ReleaseFieldApplications = "applications"
+	// ReleaseFieldServices constant value for the schema field services
+	ReleaseFieldServices = "services"
+	// ReleaseFieldServiceName constant value for the schema field services.name
+	ReleaseFieldServiceName = "name"
+	// ReleaseFieldServiceApplications constant value for the schema field services.applications
+	ReleaseFieldServiceApplications = "applications"
+
+	// Resource description constants
+
+	// ReleaseDescResource description for the release resource
+	ReleaseDescResource = "This resource manages release markers in Instana. A release marks a deployment at a given point in time and can be scoped to applications and services so that it is shown in the corresponding dashboards."
+	// ReleaseDescID description for the ID field
+	ReleaseDescID = "The ID of the release."
+	// ReleaseDescName description for the name field
+	ReleaseDescName = "The name of the release."
+	// ReleaseDescStart description for the start field
+	ReleaseDescStart = "The start time of the release as Unix timestamp in milliseconds."
+	// ReleaseDescApplications description for the applications field
+	ReleaseDescApplications = "The names of the applications the release is scoped to."
+	// ReleaseDescServices description for the services field
+	ReleaseDescServices = "The services the release is scoped to."
+	// ReleaseDescServiceName description for the services.name field
+	ReleaseDescServiceName = "The name of the service."
+	// ReleaseDescServiceApplications description for the services.applications field
+	ReleaseDescServiceApplications = "The names of the applications the service scope is limited to."
+)
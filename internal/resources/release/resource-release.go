@@ -0,0 +1,261 @@
+package release
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+)
+
+// maxReleaseScopes the maximum number of application or service scopes supported by the Instana API
+const maxReleaseScopes = 10
+
+// NewReleaseResourceHandle creates the resource handle for releases
+func NewReleaseResourceHandle() resourcehandle.ResourceHandle[*instanaapi.Release] {
+	return &releaseResource{
+		metaData: resourcehandle.ResourceMetaData{
+			ResourceName:        ResourceInstanaRelease,
+			ImportNameAttribute: ReleaseFieldName,
+			Schema:              buildReleaseSchema(),
+			SchemaVersion:       0,
+			SkipIDGeneration:    true,
+		},
+	}
+}
+
+// buildReleaseSchema constructs the Terraform schema for the release resource
+func buildReleaseSchema() schema.Schema {
+	return schema.Schema{
+		Description: ReleaseDescResource,
+		Attributes: map[string]schema.Attribute{
+			ReleaseFieldID: schema.StringAttribute{
+				Computed:    true,
+				Description: ReleaseDescID,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			ReleaseFieldName: schema.StringAttribute{
+				Required:    true,
+				Description: ReleaseDescName,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			ReleaseFieldStart: schema.Int64Attribute{
+				Required:    true,
+				Description: ReleaseDescStart,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			ReleaseFieldApplications: schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: ReleaseDescApplications,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(maxReleaseScopes),
+				},
+			},
+			ReleaseFieldServices: schema.SetNestedAttribute{
+				Optional:    true,
+				Description: ReleaseDescServices,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(maxReleaseScopes),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						ReleaseFieldServiceName: schema.StringAttribute{
+							Required:    true,
+							Description: ReleaseDescServiceName,
+						},
+						ReleaseFieldServiceApplications: schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: ReleaseDescServiceApplications,
+							Validators: []validator.Set{
+								setvalidator.SizeBetween(1, maxReleaseScopes),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type releaseResource struct {
+	metaData resourcehandle.ResourceMetaData
+}
+
+func (r *releaseResource) MetaData() *resourcehandle.ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *releaseResource) GetRestResource(api client.InstanaAPI) rest.RestResource[*instanaapi.Release] {
+	return instanaapi.From(api).Releases()
+}
+
+func (r *releaseResource) SetComputedFields(_ context.Context, _ *tfsdk.Plan) diag.Diagnostics {
+	return nil
+}
+
+// UpdateState updates the Terraform state with data from the API response. The API does not distinguish between
+// empty and missing scopes, therefore empty scopes are mapped to empty sets when the plan or, without a plan, the prior
+// state holds an empty set and to null otherwise.
+func (r *releaseResource) UpdateState(ctx context.Context, state *tfsdk.State, plan *tfsdk.Plan, release *instanaapi.Release) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	emptyApplications, d := isEmptySet(ctx, state, plan, ReleaseFieldApplications)
+	diags.Append(d...)
+	emptyServices, d := isEmptySet(ctx, state, plan, ReleaseFieldServices)
+	diags.Append(d...)
+
+	applications, d := mapApplicationScopesToState(ctx, release.Applications, emptyApplications)
+	diags.Append(d...)
+
+	var services []ReleaseServiceModel
+	if emptyServices {
+		services = []ReleaseServiceModel{}
+	}
+	for _, service := range release.Services {
+		serviceModel := ReleaseServiceModel{
+			Name:         types.StringValue(service.Name),
+			Applications: types.SetNull(types.StringType),
+		}
+		if service.ScopedTo != nil {
+			serviceModel.Applications, d = mapApplicationScopesToState(ctx, service.ScopedTo.Applications, false)
+			diags.Append(d...)
+		}
+		services = append(services, serviceModel)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	model := ReleaseModel{
+		ID:           types.StringValue(release.ID),
+		Name:         types.StringValue(release.Name),
+		Start:        types.Int64Value(release.Start),
+		Applications: applications,
+		Services:     services,
+	}
+	diags.Append(state.Set(ctx, model)...)
+	return diags
+}
+
+// isEmptySet returns true when the given set attribute of the plan or, when there is no plan, of the prior state is an
+// empty set
+func isEmptySet(ctx context.Context, state *tfsdk.State, plan *tfsdk.Plan, attribute string) (bool, diag.Diagnostics) {
+	var value types.Set
+	var diags diag.Diagnostics
+	if plan != nil && !plan.Raw.IsNull() {
+		diags = plan.GetAttribute(ctx, path.Root(attribute), &value)
+	} else if state != nil && !state.Raw.IsNull() {
+		diags = state.GetAttribute(ctx, path.Root(attribute), &value)
+	}
+	return !value.IsNull() && !value.IsUnknown() && len(value.Elements()) == 0, diags
+}
+
+// mapApplicationScopesToState maps the application scopes of the API to a set of application names.
+// An empty list is mapped to an empty set when keepEmpty is true and to null otherwise as the attribute is optional.
+func mapApplicationScopesToState(ctx context.Context, scopes []instanaapi.ReleaseApplicationScope, keepEmpty bool) (types.Set, diag.Diagnostics) {
+	if len(scopes) == 0 {
+		if keepEmpty {
+			return types.SetValueMust(types.StringType, []attr.Value{}), nil
+		}
+		return types.SetNull(types.StringType), nil
+	}
+	names := make([]string, len(scopes))
+	for i, scope := range scopes {
+		names[i] = scope.Name
+	}
+	return types.SetValueFrom(ctx, types.StringType, names)
+}
+
+// MapStateToDataObject maps Terraform state/plan to an API Release object
+func (r *releaseResource) MapStateToDataObject(ctx context.Context, plan *tfsdk.Plan, state *tfsdk.State) (*instanaapi.Release, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var model ReleaseModel
+
+	if plan != nil {
+		diags.Append(plan.Get(ctx, &model)...)
+	} else if state != nil {
+		diags.Append(state.Get(ctx, &model)...)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	id := ""
+	if !model.ID.IsNull() && !model.ID.IsUnknown() {
+		id = model.ID.ValueString()
+	}
+
+	applications, d := mapApplicationScopesFromState(ctx, model.Applications)
+	diags.Append(d...)
+
+	services := make([]instanaapi.ReleaseServiceScope, 0, len(model.Services))
+	for _, serviceModel := range model.Services {
+		service := instanaapi.ReleaseServiceScope{
+			Name: serviceModel.Name.ValueString(),
+		}
+		serviceApplications, d := mapApplicationScopesFromState(ctx, serviceModel.Applications)
+		diags.Append(d...)
+		if len(serviceApplications) > 0 {
+			service.ScopedTo = &instanaapi.ReleaseServiceScopedTo{Applications: serviceApplications}
+		}
+		services = append(services, service)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &instanaapi.Release{
+		ID:           id,
+		Name:         model.Name.ValueString(),
+		Start:        model.Start.ValueInt64(),
+		Applications: applications,
+		Services:     services,
+	}, diags
+}
+
+// mapApplicationScopesFromState maps a set of application names to application scopes of the API
+func mapApplicationScopesFromState(ctx context.Context, names types.Set) ([]instanaapi.ReleaseApplicationScope, diag.Diagnostics) {
+	if names.IsNull() || names.IsUnknown() {
+		return []instanaapi.ReleaseApplicationScope{}, nil
+	}
+	var values []string
+	diags := names.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	scopes := make([]instanaapi.ReleaseApplicationScope, len(values))
+	for i, name := range values {
+		scopes[i] = instanaapi.ReleaseApplicationScope{Name: name}
+	}
+	return scopes, diags
+}
+
+// GetStateUpgraders returns the state upgraders for this resource (none needed for v0)
+func (r *releaseResource) GetStateUpgraders(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
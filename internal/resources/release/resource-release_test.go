@@ -0,0 +1,264 @@
+package release
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/config"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	releaseID   = "release-id"
+	releaseName = "release-name"
+	releaseTime = int64(1700000000000)
+)
+
+func TestNewReleaseResourceHandle(t *testing.T) {
+	t.Run("should create resource handle with correct metadata", func(t *testing.T) {
+		handle := NewReleaseResourceHandle()
+
+		require.NotNil(t, handle)
+		metadata := handle.MetaData()
+		require.NotNil(t, metadata)
+		assert.Equal(t, ResourceInstanaRelease, metadata.ResourceName)
+		assert.Equal(t, int64(0), metadata.SchemaVersion)
+		assert.True(t, metadata.SkipIDGeneration)
+	})
+
+	t.Run("should have correct schema attributes", func(t *testing.T) {
+		s := NewReleaseResourceHandle().MetaData().Schema
+
+		assert.True(t, s.Attributes[ReleaseFieldID].IsComputed())
+		assert.True(t, s.Attributes[ReleaseFieldName].IsRequired())
+		assert.True(t, s.Attributes[ReleaseFieldStart].IsRequired())
+		assert.True(t, s.Attributes[ReleaseFieldApplications].IsOptional())
+		assert.True(t, s.Attributes[ReleaseFieldServices].IsOptional())
+	})
+
+	t.Run("should return no state upgraders", func(t *testing.T) {
+		assert.Empty(t, NewReleaseResourceHandle().GetStateUpgraders(context.Background()))
+	})
+}
+
+func newFullReleaseModel() ReleaseModel {
+	return ReleaseModel{
+		ID:           types.StringValue(releaseID),
+		Name:         types.StringValue(releaseName),
+		Start:        types.Int64Value(releaseTime),
+		Applications: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("app-1")}),
+		Services: []ReleaseServiceModel{
+			{
+				Name:         types.StringValue("service-1"),
+				Applications: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("app-1")}),
+			},
+			{
+				Name:         types.StringValue("service-2"),
+				Applications: types.SetNull(types.StringType),
+			},
+		},
+	}
+}
+
+func TestReleaseMapStateToDataObject(t *testing.T) {
+	ctx := context.Background()
+	handle := NewReleaseResourceHandle()
+
+	t.Run("should map complete model from plan", func(t *testing.T) {
+		plan := &tfsdk.Plan{Schema: handle.MetaData().Schema}
+		require.False(t, plan.Set(ctx, newFullReleaseModel()).HasError())
+
+		result, diags := handle.MapStateToDataObject(ctx, plan, nil)
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, &instanaapi.Release{
+			ID:           releaseID,
+			Name:         releaseName,
+			Start:        releaseTime,
+			Applications: []instanaapi.ReleaseApplicationScope{{Name: "app-1"}},
+			Services: []instanaapi.ReleaseServiceScope{
+				{Name: "service-1", ScopedTo: &instanaapi.ReleaseServiceScopedTo{Applications: []instanaapi.ReleaseApplicationScope{{Name: "app-1"}}}},
+				{Name: "service-2"},
+			},
+		}, result)
+	})
+
+	t.Run("should map minimal model from state without ID", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+		require.False(t, state.Set(ctx, ReleaseModel{
+			ID:           types.StringUnknown(),
+			Name:         types.StringValue(releaseName),
+			Start:        types.Int64Value(releaseTime),
+			Applications: types.SetNull(types.StringType),
+		}).HasError())
+
+		result, diags := handle.MapStateToDataObject(ctx, nil, state)
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, "", result.ID)
+		assert.Equal(t, releaseName, result.Name)
+		assert.Empty(t, result.Applications)
+		assert.Empty(t, result.Services)
+	})
+}
+
+func TestReleaseUpdateState(t *testing.T) {
+	ctx := context.Background()
+	handle := NewReleaseResourceHandle()
+
+	t.Run("should map complete release to state", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+		diags := handle.UpdateState(ctx, state, nil, &instanaapi.Release{
+			ID:           releaseID,
+			Name:         releaseName,
+			Start:        releaseTime,
+			Applications: []instanaapi.ReleaseApplicationScope{{Name: "app-1"}},
+			Services: []instanaapi.ReleaseServiceScope{
+				{Name: "service-1", ScopedTo: &instanaapi.ReleaseServiceScopedTo{Applications: []instanaapi.ReleaseApplicationScope{{Name: "app-1"}}}},
+				{Name: "service-2"},
+			},
+			LastUpdated: releaseTime + 1,
+		})
+		require.False(t, diags.HasError())
+
+		var model ReleaseModel
+		require.False(t, state.Get(ctx, &model).HasError())
+		assert.Equal(t, newFullReleaseModel(), model)
+	})
+
+	t.Run("should map release without scopes to null values", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+		diags := handle.UpdateState(ctx, state, nil, &instanaapi.Release{ID: releaseID, Name: releaseName, Start: releaseTime})
+		require.False(t, diags.HasError())
+
+		var model ReleaseModel
+		require.False(t, state.Get(ctx, &model).HasError())
+		assert.True(t, model.Applications.IsNull())
+		assert.Nil(t, model.Services)
+	})
+
+	t.Run("should keep empty scopes of the plan as empty sets", func(t *testing.T) {
+		plan := &tfsdk.Plan{Schema: handle.MetaData().Schema}
+		require.False(t, plan.Set(ctx, ReleaseModel{
+			ID:           types.StringUnknown(),
+			Name:         types.StringValue(releaseName),
+			Start:        types.Int64Value(releaseTime),
+			Applications: types.SetValueMust(types.StringType, []attr.Value{}),
+			Services:     []ReleaseServiceModel{},
+		}).HasError())
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+
+		diags := handle.UpdateState(ctx, state, plan, &instanaapi.Release{ID: releaseID, Name: releaseName, Start: releaseTime})
+		require.False(t, diags.HasError())
+
+		var model ReleaseModel
+		require.False(t, state.Get(ctx, &model).HasError())
+		assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{}), model.Applications)
+		assert.NotNil(t, model.Services)
+		assert.Empty(t, model.Services)
+	})
+
+	t.Run("should keep empty scopes of the prior state as empty sets on refresh", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+		require.False(t, state.Set(ctx, ReleaseModel{
+			ID:           types.StringValue(releaseID),
+			Name:         types.StringValue(releaseName),
+			Start:        types.Int64Value(releaseTime),
+			Applications: types.SetValueMust(types.StringType, []attr.Value{}),
+			Services:     []ReleaseServiceModel{},
+		}).HasError())
+
+		diags := handle.UpdateState(ctx, state, nil, &instanaapi.Release{ID: releaseID, Name: releaseName, Start: releaseTime})
+		require.False(t, diags.HasError())
+
+		var model ReleaseModel
+		require.False(t, state.Get(ctx, &model).HasError())
+		assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{}), model.Applications)
+		assert.NotNil(t, model.Services)
+		assert.Empty(t, model.Services)
+	})
+
+	t.Run("should map scopes of the API when the prior state holds empty sets", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+		require.False(t, state.Set(ctx, ReleaseModel{
+			ID:           types.StringValue(releaseID),
+			Name:         types.StringValue(releaseName),
+			Start:        types.Int64Value(releaseTime),
+			Applications: types.SetValueMust(types.StringType, []attr.Value{}),
+			Services:     []ReleaseServiceModel{},
+		}).HasError())
+
+		diags := handle.UpdateState(ctx, state, nil, &instanaapi.Release{
+			ID:           releaseID,
+			Name:         releaseName,
+			Start:        releaseTime,
+			Applications: []instanaapi.ReleaseApplicationScope{{Name: "app-1"}},
+			Services: []instanaapi.ReleaseServiceScope{
+				{Name: "service-1", ScopedTo: &instanaapi.ReleaseServiceScopedTo{Applications: []instanaapi.ReleaseApplicationScope{{Name: "app-1"}}}},
+				{Name: "service-2"},
+			},
+		})
+		require.False(t, diags.HasError())
+
+		var model ReleaseModel
+		require.False(t, state.Get(ctx, &model).HasError())
+		assert.Equal(t, newFullReleaseModel(), model)
+	})
+}
+
+func TestReleaseRestResourceAgainstHTTPServer(t *testing.T) {
+	server := testutils.NewTestHTTPServer()
+	server.AddRoute(http.MethodPost, instanaapi.ReleasesResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		release := instanaapi.Release{}
+		require.NoError(t, json.Unmarshal(body, &release))
+		assert.Equal(t, "", release.ID)
+		release.ID = releaseID
+		data, err := json.Marshal(release)
+		require.NoError(t, err)
+		server.WriteJSONResponse(w, data)
+	})
+	server.AddRoute(http.MethodGet, instanaapi.ReleasesResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		server.WriteJSONResponse(w, []byte(fmt.Sprintf(`{"id":"%s","name":"%s","start":%d,"applications":[{"name":"app-1"}],"lastUpdated":1}`, mux.Vars(r)["id"], releaseName, releaseTime)))
+	})
+	server.AddRoute(http.MethodDelete, instanaapi.ReleasesResourcePath+"/{id}", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server.Start()
+	defer server.Close()
+
+	clientConfig := config.DefaultClientConfig()
+	clientConfig.BaseURL = fmt.Sprintf("https://localhost:%d", server.GetPort())
+	clientConfig.APIToken = "api-token"
+	clientConfig.HTTPClient = &http.Client{
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}, //nolint:gosec
+	}
+	restResource := NewReleaseResourceHandle().GetRestResource(instanaapi.NewInstanaAPI(&testutils.MockInstanaAPI{}, clientConfig))
+
+	created, err := restResource.Create(&instanaapi.Release{Name: releaseName, Start: releaseTime})
+	require.NoError(t, err)
+	assert.Equal(t, releaseID, created.ID)
+
+	read, err := restResource.GetOne(releaseID)
+	require.NoError(t, err)
+	assert.Equal(t, []instanaapi.ReleaseApplicationScope{{Name: "app-1"}}, read.Applications)
+
+	require.NoError(t, restResource.DeleteByID(releaseID))
+
+	assert.Equal(t, 1, server.GetCallCount(http.MethodPost, instanaapi.ReleasesResourcePath))
+	assert.Equal(t, 1, server.GetCallCount(http.MethodGet, instanaapi.ReleasesResourcePath+"/"+releaseID))
+	assert.Equal(t, 1, server.GetCallCount(http.MethodDelete, instanaapi.ReleasesResourcePath+"/"+releaseID))
+}
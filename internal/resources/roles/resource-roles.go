@@ -25,9 +25,10 @@ import (
 func NewRoleResourceHandle() resourcehandle.ResourceHandle[*api.Role] {
 	return &roleResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName:  ResourceInstanaRole,
-			Schema:        buildRoleSchema(),
-			SchemaVersion: 1,
+			ResourceName:        ResourceInstanaRole,
+			ImportNameAttribute: RoleFieldName,
+			Schema:              buildRoleSchema(),
+			SchemaVersion:       1,
 		},
 	}
 }
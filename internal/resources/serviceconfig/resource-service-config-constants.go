@@ -0,0 +1,50 @@
+package serviceconfig
+
+// ResourceInstanaServiceConfig the name of the terraform-provider-instana resource to manage custom service configurations
+const ResourceInstanaServiceConfig = "service_config"
+
+const (
+	// Schema field names
+
+	// ServiceConfigFieldID constant value for the schema field id
+	ServiceConfigFieldID = "id"
+	// ServiceConfigFieldName constant value for the schema field name
+	ServiceConfigFieldName = "name"
+	// ServiceConfigFieldComment constant value for the schema field comment
+	ServiceConfigFieldComment = "comment"
+	// ServiceConfigFieldLabel constant value for the schema field label
+	ServiceConfigFieldLabel = "label"
+	// ServiceConfigFieldEnabled constant value for the schema field enabled
+	ServiceConfigFieldEnabled = "enabled"
+	// ServiceConfigFieldPosition constant value for the schema field position
+	ServiceConfigFieldPosition = "position"
+	// ServiceConfigFieldMatchSpecification constant value for the schema field match_specification
+	ServiceConfigFieldMatchSpecification = "match_specification"
+	// ServiceConfigFieldMatchSpecificationKey constant value for the schema field match_specification.key
+	ServiceConfigFieldMatchSpecificationKey = "key"
+	// ServiceConfigFieldMatchSpecificationValue constant value for the schema field match_specification.value
+	ServiceConfigFieldMatchSpecificationValue = "value"
+
+	// Resource description constants
+
+	// ServiceConfigDescResource description for the service config resource
+	ServiceConfigDescResource = "This resource manages custom service configurations in Instana. A custom service configuration defines how services are named based on the tags of the monitored entities. The configurations are evaluated in order and the first matching configuration wins."
+	// ServiceConfigDescID description for the ID field
+	ServiceConfigDescID = "The ID of the custom service configuration."
+	// ServiceConfigDescName description for the name field
+	ServiceConfigDescName = "The name of the custom service configuration."
+	// ServiceConfigDescComment description for the comment field
+	ServiceConfigDescComment = "An optional comment describing the custom service configuration."
+	// ServiceConfigDescLabel description for the label field
+	ServiceConfigDescLabel = "The label of the resulting services. Tag values can be referenced by {tag_name}, e.g. {kubernetes.container.name}."
+	// ServiceConfigDescEnabled description for the enabled field
+	ServiceConfigDescEnabled = "Flag indicating if the custom service configuration is enabled."
+	// ServiceConfigDescPosition description for the position field
+	ServiceConfigDescPosition = "The zero based position of the custom service configuration in the ordered list of all custom service configurations. When not set the order is not managed by terraform. Positions beyond the end of the list move the configuration to the end. The position is an absolute index and is only stable when a single custom service configuration sets it, as moving a configuration shifts the positions of all others."
+	// ServiceConfigDescMatchSpecification description for the match_specification field
+	ServiceConfigDescMatchSpecification = "The rules which all need to match for the custom service configuration to be applied."
+	// ServiceConfigDescMatchSpecificationKey description for the match_specification.key field
+	ServiceConfigDescMatchSpecificationKey = "The tag key to match, e.g. kubernetes.namespace.name."
+	// ServiceConfigDescMatchSpecificationValue description for the match_specification.value field
+	ServiceConfigDescMatchSpecificationValue = "The value of the tag to match. Use * to match any value."
+)
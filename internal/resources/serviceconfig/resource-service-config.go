@@ -0,0 +1,232 @@
+package serviceconfig
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/util"
+)
+
+// maxMatchingRules the maximum number of matching rules of a custom service configuration supported by the Instana API
+const maxMatchingRules = 20
+
+// ============================================================================
+// Resource Factory
+// ============================================================================
+
+// NewServiceConfigResourceHandle creates the resource handle for custom service configurations
+func NewServiceConfigResourceHandle() resourcehandle.ResourceHandle[*instanaapi.ServiceConfig] {
+	return &serviceConfigResource{
+		metaData: resourcehandle.ResourceMetaData{
+			ResourceName:        ResourceInstanaServiceConfig,
+			ImportNameAttribute: ServiceConfigFieldName,
+			Schema: schema.Schema{
+				Description: ServiceConfigDescResource,
+				Attributes: map[string]schema.Attribute{
+					ServiceConfigFieldID: schema.StringAttribute{
+						Computed:    true,
+						Description: ServiceConfigDescID,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					ServiceConfigFieldName: schema.StringAttribute{
+						Required:    true,
+						Description: ServiceConfigDescName,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 128),
+						},
+					},
+					ServiceConfigFieldComment: schema.StringAttribute{
+						Optional:    true,
+						Description: ServiceConfigDescComment,
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(2048),
+						},
+					},
+					ServiceConfigFieldLabel: schema.StringAttribute{
+						Required:    true,
+						Description: ServiceConfigDescLabel,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					ServiceConfigFieldEnabled: schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(true),
+						Description: ServiceConfigDescEnabled,
+					},
+					ServiceConfigFieldPosition: schema.Int64Attribute{
+						Optional:    true,
+						Description: ServiceConfigDescPosition,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					ServiceConfigFieldMatchSpecification: schema.ListNestedAttribute{
+						Required:    true,
+						Description: ServiceConfigDescMatchSpecification,
+						Validators: []validator.List{
+							listvalidator.SizeBetween(1, maxMatchingRules),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								ServiceConfigFieldMatchSpecificationKey: schema.StringAttribute{
+									Required:    true,
+									Description: ServiceConfigDescMatchSpecificationKey,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								ServiceConfigFieldMatchSpecificationValue: schema.StringAttribute{
+									Required:    true,
+									Description: ServiceConfigDescMatchSpecificationValue,
+								},
+							},
+						},
+					},
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+// ============================================================================
+// Resource Implementation
+// ============================================================================
+
+type serviceConfigResource struct {
+	metaData resourcehandle.ResourceMetaData
+}
+
+// MetaData returns the resource metadata
+func (r *serviceConfigResource) MetaData() *resourcehandle.ResourceMetaData {
+	return &r.metaData
+}
+
+// GetRestResource returns the REST resource for custom service configurations
+func (r *serviceConfigResource) GetRestResource(api client.InstanaAPI) rest.RestResource[*instanaapi.ServiceConfig] {
+	return instanaapi.From(api).ServiceConfigs()
+}
+
+// SetComputedFields sets computed fields in the plan (none for this resource)
+func (r *serviceConfigResource) SetComputedFields(_ context.Context, _ *tfsdk.Plan) diag.Diagnostics {
+	return nil
+}
+
+// ============================================================================
+// API to State Mapping
+// ============================================================================
+
+// UpdateState converts API data object to Terraform state. The position is only tracked when it is
+// configured, so that the order of configurations which are not positioned by terraform does not cause
+// any drift. On create and update the planned position is kept as positions beyond the end of the list
+// are moved to the end by the API. For the same reason a configured position at or beyond the end of the list is
+// kept on refresh as long as the configuration is the last one.
+func (r *serviceConfigResource) UpdateState(ctx context.Context, state *tfsdk.State, plan *tfsdk.Plan, config *instanaapi.ServiceConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var model ServiceConfigModel
+
+	if plan != nil {
+		diags.Append(plan.Get(ctx, &model)...)
+	} else if state != nil && !state.Raw.IsNull() {
+		diags.Append(state.Get(ctx, &model)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	position := model.Position
+	if plan == nil && !position.IsNull() && config.Position != nil {
+		actual := int64(*config.Position)
+		if !config.IsLast || position.ValueInt64() < actual {
+			position = types.Int64Value(actual)
+		}
+	}
+
+	matchSpecification := make([]ServiceMatchingRuleModel, len(config.MatchSpecification))
+	for i, rule := range config.MatchSpecification {
+		matchSpecification[i] = ServiceMatchingRuleModel{
+			Key:   types.StringValue(rule.Key),
+			Value: types.StringValue(rule.Value),
+		}
+	}
+
+	diags.Append(state.Set(ctx, ServiceConfigModel{
+		ID:                 types.StringValue(config.ID),
+		Name:               types.StringValue(config.Name),
+		Comment:            util.SetStringPointerToState(config.Comment),
+		Label:              types.StringValue(config.Label),
+		Enabled:            types.BoolValue(config.Enabled),
+		Position:           position,
+		MatchSpecification: matchSpecification,
+	})...)
+	return diags
+}
+
+// ============================================================================
+// State to API Mapping
+// ============================================================================
+
+// MapStateToDataObject converts Terraform state to API data object
+func (r *serviceConfigResource) MapStateToDataObject(ctx context.Context, plan *tfsdk.Plan, state *tfsdk.State) (*instanaapi.ServiceConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var model ServiceConfigModel
+
+	if plan != nil {
+		diags.Append(plan.Get(ctx, &model)...)
+	} else if state != nil {
+		diags.Append(state.Get(ctx, &model)...)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	matchSpecification := make([]instanaapi.ServiceMatchingRule, len(model.MatchSpecification))
+	for i, rule := range model.MatchSpecification {
+		matchSpecification[i] = instanaapi.ServiceMatchingRule{
+			Key:   rule.Key.ValueString(),
+			Value: rule.Value.ValueString(),
+		}
+	}
+
+	var position *int
+	if !model.Position.IsNull() && !model.Position.IsUnknown() {
+		value := int(model.Position.ValueInt64())
+		position = &value
+	}
+
+	return &instanaapi.ServiceConfig{
+		ID:                 model.ID.ValueString(),
+		Name:               model.Name.ValueString(),
+		Comment:            util.SetStringPointerFromState(model.Comment),
+		Label:              model.Label.ValueString(),
+		Enabled:            model.Enabled.ValueBool(),
+		MatchSpecification: matchSpecification,
+		Position:           position,
+	}, diags
+}
+
+// GetStateUpgraders returns the state upgraders for this resource (none needed for v0)
+func (r *serviceConfigResource) GetStateUpgraders(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
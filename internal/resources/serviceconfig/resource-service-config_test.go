@@ -0,0 +1,222 @@
+package serviceconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testServiceConfigID = "service-config-id"
+
+func intPtr(i int) *int { return &i }
+
+func strPtr(s string) *string { return &s }
+
+func TestNewServiceConfigResourceHandle(t *testing.T) {
+	t.Run("should create resource handle with correct metadata", func(t *testing.T) {
+		handle := NewServiceConfigResourceHandle()
+
+		require.NotNil(t, handle)
+		metadata := handle.MetaData()
+		assert.Equal(t, ResourceInstanaServiceConfig, metadata.ResourceName)
+		assert.Equal(t, int64(0), metadata.SchemaVersion)
+		assert.True(t, metadata.SkipIDGeneration)
+	})
+
+	t.Run("should have correct schema attributes", func(t *testing.T) {
+		s := NewServiceConfigResourceHandle().MetaData().Schema
+
+		assert.True(t, s.Attributes[ServiceConfigFieldID].IsComputed())
+		assert.True(t, s.Attributes[ServiceConfigFieldName].IsRequired())
+		assert.True(t, s.Attributes[ServiceConfigFieldComment].IsOptional())
+		assert.True(t, s.Attributes[ServiceConfigFieldLabel].IsRequired())
+		assert.True(t, s.Attributes[ServiceConfigFieldEnabled].IsOptional())
+		assert.True(t, s.Attributes[ServiceConfigFieldPosition].IsOptional())
+		assert.False(t, s.Attributes[ServiceConfigFieldPosition].IsComputed())
+		assert.True(t, s.Attributes[ServiceConfigFieldMatchSpecification].IsRequired())
+	})
+
+	t.Run("should return no state upgraders", func(t *testing.T) {
+		assert.Empty(t, NewServiceConfigResourceHandle().GetStateUpgraders(context.Background()))
+	})
+}
+
+type mockServiceConfigAPI struct {
+	testutils.MockInstanaAPI
+	restResource rest.RestResource[*instanaapi.ServiceConfig]
+}
+
+func (m *mockServiceConfigAPI) ServiceConfigs() rest.RestResource[*instanaapi.ServiceConfig] {
+	return m.restResource
+}
+
+func TestServiceConfigGetRestResource(t *testing.T) {
+	restResource := instanaapi.NewServiceConfigRestResource(nil)
+
+	result := NewServiceConfigResourceHandle().GetRestResource(&mockServiceConfigAPI{restResource: restResource})
+
+	assert.Same(t, restResource, result)
+}
+
+func newFullModel() ServiceConfigModel {
+	return ServiceConfigModel{
+		ID:       types.StringValue(testServiceConfigID),
+		Name:     types.StringValue("kubernetes"),
+		Comment:  types.StringValue("name services by container"),
+		Label:    types.StringValue("{kubernetes.container.name}"),
+		Enabled:  types.BoolValue(true),
+		Position: types.Int64Value(1),
+		MatchSpecification: []ServiceMatchingRuleModel{
+			{Key: types.StringValue("kubernetes.namespace.name"), Value: types.StringValue("shop")},
+			{Key: types.StringValue("kubernetes.container.name"), Value: types.StringValue("*")},
+		},
+	}
+}
+
+func newFullAPIObject() *instanaapi.ServiceConfig {
+	return &instanaapi.ServiceConfig{
+		ID:      testServiceConfigID,
+		Name:    "kubernetes",
+		Comment: strPtr("name services by container"),
+		Label:   "{kubernetes.container.name}",
+		Enabled: true,
+		MatchSpecification: []instanaapi.ServiceMatchingRule{
+			{Key: "kubernetes.namespace.name", Value: "shop"},
+			{Key: "kubernetes.container.name", Value: "*"},
+		},
+		Position: intPtr(1),
+	}
+}
+
+func TestServiceConfigMapStateToDataObject(t *testing.T) {
+	ctx := context.Background()
+	handle := NewServiceConfigResourceHandle()
+
+	t.Run("should map complete model from plan", func(t *testing.T) {
+		plan := &tfsdk.Plan{Schema: handle.MetaData().Schema}
+		require.False(t, plan.Set(ctx, newFullModel()).HasError())
+
+		result, diags := handle.MapStateToDataObject(ctx, plan, nil)
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, newFullAPIObject(), result)
+	})
+
+	t.Run("should map model without position and comment", func(t *testing.T) {
+		model := newFullModel()
+		model.Position = types.Int64Null()
+		model.Comment = types.StringNull()
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+		require.False(t, state.Set(ctx, model).HasError())
+
+		result, diags := handle.MapStateToDataObject(ctx, nil, state)
+
+		require.False(t, diags.HasError())
+		assert.Nil(t, result.Position)
+		assert.Nil(t, result.Comment)
+	})
+}
+
+func TestServiceConfigUpdateState(t *testing.T) {
+	ctx := context.Background()
+	handle := NewServiceConfigResourceHandle()
+
+	t.Run("should keep planned position on create and update", func(t *testing.T) {
+		plan := &tfsdk.Plan{Schema: handle.MetaData().Schema}
+		model := newFullModel()
+		model.ID = types.StringUnknown()
+		model.Position = types.Int64Value(10)
+		require.False(t, plan.Set(ctx, model).HasError())
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+
+		diags := handle.UpdateState(ctx, state, plan, newFullAPIObject())
+
+		require.False(t, diags.HasError())
+		var result ServiceConfigModel
+		require.False(t, state.Get(ctx, &result).HasError())
+		assert.Equal(t, testServiceConfigID, result.ID.ValueString())
+		assert.Equal(t, int64(10), result.Position.ValueInt64())
+	})
+
+	t.Run("should refresh actual position when position is managed", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+		model := newFullModel()
+		model.Position = types.Int64Value(0)
+		require.False(t, state.Set(ctx, model).HasError())
+
+		diags := handle.UpdateState(ctx, state, nil, newFullAPIObject())
+
+		require.False(t, diags.HasError())
+		var result ServiceConfigModel
+		require.False(t, state.Get(ctx, &result).HasError())
+		assert.Equal(t, newFullModel(), result)
+	})
+
+	t.Run("should keep configured position beyond the end of the list when configuration is the last one", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+		model := newFullModel()
+		model.Position = types.Int64Value(10)
+		require.False(t, state.Set(ctx, model).HasError())
+		apiObject := newFullAPIObject()
+		apiObject.Position = intPtr(2)
+		apiObject.IsLast = true
+
+		diags := handle.UpdateState(ctx, state, nil, apiObject)
+
+		require.False(t, diags.HasError())
+		var result ServiceConfigModel
+		require.False(t, state.Get(ctx, &result).HasError())
+		assert.Equal(t, int64(10), result.Position.ValueInt64())
+	})
+
+	t.Run("should refresh actual position beyond the end of the list when configuration is not the last one", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+		model := newFullModel()
+		model.Position = types.Int64Value(10)
+		require.False(t, state.Set(ctx, model).HasError())
+
+		diags := handle.UpdateState(ctx, state, nil, newFullAPIObject())
+
+		require.False(t, diags.HasError())
+		var result ServiceConfigModel
+		require.False(t, state.Get(ctx, &result).HasError())
+		assert.Equal(t, int64(1), result.Position.ValueInt64())
+	})
+
+	t.Run("should refresh actual position when configuration moved to the end of the list", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+		model := newFullModel()
+		model.Position = types.Int64Value(0)
+		require.False(t, state.Set(ctx, model).HasError())
+		apiObject := newFullAPIObject()
+		apiObject.Position = intPtr(2)
+		apiObject.IsLast = true
+
+		diags := handle.UpdateState(ctx, state, nil, apiObject)
+
+		require.False(t, diags.HasError())
+		var result ServiceConfigModel
+		require.False(t, state.Get(ctx, &result).HasError())
+		assert.Equal(t, int64(2), result.Position.ValueInt64())
+	})
+
+	t.Run("should not track position when position is not managed", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+
+		diags := handle.UpdateState(ctx, state, nil, newFullAPIObject())
+
+		require.False(t, diags.HasError())
+		var result ServiceConfigModel
+		require.False(t, state.Get(ctx, &result).HasError())
+		assert.True(t, result.Position.IsNull())
+		assert.Equal(t, "kubernetes", result.Name.ValueString())
+		assert.Len(t, result.MatchSpecification, 2)
+	})
+}
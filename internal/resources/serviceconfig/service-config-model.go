@@ -0,0 +1,20 @@
+package serviceconfig
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// ServiceConfigModel represents the data model for a custom service configuration
+type ServiceConfigModel struct {
+	ID                 types.String               `tfsdk:"id"`
+	Name               types.String               `tfsdk:"name"`
+	Comment            types.String               `tfsdk:"comment"`
+	Label              types.String               `tfsdk:"label"`
+	Enabled            types.Bool                 `tfsdk:"enabled"`
+	Position           types.Int64                `tfsdk:"position"`
+	MatchSpecification []ServiceMatchingRuleModel `tfsdk:"match_specification"`
+}
+
+// ServiceMatchingRuleModel represents a single key/value rule of a custom service configuration
+type ServiceMatchingRuleModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
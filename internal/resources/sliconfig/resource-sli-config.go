@@ -19,6 +19,9 @@ import (
 	"github.com/instana/instana-go-client/shared/rest"
 	tag "github.com/instana/instana-go-client/shared/tagfilter"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/applicationconfig"
+	"github.com/instana/terraform-provider-instana/internal/resources/websitemonitoringconfig"
+	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
 	"github.com/instana/terraform-provider-instana/internal/util"
 )
@@ -27,10 +30,15 @@ import (
 func NewSliConfigResourceHandle() resourcehandle.ResourceHandle[*api.SliConfig] {
 	return &sliConfigResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName:  ResourceInstanaSliConfig,
-			Schema:        buildSliConfigSchema(),
-			SchemaVersion: 2,
-			CreateOnly:    true,
+			ResourceName:        ResourceInstanaSliConfig,
+			ImportNameAttribute: SchemaFieldName,
+			Schema:              buildSliConfigSchema(),
+			SchemaVersion:       2,
+			CreateOnly:          true,
+			ReferenceAttributes: map[string]string{
+				SchemaFieldApplicationID: applicationconfig.ResourceInstanaApplicationConfig,
+				SchemaFieldWebsiteID:     websitemonitoringconfig.ResourceInstanaWebsiteMonitoringConfig,
+			},
 		},
 	}
 }
@@ -270,6 +278,13 @@ func buildBadEventFilterExpressionAttribute() schema.StringAttribute {
 	return schema.StringAttribute{
 		Required:    true,
 		Description: SliConfigDescBadEventFilterExpression,
+		CustomType:  shared.TagFilterType{},
+		Validators: []validator.String{
+			shared.TagFilterValidator(),
+		},
+		PlanModifiers: []planmodifier.String{
+			shared.TagFilterPlanModifier(),
+		},
 	}
 }
 
@@ -278,6 +293,13 @@ func buildGoodEventFilterExpressionAttribute() schema.StringAttribute {
 	return schema.StringAttribute{
 		Required:    true,
 		Description: SliConfigDescGoodEventFilterExpression,
+		CustomType:  shared.TagFilterType{},
+		Validators: []validator.String{
+			shared.TagFilterValidator(),
+		},
+		PlanModifiers: []planmodifier.String{
+			shared.TagFilterPlanModifier(),
+		},
 	}
 }
 
@@ -330,6 +352,13 @@ func buildFilterExpressionAttribute() schema.StringAttribute {
 	return schema.StringAttribute{
 		Optional:    true,
 		Description: SliConfigDescFilterExpression,
+		CustomType:  shared.TagFilterType{},
+		Validators: []validator.String{
+			shared.TagFilterValidator(),
+		},
+		PlanModifiers: []planmodifier.String{
+			shared.TagFilterPlanModifier(),
+		},
 	}
 }
 
@@ -507,17 +536,17 @@ func (r *sliConfigResource) mapWebsiteTimeBasedToState(sliEntity api.SliEntity)
 }
 
 // mapTagFilterToString converts tag filter to normalized string representation
-func (r *sliConfigResource) mapTagFilterToString(tagFilter *tag.TagFilter, context string) (types.String, error) {
+func (r *sliConfigResource) mapTagFilterToString(tagFilter *tag.TagFilter, context string) (shared.TagFilterValue, error) {
 	if tagFilter == nil {
-		return types.StringNull(), nil
+		return shared.NewTagFilterNull(), nil
 	}
 
 	filterStr, err := tagfilter.MapTagFilterToNormalizedString(tagFilter)
 	if err != nil {
-		return types.StringNull(), fmt.Errorf(ErrMsgFailedToParseFilterExpression, context, err)
+		return shared.NewTagFilterNull(), fmt.Errorf(ErrMsgFailedToParseFilterExpression, context, err)
 	}
 
-	return util.SetStringPointerToState(filterStr), nil
+	return shared.NewTagFilterPointerValue(filterStr), nil
 }
 
 // mapBooleanPointerToState converts boolean pointer to state value with default fallback
@@ -704,7 +733,7 @@ func (r *sliConfigResource) mapWebsiteTimeBasedFromState(model *WebsiteTimeBased
 }
 
 // mapEventFilterExpressions maps bad and good event filter expressions to entity
-func (r *sliConfigResource) mapEventFilterExpressions(entity *api.SliEntity, badFilter, goodFilter types.String) error {
+func (r *sliConfigResource) mapEventFilterExpressions(entity *api.SliEntity, badFilter, goodFilter shared.TagFilterValue) error {
 	if !badFilter.IsNull() {
 		badEventFilter, err := r.parseTagFilterString(badFilter.ValueString(), ErrMsgBadEventFilterContext)
 		if err != nil {
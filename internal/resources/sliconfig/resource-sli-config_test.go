@@ -12,6 +12,7 @@ import (
 	tag "github.com/instana/instana-go-client/shared/tagfilter"
 	common "github.com/instana/instana-go-client/shared/types"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -614,8 +615,8 @@ func TestMapStateToDataObject_ApplicationEventBased_Basic(t *testing.T) {
 			ApplicationEventBased: &ApplicationEventBasedModel{
 				ApplicationID:             types.StringValue("app-123"),
 				BoundaryScope:             types.StringValue("ALL"),
-				GoodEventFilterExpression: types.StringValue("call.http.status EQUALS '200'"),
-				BadEventFilterExpression:  types.StringValue("call.http.status EQUALS '500'"),
+				GoodEventFilterExpression: shared.NewTagFilterValue("call.http.status EQUALS '200'"),
+				BadEventFilterExpression:  shared.NewTagFilterValue("call.http.status EQUALS '500'"),
 				IncludeInternal:           types.BoolValue(false),
 				IncludeSynthetic:          types.BoolValue(false),
 			},
@@ -644,8 +645,8 @@ func TestMapStateToDataObject_ApplicationEventBased_WithIncludeFlags(t *testing.
 			ApplicationEventBased: &ApplicationEventBasedModel{
 				ApplicationID:             types.StringValue("app-123"),
 				BoundaryScope:             types.StringValue("INBOUND"),
-				GoodEventFilterExpression: types.StringValue("call.http.status EQUALS '200'"),
-				BadEventFilterExpression:  types.StringValue("call.http.status EQUALS '500'"),
+				GoodEventFilterExpression: shared.NewTagFilterValue("call.http.status EQUALS '200'"),
+				BadEventFilterExpression:  shared.NewTagFilterValue("call.http.status EQUALS '500'"),
 				IncludeInternal:           types.BoolValue(true),
 				IncludeSynthetic:          types.BoolValue(true),
 			},
@@ -674,8 +675,8 @@ func TestMapStateToDataObject_ApplicationEventBased_WithNullIncludeFlags(t *test
 			ApplicationEventBased: &ApplicationEventBasedModel{
 				ApplicationID:             types.StringValue("app-123"),
 				BoundaryScope:             types.StringValue("ALL"),
-				GoodEventFilterExpression: types.StringValue("call.http.status EQUALS '200'"),
-				BadEventFilterExpression:  types.StringValue("call.http.status EQUALS '500'"),
+				GoodEventFilterExpression: shared.NewTagFilterValue("call.http.status EQUALS '200'"),
+				BadEventFilterExpression:  shared.NewTagFilterValue("call.http.status EQUALS '500'"),
 				IncludeInternal:           types.BoolNull(),
 				IncludeSynthetic:          types.BoolNull(),
 			},
@@ -705,8 +706,8 @@ func TestMapStateToDataObject_WebsiteEventBased_Basic(t *testing.T) {
 			WebsiteEventBased: &WebsiteEventBasedModel{
 				WebsiteID:                 types.StringValue("website-123"),
 				BeaconType:                types.StringValue("pageLoad"),
-				GoodEventFilterExpression: types.StringValue("beacon.page.name EQUALS 'home'"),
-				BadEventFilterExpression:  types.StringValue("beacon.error EQUALS 'true'"),
+				GoodEventFilterExpression: shared.NewTagFilterValue("beacon.page.name EQUALS 'home'"),
+				BadEventFilterExpression:  shared.NewTagFilterValue("beacon.error EQUALS 'true'"),
 			},
 		},
 	})
@@ -741,7 +742,7 @@ func TestMapStateToDataObject_WebsiteTimeBased_Basic(t *testing.T) {
 			WebsiteTimeBased: &WebsiteTimeBasedModel{
 				WebsiteID:        types.StringValue("website-123"),
 				BeaconType:       types.StringValue("pageLoad"),
-				FilterExpression: types.StringValue("beacon.page.name EQUALS 'home'"),
+				FilterExpression: shared.NewTagFilterValue("beacon.page.name EQUALS 'home'"),
 			},
 		},
 	})
@@ -773,7 +774,7 @@ func TestMapStateToDataObject_WebsiteTimeBased_WithoutFilterExpression(t *testin
 			WebsiteTimeBased: &WebsiteTimeBasedModel{
 				WebsiteID:        types.StringValue("website-123"),
 				BeaconType:       types.StringValue("httpRequest"),
-				FilterExpression: types.StringNull(),
+				FilterExpression: shared.NewTagFilterNull(),
 			},
 		},
 	})
@@ -900,8 +901,8 @@ func TestMapStateToDataObject_ApplicationEventBased_InvalidGoodEventFilter(t *te
 			ApplicationEventBased: &ApplicationEventBasedModel{
 				ApplicationID:             types.StringValue("app-123"),
 				BoundaryScope:             types.StringValue("ALL"),
-				GoodEventFilterExpression: types.StringValue("invalid filter syntax"),
-				BadEventFilterExpression:  types.StringValue("call.http.status EQUALS '500'"),
+				GoodEventFilterExpression: shared.NewTagFilterValue("invalid filter syntax"),
+				BadEventFilterExpression:  shared.NewTagFilterValue("call.http.status EQUALS '500'"),
 			},
 		},
 	})
@@ -922,8 +923,8 @@ func TestMapStateToDataObject_ApplicationEventBased_InvalidBadEventFilter(t *tes
 			ApplicationEventBased: &ApplicationEventBasedModel{
 				ApplicationID:             types.StringValue("app-123"),
 				BoundaryScope:             types.StringValue("ALL"),
-				GoodEventFilterExpression: types.StringValue("call.http.status EQUALS '200'"),
-				BadEventFilterExpression:  types.StringValue("invalid filter syntax"),
+				GoodEventFilterExpression: shared.NewTagFilterValue("call.http.status EQUALS '200'"),
+				BadEventFilterExpression:  shared.NewTagFilterValue("invalid filter syntax"),
 			},
 		},
 	})
@@ -944,8 +945,8 @@ func TestMapStateToDataObject_WebsiteEventBased_InvalidGoodEventFilter(t *testin
 			WebsiteEventBased: &WebsiteEventBasedModel{
 				WebsiteID:                 types.StringValue("website-123"),
 				BeaconType:                types.StringValue("pageLoad"),
-				GoodEventFilterExpression: types.StringValue("invalid filter syntax"),
-				BadEventFilterExpression:  types.StringValue("beacon.error EQUALS 'true'"),
+				GoodEventFilterExpression: shared.NewTagFilterValue("invalid filter syntax"),
+				BadEventFilterExpression:  shared.NewTagFilterValue("beacon.error EQUALS 'true'"),
 			},
 		},
 	})
@@ -966,7 +967,7 @@ func TestMapStateToDataObject_WebsiteTimeBased_InvalidFilterExpression(t *testin
 			WebsiteTimeBased: &WebsiteTimeBasedModel{
 				WebsiteID:        types.StringValue("website-123"),
 				BeaconType:       types.StringValue("pageLoad"),
-				FilterExpression: types.StringValue("invalid filter syntax"),
+				FilterExpression: shared.NewTagFilterValue("invalid filter syntax"),
 			},
 		},
 	})
@@ -1165,8 +1166,8 @@ func TestMapStateToDataObject_ApplicationEventBased_WithNullGoodEventFilter(t *t
 			ApplicationEventBased: &ApplicationEventBasedModel{
 				ApplicationID:             types.StringValue("app-123"),
 				BoundaryScope:             types.StringValue("ALL"),
-				GoodEventFilterExpression: types.StringNull(),
-				BadEventFilterExpression:  types.StringValue("call.http.status EQUALS '500'"),
+				GoodEventFilterExpression: shared.NewTagFilterNull(),
+				BadEventFilterExpression:  shared.NewTagFilterValue("call.http.status EQUALS '500'"),
 			},
 		},
 	})
@@ -1191,8 +1192,8 @@ func TestMapStateToDataObject_ApplicationEventBased_WithNullBadEventFilter(t *te
 			ApplicationEventBased: &ApplicationEventBasedModel{
 				ApplicationID:             types.StringValue("app-123"),
 				BoundaryScope:             types.StringValue("ALL"),
-				GoodEventFilterExpression: types.StringValue("call.http.status EQUALS '200'"),
-				BadEventFilterExpression:  types.StringNull(),
+				GoodEventFilterExpression: shared.NewTagFilterValue("call.http.status EQUALS '200'"),
+				BadEventFilterExpression:  shared.NewTagFilterNull(),
 			},
 		},
 	})
@@ -1217,8 +1218,8 @@ func TestMapStateToDataObject_WebsiteEventBased_WithNullGoodEventFilter(t *testi
 			WebsiteEventBased: &WebsiteEventBasedModel{
 				WebsiteID:                 types.StringValue("website-123"),
 				BeaconType:                types.StringValue("pageLoad"),
-				GoodEventFilterExpression: types.StringNull(),
-				BadEventFilterExpression:  types.StringValue("beacon.error EQUALS 'true'"),
+				GoodEventFilterExpression: shared.NewTagFilterNull(),
+				BadEventFilterExpression:  shared.NewTagFilterValue("beacon.error EQUALS 'true'"),
 			},
 		},
 	})
@@ -1243,8 +1244,8 @@ func TestMapStateToDataObject_WebsiteEventBased_WithNullBadEventFilter(t *testin
 			WebsiteEventBased: &WebsiteEventBasedModel{
 				WebsiteID:                 types.StringValue("website-123"),
 				BeaconType:                types.StringValue("pageLoad"),
-				GoodEventFilterExpression: types.StringValue("beacon.page.name EQUALS 'home'"),
-				BadEventFilterExpression:  types.StringNull(),
+				GoodEventFilterExpression: shared.NewTagFilterValue("beacon.page.name EQUALS 'home'"),
+				BadEventFilterExpression:  shared.NewTagFilterNull(),
 			},
 		},
 	})
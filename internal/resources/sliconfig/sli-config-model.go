@@ -3,6 +3,7 @@ package sliconfig
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/shared"
 )
 
 // SliConfigModel represents the data model for SLI configuration
@@ -39,29 +40,29 @@ type ApplicationTimeBasedModel struct {
 
 // ApplicationEventBasedModel represents the application event based SLI entity
 type ApplicationEventBasedModel struct {
-	ApplicationID             types.String `tfsdk:"application_id"`
-	BoundaryScope             types.String `tfsdk:"boundary_scope"`
-	BadEventFilterExpression  types.String `tfsdk:"bad_event_filter_expression"`
-	GoodEventFilterExpression types.String `tfsdk:"good_event_filter_expression"`
-	IncludeInternal           types.Bool   `tfsdk:"include_internal"`
-	IncludeSynthetic          types.Bool   `tfsdk:"include_synthetic"`
-	ServiceID                 types.String `tfsdk:"service_id"`
-	EndpointID                types.String `tfsdk:"endpoint_id"`
+	ApplicationID             types.String          `tfsdk:"application_id"`
+	BoundaryScope             types.String          `tfsdk:"boundary_scope"`
+	BadEventFilterExpression  shared.TagFilterValue `tfsdk:"bad_event_filter_expression"`
+	GoodEventFilterExpression shared.TagFilterValue `tfsdk:"good_event_filter_expression"`
+	IncludeInternal           types.Bool            `tfsdk:"include_internal"`
+	IncludeSynthetic          types.Bool            `tfsdk:"include_synthetic"`
+	ServiceID                 types.String          `tfsdk:"service_id"`
+	EndpointID                types.String          `tfsdk:"endpoint_id"`
 }
 
 // WebsiteEventBasedModel represents the website event based SLI entity
 type WebsiteEventBasedModel struct {
-	WebsiteID                 types.String `tfsdk:"website_id"`
-	BadEventFilterExpression  types.String `tfsdk:"bad_event_filter_expression"`
-	GoodEventFilterExpression types.String `tfsdk:"good_event_filter_expression"`
-	BeaconType                types.String `tfsdk:"beacon_type"`
+	WebsiteID                 types.String          `tfsdk:"website_id"`
+	BadEventFilterExpression  shared.TagFilterValue `tfsdk:"bad_event_filter_expression"`
+	GoodEventFilterExpression shared.TagFilterValue `tfsdk:"good_event_filter_expression"`
+	BeaconType                types.String          `tfsdk:"beacon_type"`
 }
 
 // WebsiteTimeBasedModel represents the website time based SLI entity
 type WebsiteTimeBasedModel struct {
-	WebsiteID        types.String `tfsdk:"website_id"`
-	FilterExpression types.String `tfsdk:"filter_expression"`
-	BeaconType       types.String `tfsdk:"beacon_type"`
+	WebsiteID        types.String          `tfsdk:"website_id"`
+	FilterExpression shared.TagFilterValue `tfsdk:"filter_expression"`
+	BeaconType       types.String          `tfsdk:"beacon_type"`
 }
 
 type sliConfigResource struct {
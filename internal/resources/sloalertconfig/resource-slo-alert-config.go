@@ -22,6 +22,9 @@ import (
 	"github.com/instana/instana-go-client/shared/rest"
 	model "github.com/instana/instana-go-client/shared/types"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/alertingchannel"
+	"github.com/instana/terraform-provider-instana/internal/resources/apdexconfig"
+	"github.com/instana/terraform-provider-instana/internal/resources/sloconfig"
 	"github.com/instana/terraform-provider-instana/internal/shared"
 )
 
@@ -29,10 +32,16 @@ import (
 func NewSloAlertConfigResourceHandle() resourcehandle.ResourceHandle[*api.SloAlertConfig] {
 	return &sloAlertConfigResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName:  ResourceInstanaSloAlertConfig,
-			Schema:        buildSloAlertConfigSchema(),
-			SchemaVersion: 2,
-			CreateOnly:    false,
+			ResourceName:        ResourceInstanaSloAlertConfig,
+			ImportNameAttribute: SchemaFieldName,
+			Schema:              buildSloAlertConfigSchema(),
+			SchemaVersion:       2,
+			CreateOnly:          false,
+			ReferenceAttributes: map[string]string{
+				SchemaFieldSloIds:          sloconfig.ResourceInstanaSloConfig,
+				SchemaFieldApdexIds:        apdexconfig.ResourceInstanaApdexConfig,
+				SchemaFieldAlertChannelIds: alertingchannel.ResourceInstanaAlertingChannel,
+			},
 		},
 	}
 }
@@ -23,6 +23,10 @@ import (
 	tag "github.com/instana/instana-go-client/shared/tagfilter"
 	common "github.com/instana/instana-go-client/shared/types"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/applicationconfig"
+	"github.com/instana/terraform-provider-instana/internal/resources/mobileappconfig"
+	"github.com/instana/terraform-provider-instana/internal/resources/websitemonitoringconfig"
+	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
 	"github.com/instana/terraform-provider-instana/internal/util"
 )
@@ -31,10 +35,16 @@ import (
 func NewSloConfigResourceHandle() resourcehandle.ResourceHandle[*api.SloConfig] {
 	return &sloConfigResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName:     ResourceInstanaSloConfig,
-			Schema:           buildSloConfigSchema(),
-			SchemaVersion:    2,
-			SkipIDGeneration: true,
+			ResourceName:        ResourceInstanaSloConfig,
+			ImportNameAttribute: SloConfigFieldName,
+			Schema:              buildSloConfigSchema(),
+			SchemaVersion:       2,
+			SkipIDGeneration:    true,
+			ReferenceAttributes: map[string]string{
+				SloConfigFieldApplicationID: applicationconfig.ResourceInstanaApplicationConfig,
+				SloConfigFieldWebsiteID:     websitemonitoringconfig.ResourceInstanaWebsiteMonitoringConfig,
+				SloConfigFieldMobileIDs:     mobileappconfig.ResourceInstanaMobileAppConfig,
+			},
 		},
 	}
 }
@@ -169,6 +179,13 @@ func buildApplicationEntityAttribute() schema.SingleNestedAttribute {
 			SloConfigFieldFilterExpression: schema.StringAttribute{
 				Optional:    true,
 				Description: SloConfigDescEntityFilter,
+				CustomType:  shared.TagFilterType{},
+				Validators: []validator.String{
+					shared.TagFilterValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					shared.TagFilterPlanModifier(),
+				},
 			},
 			SloConfigFieldIncludeInternal: schema.BoolAttribute{
 				Optional:    true,
@@ -205,6 +222,13 @@ func buildWebsiteEntityAttribute() schema.SingleNestedAttribute {
 			SloConfigFieldFilterExpression: schema.StringAttribute{
 				Optional:    true,
 				Description: SloConfigDescEntityFilter,
+				CustomType:  shared.TagFilterType{},
+				Validators: []validator.String{
+					shared.TagFilterValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					shared.TagFilterPlanModifier(),
+				},
 			},
 			SloConfigFieldBeaconType: schema.StringAttribute{
 				Optional:    true,
@@ -233,6 +257,13 @@ func buildSyntheticEntityAttribute() schema.SingleNestedAttribute {
 			SloConfigFieldFilterExpression: schema.StringAttribute{
 				Optional:    true,
 				Description: SloConfigDescEntityFilter,
+				CustomType:  shared.TagFilterType{},
+				Validators: []validator.String{
+					shared.TagFilterValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					shared.TagFilterPlanModifier(),
+				},
 			},
 		},
 	}
@@ -251,6 +282,13 @@ func buildInfrastructureEntityAttribute() schema.SingleNestedAttribute {
 			SloConfigFieldFilterExpression: schema.StringAttribute{
 				Optional:    true,
 				Description: SloConfigDescEntityFilter,
+				CustomType:  shared.TagFilterType{},
+				Validators: []validator.String{
+					shared.TagFilterValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					shared.TagFilterPlanModifier(),
+				},
 			},
 		},
 	}
@@ -270,6 +308,13 @@ func buildMobileEntityAttribute() schema.SingleNestedAttribute {
 			SloConfigFieldFilterExpression: schema.StringAttribute{
 				Optional:    true,
 				Description: SloConfigDescEntityFilter,
+				CustomType:  shared.TagFilterType{},
+				Validators: []validator.String{
+					shared.TagFilterValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					shared.TagFilterPlanModifier(),
+				},
 			},
 		},
 	}
@@ -315,6 +360,13 @@ func buildEntityMetricAttribute() schema.SingleNestedAttribute {
 					SloConfigFieldFilterExpression: schema.StringAttribute{
 						Optional:    true,
 						Description: SloConfigDescEntityFilter,
+						CustomType:  shared.TagFilterType{},
+						Validators: []validator.String{
+							shared.TagFilterValidator(),
+						},
+						PlanModifiers: []planmodifier.String{
+							shared.TagFilterPlanModifier(),
+						},
 					},
 				},
 			},
@@ -474,10 +526,24 @@ func buildCustomIndicatorAttribute() schema.SingleNestedAttribute {
 			SloConfigFieldGoodEventFilterExpression: schema.StringAttribute{
 				Optional:    true,
 				Description: SloConfigDescGoodEventFilterExpression,
+				CustomType:  shared.TagFilterType{},
+				Validators: []validator.String{
+					shared.TagFilterValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					shared.TagFilterPlanModifier(),
+				},
 			},
 			SloConfigFieldBadEventFilterExpression: schema.StringAttribute{
 				Optional:    true,
 				Description: SloConfigDescBadEventFilterExpression,
+				CustomType:  shared.TagFilterType{},
+				Validators: []validator.String{
+					shared.TagFilterValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					shared.TagFilterPlanModifier(),
+				},
 			},
 		},
 	}
@@ -962,7 +1028,7 @@ func (r *sloConfigResource) buildInfrastructureEntity(model *InfrastructureEntit
 }
 
 // mapFilterExpressionToEntity converts filter expression to API model
-func (r *sloConfigResource) mapFilterExpressionToEntity(filterExpression types.String) (*tag.TagFilter, diag.Diagnostics) {
+func (r *sloConfigResource) mapFilterExpressionToEntity(filterExpression shared.TagFilterValue) (*tag.TagFilter, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	if !filterExpression.IsNull() && !filterExpression.IsUnknown() {
@@ -1297,15 +1363,15 @@ func (r *sloConfigResource) mapEntityMetricToModel(metric *api.SloEntityMetric)
 				rendered := expr.Render()
 				// only store if it's a non-empty expression
 				if rendered != "" {
-					scopeModel.FilterExpression = types.StringValue(rendered)
+					scopeModel.FilterExpression = shared.NewTagFilterValue(rendered)
 				} else {
-					scopeModel.FilterExpression = types.StringNull()
+					scopeModel.FilterExpression = shared.NewTagFilterNull()
 				}
 			} else {
-				scopeModel.FilterExpression = types.StringNull()
+				scopeModel.FilterExpression = shared.NewTagFilterNull()
 			}
 		} else {
-			scopeModel.FilterExpression = types.StringNull()
+			scopeModel.FilterExpression = shared.NewTagFilterNull()
 		}
 		m.Scope = scopeModel
 	}
@@ -1667,23 +1733,23 @@ func (r *sloConfigResource) mapMobileEntityToState(entity api.SloEntity) (Mobile
 }
 
 // mapFilterExpressionToState converts filter expression from API to state
-func (r *sloConfigResource) mapFilterExpressionToState(filterExpression *tag.TagFilter) (types.String, diag.Diagnostics) {
+func (r *sloConfigResource) mapFilterExpressionToState(filterExpression *tag.TagFilter) (shared.TagFilterValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	if filterExpression == nil {
-		return types.StringNull(), diags
+		return shared.NewTagFilterNull(), diags
 	}
 
 	filterExprStr, err := tagfilter.MapTagFilterToNormalizedString(filterExpression)
 	if err != nil {
 		diags.AddError(SloConfigErrNormalizingFilterExpression, fmt.Sprintf(SloConfigErrNormalizingFilterExpressionMsg, err))
-		return types.StringNull(), diags
+		return shared.NewTagFilterNull(), diags
 	}
 
 	if filterExprStr != nil {
-		return util.SetStringPointerToState(filterExprStr), diags
+		return shared.NewTagFilterPointerValue(filterExprStr), diags
 	}
-	return types.StringNull(), diags
+	return shared.NewTagFilterNull(), diags
 }
 
 func (r *sloConfigResource) mapIndicatorToState(apiObject *api.SloConfig, sloConfigModel *SloConfigModel) (IndicatorModel, diag.Diagnostics) {
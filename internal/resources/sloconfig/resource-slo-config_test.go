@@ -11,6 +11,7 @@ import (
 	"github.com/instana/instana-go-client/api"
 	tag "github.com/instana/instana-go-client/shared/tagfilter"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -72,7 +73,7 @@ func TestMapStateToDataObject_ApplicationEntity(t *testing.T) {
 				EndpointID:       types.StringValue("endpoint-123"),
 				IncludeInternal:  types.BoolValue(true),
 				IncludeSynthetic: types.BoolValue(false),
-				FilterExpression: types.StringValue("entity.tag.name EQUALS 'value'"),
+				FilterExpression: shared.NewTagFilterValue("entity.tag.name EQUALS 'value'"),
 			},
 		}
 
@@ -124,7 +125,7 @@ func TestMapStateToDataObject_WebsiteEntity(t *testing.T) {
 			WebsiteEntityModel: &WebsiteEntityModel{
 				WebsiteID:        types.StringValue("website-123"),
 				BeaconType:       types.StringValue("pageLoad"),
-				FilterExpression: types.StringValue("entity.tag.name EQUALS 'value'"),
+				FilterExpression: shared.NewTagFilterValue("entity.tag.name EQUALS 'value'"),
 			},
 		}
 
@@ -161,7 +162,7 @@ func TestMapStateToDataObject_SyntheticEntity(t *testing.T) {
 		model := EntityModel{
 			SyntheticEntityModel: &SyntheticEntityModel{
 				SyntheticTestIDs: testIDs,
-				FilterExpression: types.StringValue("entity.tag.name EQUALS 'value'"),
+				FilterExpression: shared.NewTagFilterValue("entity.tag.name EQUALS 'value'"),
 			},
 		}
 
@@ -204,7 +205,7 @@ func TestMapStateToDataObject_InfrastructureEntity(t *testing.T) {
 		entityModel := EntityModel{
 			InfrastructureEntityModel: &InfrastructureEntityModel{
 				InfraType:        types.StringValue("host"),
-				FilterExpression: types.StringValue("entity.tag.host.name EQUALS 'worker-1'"),
+				FilterExpression: shared.NewTagFilterValue("entity.tag.host.name EQUALS 'worker-1'"),
 			},
 		}
 
@@ -247,7 +248,7 @@ func TestMapStateToDataObject_InfrastructureEntity(t *testing.T) {
 		entityModel := EntityModel{
 			InfrastructureEntityModel: &InfrastructureEntityModel{
 				InfraType:        types.StringValue("kubernetes.cluster"),
-				FilterExpression: types.StringValue("invalid((expression"),
+				FilterExpression: shared.NewTagFilterValue("invalid((expression"),
 			},
 		}
 
@@ -521,8 +522,8 @@ func TestMapIndicatorFromState_Custom(t *testing.T) {
 	t.Run("should map custom indicator successfully with good events only", func(t *testing.T) {
 		model := IndicatorModel{
 			CustomIndicatorModel: &CustomIndicatorModel{
-				GoodEventFilterExpression: types.StringValue("entity.tag.status EQUALS 'success'"),
-				BadEventFilterExpression:  types.StringNull(),
+				GoodEventFilterExpression: shared.NewTagFilterValue("entity.tag.status EQUALS 'success'"),
+				BadEventFilterExpression:  shared.NewTagFilterNull(),
 			},
 		}
 
@@ -537,8 +538,8 @@ func TestMapIndicatorFromState_Custom(t *testing.T) {
 	t.Run("should map custom indicator with both good and bad events", func(t *testing.T) {
 		model := IndicatorModel{
 			CustomIndicatorModel: &CustomIndicatorModel{
-				GoodEventFilterExpression: types.StringValue("entity.tag.status EQUALS 'success'"),
-				BadEventFilterExpression:  types.StringValue("entity.tag.status EQUALS 'error'"),
+				GoodEventFilterExpression: shared.NewTagFilterValue("entity.tag.status EQUALS 'success'"),
+				BadEventFilterExpression:  shared.NewTagFilterValue("entity.tag.status EQUALS 'error'"),
 			},
 		}
 
@@ -552,7 +553,7 @@ func TestMapIndicatorFromState_Custom(t *testing.T) {
 	t.Run("should return error when good event filter is missing", func(t *testing.T) {
 		model := IndicatorModel{
 			CustomIndicatorModel: &CustomIndicatorModel{
-				GoodEventFilterExpression: types.StringNull(),
+				GoodEventFilterExpression: shared.NewTagFilterNull(),
 			},
 		}
 
@@ -723,7 +724,7 @@ func TestMapTagFilterFromState(t *testing.T) {
 	resource := &sloConfigResource{}
 
 	t.Run("should parse valid filter expression", func(t *testing.T) {
-		filterExpression := types.StringValue("entity.tag.name EQUALS 'value'")
+		filterExpression := shared.NewTagFilterValue("entity.tag.name EQUALS 'value'")
 
 		result, diags := resource.mapFilterExpressionToEntity(filterExpression)
 
@@ -732,7 +733,7 @@ func TestMapTagFilterFromState(t *testing.T) {
 	})
 
 	t.Run("should return default filter for null expression", func(t *testing.T) {
-		filterExpression := types.StringNull()
+		filterExpression := shared.NewTagFilterNull()
 
 		result, diags := resource.mapFilterExpressionToEntity(filterExpression)
 
@@ -742,7 +743,7 @@ func TestMapTagFilterFromState(t *testing.T) {
 	})
 
 	t.Run("should return error for invalid filter expression", func(t *testing.T) {
-		filterExpression := types.StringValue("invalid((filter")
+		filterExpression := shared.NewTagFilterValue("invalid((filter")
 
 		_, diags := resource.mapFilterExpressionToEntity(filterExpression)
 
@@ -1348,7 +1349,7 @@ func TestFilterExpressionErrorHandling(t *testing.T) {
 			ApplicationEntityModel: &ApplicationEntityModel{
 				ApplicationID:    types.StringValue("app-123"),
 				BoundaryScope:    types.StringValue("ALL"),
-				FilterExpression: types.StringValue("invalid((expression"),
+				FilterExpression: shared.NewTagFilterValue("invalid((expression"),
 			},
 		}
 
@@ -1362,7 +1363,7 @@ func TestFilterExpressionErrorHandling(t *testing.T) {
 			WebsiteEntityModel: &WebsiteEntityModel{
 				WebsiteID:        types.StringValue("website-123"),
 				BeaconType:       types.StringValue("pageLoad"),
-				FilterExpression: types.StringValue("invalid((expression"),
+				FilterExpression: shared.NewTagFilterValue("invalid((expression"),
 			},
 		}
 
@@ -1376,7 +1377,7 @@ func TestFilterExpressionErrorHandling(t *testing.T) {
 		model := EntityModel{
 			SyntheticEntityModel: &SyntheticEntityModel{
 				SyntheticTestIDs: testIDs,
-				FilterExpression: types.StringValue("invalid((expression"),
+				FilterExpression: shared.NewTagFilterValue("invalid((expression"),
 			},
 		}
 
@@ -1427,7 +1428,7 @@ func TestMapStateToDataObjectWithFullModel(t *testing.T) {
 					EndpointID:       types.StringValue("endpoint-123"),
 					IncludeInternal:  types.BoolValue(true),
 					IncludeSynthetic: types.BoolValue(false),
-					FilterExpression: types.StringValue("entity.tag.name EQUALS 'value'"),
+					FilterExpression: shared.NewTagFilterValue("entity.tag.name EQUALS 'value'"),
 				},
 			},
 			Indicator: &IndicatorModel{
@@ -1878,7 +1879,7 @@ func TestMapEntityFromState_MobileEntity(t *testing.T) {
 		entityModel := EntityModel{
 			MobileEntityModel: &MobileEntityModel{
 				MobileIDs:        mobileIDs,
-				FilterExpression: types.StringNull(),
+				FilterExpression: shared.NewTagFilterNull(),
 			},
 		}
 
@@ -1894,7 +1895,7 @@ func TestMapEntityFromState_MobileEntity(t *testing.T) {
 		entityModel := EntityModel{
 			MobileEntityModel: &MobileEntityModel{
 				MobileIDs:        types.SetNull(types.StringType),
-				FilterExpression: types.StringNull(),
+				FilterExpression: shared.NewTagFilterNull(),
 			},
 		}
 
@@ -1910,7 +1911,7 @@ func TestMapEntityFromState_MobileEntity(t *testing.T) {
 		entityModel := EntityModel{
 			MobileEntityModel: &MobileEntityModel{
 				MobileIDs:        emptySet,
-				FilterExpression: types.StringNull(),
+				FilterExpression: shared.NewTagFilterNull(),
 			},
 		}
 
@@ -2,6 +2,7 @@ package sloconfig
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/terraform-provider-instana/internal/shared"
 )
 
 // SloConfigModel represents the data model for the SLO configuration resource
@@ -46,45 +47,45 @@ type IndicatorModel struct {
 
 // ApplicationEntityModel represents an application entity in the Terraform model
 type ApplicationEntityModel struct {
-	ApplicationID    types.String `tfsdk:"application_id"`
-	ServiceID        types.String `tfsdk:"service_id"`
-	EndpointID       types.String `tfsdk:"endpoint_id"`
-	BoundaryScope    types.String `tfsdk:"boundary_scope"`
-	IncludeSynthetic types.Bool   `tfsdk:"include_synthetic"`
-	IncludeInternal  types.Bool   `tfsdk:"include_internal"`
-	FilterExpression types.String `tfsdk:"filter_expression"`
+	ApplicationID    types.String          `tfsdk:"application_id"`
+	ServiceID        types.String          `tfsdk:"service_id"`
+	EndpointID       types.String          `tfsdk:"endpoint_id"`
+	BoundaryScope    types.String          `tfsdk:"boundary_scope"`
+	IncludeSynthetic types.Bool            `tfsdk:"include_synthetic"`
+	IncludeInternal  types.Bool            `tfsdk:"include_internal"`
+	FilterExpression shared.TagFilterValue `tfsdk:"filter_expression"`
 }
 
 // WebsiteEntityModel represents a website entity in the Terraform model
 type WebsiteEntityModel struct {
-	WebsiteID        types.String `tfsdk:"website_id"`
-	BeaconType       types.String `tfsdk:"beacon_type"`
-	FilterExpression types.String `tfsdk:"filter_expression"`
+	WebsiteID        types.String          `tfsdk:"website_id"`
+	BeaconType       types.String          `tfsdk:"beacon_type"`
+	FilterExpression shared.TagFilterValue `tfsdk:"filter_expression"`
 }
 
 // SyntheticEntityModel represents a synthetic entity in the Terraform model
 type SyntheticEntityModel struct {
-	SyntheticTestIDs              types.Set    `tfsdk:"synthetic_test_ids"`
-	IncludeUnscheduledTestResults types.Bool   `tfsdk:"include_unscheduled_test_results"`
-	FilterExpression              types.String `tfsdk:"filter_expression"`
+	SyntheticTestIDs              types.Set             `tfsdk:"synthetic_test_ids"`
+	IncludeUnscheduledTestResults types.Bool            `tfsdk:"include_unscheduled_test_results"`
+	FilterExpression              shared.TagFilterValue `tfsdk:"filter_expression"`
 }
 
 // InfrastructureEntityModel represents an infrastructure entity in the Terraform model
 type InfrastructureEntityModel struct {
-	InfraType        types.String `tfsdk:"infra_type"`
-	FilterExpression types.String `tfsdk:"filter_expression"`
+	InfraType        types.String          `tfsdk:"infra_type"`
+	FilterExpression shared.TagFilterValue `tfsdk:"filter_expression"`
 }
 
 // MobileEntityModel represents a mobile app entity in the Terraform model
 type MobileEntityModel struct {
-	MobileIDs        types.Set    `tfsdk:"mobile_ids"`
-	FilterExpression types.String `tfsdk:"filter_expression"`
+	MobileIDs        types.Set             `tfsdk:"mobile_ids"`
+	FilterExpression shared.TagFilterValue `tfsdk:"filter_expression"`
 }
 
 // EntityMetricScopeModel represents the scope nested inside a metric block
 type EntityMetricScopeModel struct {
-	ScopeType        types.String `tfsdk:"scope_type"`
-	FilterExpression types.String `tfsdk:"filter_expression"`
+	ScopeType        types.String          `tfsdk:"scope_type"`
+	FilterExpression shared.TagFilterValue `tfsdk:"filter_expression"`
 }
 
 // EntityMetricModel represents the metric nested block for threshold-based mobile indicators
@@ -145,8 +146,8 @@ type TrafficIndicatorModel struct {
 
 // CustomIndicatorModel represents a custom indicator in the Terraform model
 type CustomIndicatorModel struct {
-	GoodEventFilterExpression types.String `tfsdk:"good_event_filter_expression"`
-	BadEventFilterExpression  types.String `tfsdk:"bad_event_filter_expression"`
+	GoodEventFilterExpression shared.TagFilterValue `tfsdk:"good_event_filter_expression"`
+	BadEventFilterExpression  shared.TagFilterValue `tfsdk:"bad_event_filter_expression"`
 }
 
 // TimeBasedSaturationIndicatorModel represents a saturation indicator in the Terraform model
@@ -17,6 +17,7 @@ import (
 	"github.com/instana/instana-go-client/client"
 	"github.com/instana/instana-go-client/shared/rest"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/sloconfig"
 )
 
 // NewSloCorrectionConfigResourceHandle creates the resource handle for SLO Correction Config
@@ -28,9 +29,13 @@ func NewSloCorrectionConfigResourceHandle() resourcehandle.ResourceHandle[*api.S
 // initialize sets up the resource with metadata and schema
 func (r *sloCorrectionConfigResource) initialize() *sloCorrectionConfigResource {
 	r.metaData = resourcehandle.ResourceMetaData{
-		ResourceName:  ResourceInstanaSloCorrectionConfig,
-		Schema:        r.buildSchema(),
-		SchemaVersion: 2,
+		ResourceName:        ResourceInstanaSloCorrectionConfig,
+		ImportNameAttribute: SloCorrectionConfigFieldName,
+		Schema:              r.buildSchema(),
+		SchemaVersion:       2,
+		ReferenceAttributes: map[string]string{
+			SloCorrectionConfigFieldSloIds: sloconfig.ResourceInstanaSloConfig,
+		},
 	}
 	return r
 }
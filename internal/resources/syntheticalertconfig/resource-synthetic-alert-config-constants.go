@@ -25,6 +25,8 @@ const (
 	SyntheticAlertConfigFieldTimeThreshold = "time_threshold"
 	// SyntheticAlertConfigFieldGracePeriod constant value for the schema field grace_period
 	SyntheticAlertConfigFieldGracePeriod = "grace_period"
+	// SyntheticAlertConfigFieldEnabled constant value for the schema field enabled
+	SyntheticAlertConfigFieldEnabled = "enabled"
 	// SyntheticAlertConfigFieldID constant value for the schema field id
 	SyntheticAlertConfigFieldID = "id"
 	// SyntheticAlertConfigFieldCustomPayloadField constant value for the schema field custom_payload_field
@@ -66,6 +68,8 @@ const (
 	SyntheticAlertConfigDescAlertChannelIds = "A set of Alert Channel IDs."
 	// SyntheticAlertConfigDescGracePeriod description for the grace_period field
 	SyntheticAlertConfigDescGracePeriod = "The duration in milliseconds for which an alert remains open after conditions are no longer violated."
+	// SyntheticAlertConfigDescEnabled description for the enabled field
+	SyntheticAlertConfigDescEnabled = "Flag to enable or disable the alert configuration. Changes are applied via the enable and disable endpoints without a full update."
 	// SyntheticAlertConfigDescRule description for the rule block
 	SyntheticAlertConfigDescRule = "Configuration for the synthetic alert rule."
 	// SyntheticAlertConfigDescRuleAlertType description for the rule alert_type field
@@ -1,6 +1,9 @@
 package syntheticalertconfig
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+)
 
 // SyntheticAlertConfigModel represents the data model for the Synthetic Alert Config resource
 type SyntheticAlertConfigModel struct {
@@ -9,12 +12,13 @@ type SyntheticAlertConfigModel struct {
 	Description         types.String                      `tfsdk:"description"`
 	SyntheticTestIds    types.Set                         `tfsdk:"synthetic_test_ids"`
 	Severity            types.Int64                       `tfsdk:"severity"`
-	TagFilter           types.String                      `tfsdk:"tag_filter"`
+	TagFilter           shared.TagFilterValue             `tfsdk:"tag_filter"`
 	Rule                *SyntheticAlertRuleModel          `tfsdk:"rule"`
 	AlertChannelIds     types.Set                         `tfsdk:"alert_channel_ids"`
 	TimeThreshold       *SyntheticAlertTimeThresholdModel `tfsdk:"time_threshold"`
 	GracePeriod         types.Int64                       `tfsdk:"grace_period"`
 	CustomPayloadFields types.List                        `tfsdk:"custom_payload_field"`
+	Enabled             types.Bool                        `tfsdk:"enabled"`
 }
 
 // SyntheticAlertRuleModel represents the rule configuration for synthetic alerts
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -18,10 +19,11 @@ import (
 	"github.com/instana/instana-go-client/shared/rest"
 	tag "github.com/instana/instana-go-client/shared/tagfilter"
 	common "github.com/instana/instana-go-client/shared/types"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/alertingchannel"
 	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
-	"github.com/instana/terraform-provider-instana/internal/util"
 )
 
 // NewSyntheticAlertConfigResourceHandle creates the resource handle for Synthetic Alert Configuration
@@ -33,9 +35,13 @@ func NewSyntheticAlertConfigResourceHandle() resourcehandle.ResourceHandle[*api.
 // initialize sets up the resource with metadata and schema
 func (r *syntheticAlertConfigResource) initialize() *syntheticAlertConfigResource {
 	r.metaData = resourcehandle.ResourceMetaData{
-		ResourceName:  ResourceInstanaSyntheticAlertConfig,
-		Schema:        r.buildSchema(),
-		SchemaVersion: 2,
+		ResourceName:        ResourceInstanaSyntheticAlertConfig,
+		ImportNameAttribute: SyntheticAlertConfigFieldName,
+		Schema:              r.buildSchema(),
+		SchemaVersion:       2,
+		ReferenceAttributes: map[string]string{
+			SyntheticAlertConfigFieldAlertChannelIds: alertingchannel.ResourceInstanaAlertingChannel,
+		},
 	}
 	return r
 }
@@ -59,6 +65,7 @@ func (r *syntheticAlertConfigResource) buildSchemaAttributes() map[string]schema
 		SyntheticAlertConfigFieldTagFilter:          r.buildTagFilterAttribute(),
 		SyntheticAlertConfigFieldAlertChannelIds:    r.buildAlertChannelIdsAttribute(),
 		SyntheticAlertConfigFieldGracePeriod:        r.buildGracePeriodAttribute(),
+		SyntheticAlertConfigFieldEnabled:            r.buildEnabledAttribute(),
 		SyntheticAlertConfigFieldCustomPayloadField: shared.GetCustomPayloadFieldsSchema(),
 		SyntheticAlertConfigFieldRule:               r.buildRuleAttribute(),
 		SyntheticAlertConfigFieldTimeThreshold:      r.buildTimeThresholdAttribute(),
@@ -138,6 +145,13 @@ func (r *syntheticAlertConfigResource) buildTagFilterAttribute() schema.Attribut
 	return schema.StringAttribute{
 		Optional:    true,
 		Description: SyntheticAlertConfigDescTagFilter,
+		CustomType:  shared.TagFilterType{},
+		Validators: []validator.String{
+			shared.TagFilterValidator(),
+		},
+		PlanModifiers: []planmodifier.String{
+			shared.TagFilterPlanModifier(),
+		},
 	}
 }
 
@@ -158,6 +172,16 @@ func (r *syntheticAlertConfigResource) buildGracePeriodAttribute() schema.Attrib
 	}
 }
 
+// buildEnabledAttribute creates the enabled attribute schema
+func (r *syntheticAlertConfigResource) buildEnabledAttribute() schema.Attribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+		Description: SyntheticAlertConfigDescEnabled,
+	}
+}
+
 // buildRuleAttribute creates the rule nested attribute schema
 func (r *syntheticAlertConfigResource) buildRuleAttribute() schema.Attribute {
 	return schema.SingleNestedAttribute{
@@ -304,6 +328,21 @@ func (r *syntheticAlertConfigResource) GetRestResource(api client.InstanaAPI) re
 	return api.SyntheticAlertConfigs()
 }
 
+// EnabledAttribute implementation of resourcehandle.EnabledStateToggler
+func (r *syntheticAlertConfigResource) EnabledAttribute() string {
+	return SyntheticAlertConfigFieldEnabled
+}
+
+// SetEnabled enables or disables the synthetic alert configuration via the dedicated endpoints
+func (r *syntheticAlertConfigResource) SetEnabled(instanaAPI client.InstanaAPI, id string, enabled bool) error {
+	return instanaapi.From(instanaAPI).EnablementToggle(instanaapi.SyntheticAlertConfigsResourcePath).SetEnabled(id, enabled)
+}
+
+// IsEnabled reads the enabled flag of the synthetic alert configuration from the Instana API
+func (r *syntheticAlertConfigResource) IsEnabled(instanaAPI client.InstanaAPI, config *api.SyntheticAlertConfig) (bool, error) {
+	return instanaapi.From(instanaAPI).EnablementToggle(instanaapi.SyntheticAlertConfigsResourcePath).IsEnabled(config.ID)
+}
+
 func (r *syntheticAlertConfigResource) SetComputedFields(_ context.Context, _ *tfsdk.Plan) diag.Diagnostics {
 	return nil
 }
@@ -600,11 +639,11 @@ func (r *syntheticAlertConfigResource) mapGracePeriodToState(gracePeriod int64)
 }
 
 // mapTagFilterToState converts tag filter expression to state value
-func (r *syntheticAlertConfigResource) mapTagFilterToState(tagFilterExpression *tag.TagFilter) (types.String, diag.Diagnostics) {
+func (r *syntheticAlertConfigResource) mapTagFilterToState(tagFilterExpression *tag.TagFilter) (shared.TagFilterValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	if tagFilterExpression == nil {
-		return types.StringNull(), diags
+		return shared.NewTagFilterNull(), diags
 	}
 
 	normalizedTagFilterString, err := tagfilter.MapTagFilterToNormalizedString(tagFilterExpression)
@@ -613,14 +652,14 @@ func (r *syntheticAlertConfigResource) mapTagFilterToState(tagFilterExpression *
 			SyntheticAlertConfigErrNormalizingTagFilter,
 			SyntheticAlertConfigErrNormalizingTagFilterDetail+err.Error(),
 		)
-		return types.StringNull(), diags
+		return shared.NewTagFilterNull(), diags
 	}
 
 	if normalizedTagFilterString != nil {
-		return util.SetStringPointerToState(normalizedTagFilterString), diags
+		return shared.NewTagFilterPointerValue(normalizedTagFilterString), diags
 	}
 
-	return types.StringNull(), diags
+	return shared.NewTagFilterNull(), diags
 }
 
 // mapRuleToModel converts API rule to model rule
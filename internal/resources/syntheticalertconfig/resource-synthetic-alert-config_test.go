@@ -144,7 +144,7 @@ func TestMapStateToDataObject(t *testing.T) {
 			Name:        types.StringValue("Test Alert"),
 			Description: types.StringValue("Test Description"),
 			Severity:    types.Int64Value(5),
-			TagFilter:   types.StringNull(),
+			TagFilter:   shared.NewTagFilterNull(),
 			GracePeriod: types.Int64Value(60000),
 			Rule: &SyntheticAlertRuleModel{
 				AlertType:   types.StringValue("failure"),
@@ -195,7 +195,7 @@ func TestMapStateToDataObject(t *testing.T) {
 			Name:        types.StringValue("Plan Alert"),
 			Description: types.StringValue("Plan Description"),
 			Severity:    types.Int64Value(10),
-			TagFilter:   types.StringNull(),
+			TagFilter:   shared.NewTagFilterNull(),
 			GracePeriod: types.Int64Null(),
 			Rule: &SyntheticAlertRuleModel{
 				AlertType:   types.StringValue("failure"),
@@ -248,7 +248,7 @@ func TestMapStateToDataObject(t *testing.T) {
 			Name:        types.StringValue("Test"),
 			Description: types.StringValue("Desc"),
 			Severity:    types.Int64Value(5),
-			TagFilter:   types.StringNull(),
+			TagFilter:   shared.NewTagFilterNull(),
 			Rule: &SyntheticAlertRuleModel{
 				AlertType:  types.StringValue("failure"),
 				MetricName: types.StringValue("status"),
@@ -287,7 +287,7 @@ func TestMapStateToDataObject(t *testing.T) {
 			Name:        types.StringValue("Test"),
 			Description: types.StringValue("Desc"),
 			Severity:    types.Int64Value(5),
-			TagFilter:   types.StringValue("entity.type EQUALS 'synthetic_test'"),
+			TagFilter:   shared.NewTagFilterValue("entity.type EQUALS 'synthetic_test'"),
 			Rule: &SyntheticAlertRuleModel{
 				AlertType:  types.StringValue("failure"),
 				MetricName: types.StringValue("status"),
@@ -325,7 +325,7 @@ func TestMapStateToDataObject(t *testing.T) {
 			Name:        types.StringValue("Test"),
 			Description: types.StringValue("Desc"),
 			Severity:    types.Int64Value(5),
-			TagFilter:   types.StringValue("invalid tag filter syntax"),
+			TagFilter:   shared.NewTagFilterValue("invalid tag filter syntax"),
 			Rule: &SyntheticAlertRuleModel{
 				AlertType:  types.StringValue("failure"),
 				MetricName: types.StringValue("status"),
@@ -362,7 +362,7 @@ func TestMapStateToDataObject(t *testing.T) {
 			Name:        types.StringValue("Test"),
 			Description: types.StringValue("Desc"),
 			Severity:    types.Int64Null(),
-			TagFilter:   types.StringNull(),
+			TagFilter:   shared.NewTagFilterNull(),
 			Rule: &SyntheticAlertRuleModel{
 				AlertType:  types.StringValue("failure"),
 				MetricName: types.StringValue("status"),
@@ -767,7 +767,7 @@ func TestAdditionalCoverage(t *testing.T) {
 			Name:        types.StringValue("Test"),
 			Description: types.StringValue("Desc"),
 			Severity:    types.Int64Value(5),
-			TagFilter:   types.StringNull(),
+			TagFilter:   shared.NewTagFilterNull(),
 			GracePeriod: types.Int64Null(),
 			Rule: &SyntheticAlertRuleModel{
 				AlertType:   types.StringValue("failure"),
@@ -808,7 +808,7 @@ func TestAdditionalCoverage(t *testing.T) {
 			Name:        types.StringValue("Test"),
 			Description: types.StringValue("Desc"),
 			Severity:    types.Int64Value(5),
-			TagFilter:   types.StringNull(),
+			TagFilter:   shared.NewTagFilterNull(),
 			Rule: &SyntheticAlertRuleModel{
 				AlertType:  types.StringValue("failure"),
 				MetricName: types.StringValue("status"),
@@ -848,7 +848,7 @@ func initializeEmptyState(t *testing.T, ctx context.Context, state *tfsdk.State)
 		Name:                types.StringNull(),
 		Description:         types.StringNull(),
 		Severity:            types.Int64Null(),
-		TagFilter:           types.StringNull(),
+		TagFilter:           shared.NewTagFilterNull(),
 		GracePeriod:         types.Int64Null(),
 		SyntheticTestIds:    types.SetNull(types.StringType),
 		AlertChannelIds:     types.SetNull(types.StringType),
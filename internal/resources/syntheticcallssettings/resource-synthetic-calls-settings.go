@@ -0,0 +1,238 @@
+package syntheticcallssettings
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
+	"github.com/instana/terraform-provider-instana/internal/util"
+)
+
+var customRuleObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		SyntheticCallsSettingsFieldRuleName:               types.StringType,
+		SyntheticCallsSettingsFieldRuleDescription:        types.StringType,
+		SyntheticCallsSettingsFieldRuleEnabled:            types.BoolType,
+		SyntheticCallsSettingsFieldRuleMatchSpecification: shared.TagFilterType{},
+	},
+}
+
+// NewSyntheticCallsSettingsResourceHandle creates the resource handle for the synthetic calls settings.
+func NewSyntheticCallsSettingsResourceHandle() resourcehandle.SingletonResourceHandle[*instanaapi.SyntheticCallsSettings] {
+	return &syntheticCallsSettingsResourceHandle{
+		metaData: resourcehandle.ResourceMetaData{
+			ResourceName: ResourceInstanaSyntheticCallsSettings,
+			Schema: schema.Schema{
+				Description: SyntheticCallsSettingsDescResource,
+				Attributes: map[string]schema.Attribute{
+					SyntheticCallsSettingsFieldDefaultRulesEnabled: schema.BoolAttribute{
+						Description: SyntheticCallsSettingsDescDefaultRulesEnabled,
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(true),
+					},
+					SyntheticCallsSettingsFieldCustomRules: schema.ListNestedAttribute{
+						Description: SyntheticCallsSettingsDescCustomRules,
+						Optional:    true,
+						Computed:    true,
+						Default:     listdefault.StaticValue(types.ListValueMust(customRuleObjectType, []attr.Value{})),
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(SyntheticCallsSettingsMaxCustomRules),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								SyntheticCallsSettingsFieldRuleName: schema.StringAttribute{
+									Description: SyntheticCallsSettingsDescRuleName,
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.LengthBetween(SyntheticCallsSettingsRuleNameMinLength, SyntheticCallsSettingsRuleNameMaxLength),
+									},
+								},
+								SyntheticCallsSettingsFieldRuleDescription: schema.StringAttribute{
+									Description: SyntheticCallsSettingsDescRuleDescription,
+									Optional:    true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(SyntheticCallsSettingsRuleDescriptionMaxLength),
+									},
+								},
+								SyntheticCallsSettingsFieldRuleEnabled: schema.BoolAttribute{
+									Description: SyntheticCallsSettingsDescRuleEnabled,
+									Optional:    true,
+									Computed:    true,
+									Default:     booldefault.StaticBool(true),
+								},
+								SyntheticCallsSettingsFieldRuleMatchSpecification: schema.StringAttribute{
+									Description: SyntheticCallsSettingsDescRuleMatchSpecification,
+									Required:    true,
+									CustomType:  shared.TagFilterType{},
+									Validators: []validator.String{
+										shared.TagFilterValidator(),
+									},
+									PlanModifiers: []planmodifier.String{
+										shared.TagFilterPlanModifier(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type syntheticCallsSettingsResourceHandle struct {
+	metaData resourcehandle.ResourceMetaData
+}
+
+// MetaData returns the resource metadata.
+func (h *syntheticCallsSettingsResourceHandle) MetaData() *resourcehandle.ResourceMetaData {
+	return &h.metaData
+}
+
+// GetSingletonRestResource returns the singleton REST client for the synthetic calls settings.
+func (h *syntheticCallsSettingsResourceHandle) GetSingletonRestResource(api client.InstanaAPI) rest.SingletonRestResource[*instanaapi.SyntheticCallsSettings] {
+	return instanaapi.From(api).SyntheticCallsSettings()
+}
+
+// SetComputedFields is a no-op — all computed fields of the synthetic calls settings have static defaults.
+func (h *syntheticCallsSettingsResourceHandle) SetComputedFields(_ context.Context, _ *tfsdk.Plan) diag.Diagnostics {
+	return diag.Diagnostics{}
+}
+
+// MapStateToDataObject maps the Terraform plan/state to the API object.
+func (h *syntheticCallsSettingsResourceHandle) MapStateToDataObject(ctx context.Context, plan *tfsdk.Plan, state *tfsdk.State) (*instanaapi.SyntheticCallsSettings, diag.Diagnostics) {
+	var model SyntheticCallsSettingsModel
+	var diags diag.Diagnostics
+
+	if plan != nil {
+		diags = plan.Get(ctx, &model)
+	} else {
+		diags = state.Get(ctx, &model)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	customRules := make([]instanaapi.SyntheticCallRule, 0, len(model.CustomRules))
+	for _, rule := range model.CustomRules {
+		matchSpecification, err := parseMatchSpecification(rule.MatchSpecification.ValueString())
+		if err != nil {
+			diags.AddError(SyntheticCallsSettingsErrParsingMatchSpecification, fmt.Sprintf(SyntheticCallsSettingsErrRuleDetail, rule.Name.ValueString(), err))
+			return nil, diags
+		}
+		customRules = append(customRules, instanaapi.SyntheticCallRule{
+			Name:               rule.Name.ValueString(),
+			Description:        rule.Description.ValueStringPointer(),
+			Enabled:            rule.Enabled.IsNull() || rule.Enabled.IsUnknown() || rule.Enabled.ValueBool(),
+			MatchSpecification: matchSpecification,
+		})
+	}
+
+	return &instanaapi.SyntheticCallsSettings{
+		CustomRules:         customRules,
+		DefaultRulesEnabled: model.DefaultRulesEnabled.IsNull() || model.DefaultRulesEnabled.IsUnknown() || model.DefaultRulesEnabled.ValueBool(),
+	}, diags
+}
+
+// UpdateState updates the Terraform state with the API object. The configured match specification of a rule is
+// kept as long as it is semantically equal to the one returned by the API, otherwise the normalized representation
+// of the API value is stored.
+func (h *syntheticCallsSettingsResourceHandle) UpdateState(ctx context.Context, state *tfsdk.State, plan *tfsdk.Plan, settings *instanaapi.SyntheticCallsSettings) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var prior SyntheticCallsSettingsModel
+
+	if plan != nil {
+		diags.Append(plan.Get(ctx, &prior)...)
+	} else if state != nil && !state.Raw.IsNull() {
+		diags.Append(state.Get(ctx, &prior)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	customRules := make([]SyntheticCallRuleModel, 0, len(settings.CustomRules))
+	for i, rule := range settings.CustomRules {
+		var priorMatchSpecification *string
+		if i < len(prior.CustomRules) && !prior.CustomRules[i].MatchSpecification.IsNull() && !prior.CustomRules[i].MatchSpecification.IsUnknown() {
+			priorMatchSpecification = prior.CustomRules[i].MatchSpecification.ValueStringPointer()
+		}
+
+		matchSpecification, err := mapMatchSpecificationToState(rule.MatchSpecification, priorMatchSpecification)
+		if err != nil {
+			diags.AddError(SyntheticCallsSettingsErrConvertingMatchSpecification, fmt.Sprintf(SyntheticCallsSettingsErrRuleDetail, rule.Name, err))
+			return diags
+		}
+		customRules = append(customRules, SyntheticCallRuleModel{
+			Name:               types.StringValue(rule.Name),
+			Description:        util.SetStringPointerToState(rule.Description),
+			Enabled:            types.BoolValue(rule.Enabled),
+			MatchSpecification: shared.NewTagFilterValue(matchSpecification),
+		})
+	}
+
+	diags.Append(state.Set(ctx, SyntheticCallsSettingsModel{
+		DefaultRulesEnabled: types.BoolValue(settings.DefaultRulesEnabled),
+		CustomRules:         customRules,
+	})...)
+	return diags
+}
+
+// GetStateUpgraders returns nil — no state schema migrations are needed for this resource.
+func (h *syntheticCallsSettingsResourceHandle) GetStateUpgraders(_ context.Context) map[int64]resource.StateUpgrader {
+	return nil
+}
+
+// parseMatchSpecification parses the tag filter expression and converts it into a match expression of the API
+func parseMatchSpecification(expression string) (*instanaapi.MatchExpression, error) {
+	parsed, err := tagfilter.NewParser().Parse(expression)
+	if err != nil {
+		return nil, err
+	}
+	return instanaapi.NewMatchExpressionFromTagFilter(tagfilter.NewMapper().ToAPIModel(parsed))
+}
+
+// mapMatchSpecificationToState converts the match expression of the API into its tag filter string representation.
+// The prior expression is returned when it describes the same match expression.
+func mapMatchSpecificationToState(matchSpecification *instanaapi.MatchExpression, prior *string) (string, error) {
+	if matchSpecification == nil {
+		return "", fmt.Errorf("match specification is missing")
+	}
+	if prior != nil {
+		if priorMatchSpecification, err := parseMatchSpecification(*prior); err == nil && reflect.DeepEqual(priorMatchSpecification, matchSpecification) {
+			return *prior, nil
+		}
+	}
+
+	tagFilter, err := matchSpecification.ToTagFilter()
+	if err != nil {
+		return "", err
+	}
+	normalized, err := tagfilter.MapTagFilterToNormalizedString(tagFilter)
+	if err != nil {
+		return "", err
+	}
+	if normalized == nil {
+		return "", fmt.Errorf("match specification is empty")
+	}
+	return *normalized, nil
+}
@@ -0,0 +1,171 @@
+package syntheticcallssettings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func strPtr(s string) *string { return &s }
+
+type mockSyntheticCallsSettingsAPI struct {
+	testutils.MockInstanaAPI
+	restResource rest.SingletonRestResource[*instanaapi.SyntheticCallsSettings]
+}
+
+func (m *mockSyntheticCallsSettingsAPI) SyntheticCallsSettings() rest.SingletonRestResource[*instanaapi.SyntheticCallsSettings] {
+	return m.restResource
+}
+
+func newHealthCheckMatchSpecification(t *testing.T) *instanaapi.MatchExpression {
+	result, err := parseMatchSpecification("call.http.path@dest EQUALS '/health' AND call.http.status EQUALS 200")
+	require.NoError(t, err)
+	return result
+}
+
+func TestNewSyntheticCallsSettingsResourceHandle(t *testing.T) {
+	handle := NewSyntheticCallsSettingsResourceHandle()
+
+	require.NotNil(t, handle)
+	assert.Equal(t, ResourceInstanaSyntheticCallsSettings, handle.MetaData().ResourceName)
+	require.Contains(t, handle.MetaData().Schema.Attributes, SyntheticCallsSettingsFieldDefaultRulesEnabled)
+	require.Contains(t, handle.MetaData().Schema.Attributes, SyntheticCallsSettingsFieldCustomRules)
+	assert.Nil(t, handle.GetStateUpgraders(context.Background()))
+}
+
+func TestSyntheticCallsSettingsSchemaIsValid(t *testing.T) {
+	handle := NewSyntheticCallsSettingsResourceHandle()
+
+	diags := handle.MetaData().Schema.ValidateImplementation(context.Background())
+
+	assert.False(t, diags.HasError(), "%v", diags)
+}
+
+func TestSyntheticCallsSettingsGetSingletonRestResource(t *testing.T) {
+	restResource := instanaapi.NewSingletonRestResource[*instanaapi.SyntheticCallsSettings](instanaapi.SyntheticCallsSettingsResourcePath, nil)
+
+	result := NewSyntheticCallsSettingsResourceHandle().GetSingletonRestResource(&mockSyntheticCallsSettingsAPI{restResource: restResource})
+
+	assert.Same(t, restResource, result)
+}
+
+func TestSyntheticCallsSettingsMapStateToDataObject(t *testing.T) {
+	ctx := context.Background()
+	handle := NewSyntheticCallsSettingsResourceHandle()
+
+	t.Run("should map custom rules", func(t *testing.T) {
+		plan := &tfsdk.Plan{Schema: handle.MetaData().Schema}
+		require.False(t, plan.Set(ctx, SyntheticCallsSettingsModel{
+			DefaultRulesEnabled: types.BoolValue(false),
+			CustomRules: []SyntheticCallRuleModel{{
+				Name:               types.StringValue("health-checks"),
+				Description:        types.StringValue("Load balancer health checks"),
+				Enabled:            types.BoolValue(true),
+				MatchSpecification: shared.NewTagFilterValue("call.http.path@dest EQUALS '/health' AND call.http.status EQUALS 200"),
+			}},
+		}).HasError())
+
+		result, diags := handle.MapStateToDataObject(ctx, plan, nil)
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, &instanaapi.SyntheticCallsSettings{
+			DefaultRulesEnabled: false,
+			CustomRules: []instanaapi.SyntheticCallRule{{
+				Name:               "health-checks",
+				Description:        strPtr("Load balancer health checks"),
+				Enabled:            true,
+				MatchSpecification: newHealthCheckMatchSpecification(t),
+			}},
+		}, result)
+	})
+
+	t.Run("should fail for invalid match specification", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+		require.False(t, state.Set(ctx, SyntheticCallsSettingsModel{
+			DefaultRulesEnabled: types.BoolValue(true),
+			CustomRules: []SyntheticCallRuleModel{{
+				Name:               types.StringValue("invalid"),
+				Description:        types.StringNull(),
+				Enabled:            types.BoolValue(true),
+				MatchSpecification: shared.NewTagFilterValue("call.http.path INVALID"),
+			}},
+		}).HasError())
+
+		_, diags := handle.MapStateToDataObject(ctx, nil, state)
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, SyntheticCallsSettingsErrParsingMatchSpecification, diags.Errors()[0].Summary())
+	})
+}
+
+func TestSyntheticCallsSettingsUpdateState(t *testing.T) {
+	ctx := context.Background()
+	handle := NewSyntheticCallsSettingsResourceHandle()
+	settings := &instanaapi.SyntheticCallsSettings{
+		DefaultRulesEnabled: true,
+		CustomRules: []instanaapi.SyntheticCallRule{{
+			Name:               "health-checks",
+			Enabled:            false,
+			MatchSpecification: newHealthCheckMatchSpecification(t),
+		}},
+		DefaultRules: []instanaapi.SyntheticCallRule{{Name: "default", Enabled: true}},
+	}
+
+	t.Run("should keep semantically equal match specification of plan", func(t *testing.T) {
+		expression := "call.http.path@dest   EQUALS '/health' and call.http.status EQUALS 200"
+		plan := &tfsdk.Plan{Schema: handle.MetaData().Schema}
+		require.False(t, plan.Set(ctx, SyntheticCallsSettingsModel{
+			DefaultRulesEnabled: types.BoolValue(true),
+			CustomRules: []SyntheticCallRuleModel{{
+				Name:               types.StringValue("health-checks"),
+				Description:        types.StringNull(),
+				Enabled:            types.BoolValue(false),
+				MatchSpecification: shared.NewTagFilterValue(expression),
+			}},
+		}).HasError())
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+
+		diags := handle.UpdateState(ctx, state, plan, settings)
+
+		require.False(t, diags.HasError())
+		var result SyntheticCallsSettingsModel
+		require.False(t, state.Get(ctx, &result).HasError())
+		require.Len(t, result.CustomRules, 1)
+		assert.Equal(t, expression, result.CustomRules[0].MatchSpecification.ValueString())
+		assert.False(t, result.CustomRules[0].Enabled.ValueBool())
+		assert.True(t, result.CustomRules[0].Description.IsNull())
+	})
+
+	t.Run("should map normalized match specification without prior state", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+
+		diags := handle.UpdateState(ctx, state, nil, settings)
+
+		require.False(t, diags.HasError())
+		var result SyntheticCallsSettingsModel
+		require.False(t, state.Get(ctx, &result).HasError())
+		assert.True(t, result.DefaultRulesEnabled.ValueBool())
+		require.Len(t, result.CustomRules, 1)
+		assert.Equal(t, "(call.http.path@dest EQUALS '/health' AND call.http.status@dest EQUALS '200')", result.CustomRules[0].MatchSpecification.ValueString())
+	})
+
+	t.Run("should map no custom rules to empty list", func(t *testing.T) {
+		state := &tfsdk.State{Schema: handle.MetaData().Schema}
+
+		diags := handle.UpdateState(ctx, state, nil, &instanaapi.SyntheticCallsSettings{})
+
+		require.False(t, diags.HasError())
+		var result SyntheticCallsSettingsModel
+		require.False(t, state.Get(ctx, &result).HasError())
+		assert.NotNil(t, result.CustomRules)
+		assert.Empty(t, result.CustomRules)
+	})
+}
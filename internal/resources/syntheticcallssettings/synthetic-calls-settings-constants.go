@@ -0,0 +1,63 @@
+package syntheticcallssettings
+
+// ResourceInstanaSyntheticCallsSettings is the name of the terraform resource for the synthetic calls settings.
+const ResourceInstanaSyntheticCallsSettings = "synthetic_calls_settings"
+
+// Field name constants
+const (
+	// SyntheticCallsSettingsFieldDefaultRulesEnabled field name for the flag enabling the default rules
+	SyntheticCallsSettingsFieldDefaultRulesEnabled = "default_rules_enabled"
+	// SyntheticCallsSettingsFieldCustomRules field name for the list of custom rules
+	SyntheticCallsSettingsFieldCustomRules = "custom_rules"
+	// SyntheticCallsSettingsFieldRuleName field name for the name of a custom rule
+	SyntheticCallsSettingsFieldRuleName = "name"
+	// SyntheticCallsSettingsFieldRuleDescription field name for the description of a custom rule
+	SyntheticCallsSettingsFieldRuleDescription = "description"
+	// SyntheticCallsSettingsFieldRuleEnabled field name for the enabled flag of a custom rule
+	SyntheticCallsSettingsFieldRuleEnabled = "enabled"
+	// SyntheticCallsSettingsFieldRuleMatchSpecification field name for the match specification of a custom rule
+	SyntheticCallsSettingsFieldRuleMatchSpecification = "match_specification"
+)
+
+// Resource description constants
+const (
+	// SyntheticCallsSettingsDescResource describes the resource purpose
+	SyntheticCallsSettingsDescResource = "Manages the synthetic calls settings in Instana. " +
+		"Calls matching one of the enabled rules are flagged as synthetic and excluded from service metrics. " +
+		"This is a singleton resource — only one instance exists per tenant unit."
+	// SyntheticCallsSettingsDescDefaultRulesEnabled describes the default_rules_enabled field
+	SyntheticCallsSettingsDescDefaultRulesEnabled = "Flag indicating whether the default rules provided by Instana are applied."
+	// SyntheticCallsSettingsDescCustomRules describes the custom_rules field
+	SyntheticCallsSettingsDescCustomRules = "The list of custom rules to recognize synthetic calls."
+	// SyntheticCallsSettingsDescRuleName describes the name field of a custom rule
+	SyntheticCallsSettingsDescRuleName = "The name of the rule."
+	// SyntheticCallsSettingsDescRuleDescription describes the description field of a custom rule
+	SyntheticCallsSettingsDescRuleDescription = "The description of the rule."
+	// SyntheticCallsSettingsDescRuleEnabled describes the enabled field of a custom rule
+	SyntheticCallsSettingsDescRuleEnabled = "Flag indicating whether the rule is enabled."
+	// SyntheticCallsSettingsDescRuleMatchSpecification describes the match_specification field of a custom rule
+	SyntheticCallsSettingsDescRuleMatchSpecification = "The tag filter expression matching the synthetic calls. " +
+		"Only tags without a tag key are supported."
+)
+
+// Error message constants
+const (
+	// SyntheticCallsSettingsErrParsingMatchSpecification error title when the match specification cannot be parsed
+	SyntheticCallsSettingsErrParsingMatchSpecification = "Error parsing match specification"
+	// SyntheticCallsSettingsErrConvertingMatchSpecification error title when the match specification cannot be converted
+	SyntheticCallsSettingsErrConvertingMatchSpecification = "Error converting match specification"
+	// SyntheticCallsSettingsErrRuleDetail error detail including the rule name
+	SyntheticCallsSettingsErrRuleDetail = "Rule %s: %s"
+)
+
+// Validation constants enforced by the Instana API
+const (
+	// SyntheticCallsSettingsMaxCustomRules maximum number of custom rules
+	SyntheticCallsSettingsMaxCustomRules = 500
+	// SyntheticCallsSettingsRuleNameMinLength minimum length of a rule name
+	SyntheticCallsSettingsRuleNameMinLength = 1
+	// SyntheticCallsSettingsRuleNameMaxLength maximum length of a rule name
+	SyntheticCallsSettingsRuleNameMaxLength = 128
+	// SyntheticCallsSettingsRuleDescriptionMaxLength maximum length of a rule description
+	SyntheticCallsSettingsRuleDescriptionMaxLength = 2048
+)
@@ -0,0 +1,20 @@
+package syntheticcallssettings
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+)
+
+// SyntheticCallsSettingsModel is the Terraform model for the synthetic calls settings.
+type SyntheticCallsSettingsModel struct {
+	DefaultRulesEnabled types.Bool               `tfsdk:"default_rules_enabled"`
+	CustomRules         []SyntheticCallRuleModel `tfsdk:"custom_rules"`
+}
+
+// SyntheticCallRuleModel is the Terraform model for a single custom rule of the synthetic calls settings.
+type SyntheticCallRuleModel struct {
+	Name               types.String          `tfsdk:"name"`
+	Description        types.String          `tfsdk:"description"`
+	Enabled            types.Bool            `tfsdk:"enabled"`
+	MatchSpecification shared.TagFilterValue `tfsdk:"match_specification"`
+}
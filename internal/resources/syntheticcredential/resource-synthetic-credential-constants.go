@@ -18,6 +18,7 @@ const (
 	SyntheticCredentialDescResource       = "This resource manages Synthetic Credentials in Instana."
 	SyntheticCredentialDescCredentialName = "The unique name of the credential. This serves as the identifier for the resource."
 	SyntheticCredentialDescCredentialValue = "The secret value of the credential. This field is write-only and will not be read back from the API."
+	SyntheticCredentialDescCredentialSecret = "The secret value of the credential"
 	SyntheticCredentialDescApplications   = "List of application IDs the credential is scoped to."
 	SyntheticCredentialDescMobileApps     = "List of mobile app IDs the credential is scoped to."
 	SyntheticCredentialDescWebsites       = "List of website IDs the credential is scoped to."
@@ -17,6 +17,7 @@ import (
 	"github.com/instana/instana-go-client/client"
 	"github.com/instana/instana-go-client/shared/rest"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/shared"
 )
 
 // NewSyntheticCredentialResourceHandle creates the resource handle for Synthetic Credentials
@@ -39,7 +40,7 @@ func strPtr(s string) *string {
 func buildSyntheticCredentialSchema() schema.Schema {
 	return schema.Schema{
 		Description: SyntheticCredentialDescResource,
-		Attributes: map[string]schema.Attribute{
+		Attributes: shared.AddWriteOnlySecretAttributes(map[string]schema.Attribute{
 			SyntheticCredentialFieldCredentialName: schema.StringAttribute{
 				Required:    true,
 				Description: SyntheticCredentialDescCredentialName,
@@ -97,7 +98,7 @@ func buildSyntheticCredentialSchema() schema.Schema {
 					},
 				},
 			},
-		},
+		}, SyntheticCredentialFieldCredentialValue, SyntheticCredentialDescCredentialSecret),
 	}
 }
 
@@ -147,14 +148,16 @@ func (r *syntheticCredentialResource) MapStateToDataObject(ctx context.Context,
 	// resource was imported without it; that is fine because delete only needs the name.
 	if !model.CredentialValue.IsNull() && !model.CredentialValue.IsUnknown() {
 		cred.CredentialValue = model.CredentialValue.ValueString()
-	} else if plan != nil {
-		// plan != nil means this is a create or update — value is required.
+	} else if plan != nil && !shared.IsWriteOnlySecret(model.CredentialValueWoVersion) {
+		// plan != nil means this is a create or update — value is required unless it is
+		// provided by the write-only attribute (see MapWriteOnlyAttributes).
 		diags.AddAttributeError(
 			path.Root(SyntheticCredentialFieldCredentialValue),
 			"Missing required attribute",
 			fmt.Sprintf(
-				"%s must be set before applying. Add it to your configuration and run terraform apply again.",
+				"%s or %s must be set before applying. Add it to your configuration and run terraform apply again.",
 				SyntheticCredentialFieldCredentialValue,
+				shared.WriteOnlyAttributeName(SyntheticCredentialFieldCredentialValue),
 			),
 		)
 		return nil, diags
@@ -198,6 +201,16 @@ func (r *syntheticCredentialResource) MapStateToDataObject(ctx context.Context,
 	return cred, diags
 }
 
+// MapWriteOnlyAttributes applies the write-only credential value of the configuration to the API model
+func (r *syntheticCredentialResource) MapWriteOnlyAttributes(ctx context.Context, config *tfsdk.Config, cred *api.SyntheticCredential) diag.Diagnostics {
+	var credentialValueWo types.String
+	diags := config.GetAttribute(ctx, path.Root(shared.WriteOnlyAttributeName(SyntheticCredentialFieldCredentialValue)), &credentialValueWo)
+	if !diags.HasError() && !credentialValueWo.IsNull() && !credentialValueWo.IsUnknown() {
+		cred.CredentialValue = credentialValueWo.ValueString()
+	}
+	return diags
+}
+
 // UpdateState maps the API response back to the Terraform state.
 //
 // The associations GET endpoint returns only credentialName, applications, applicationLabels,
@@ -220,12 +233,14 @@ func (r *syntheticCredentialResource) UpdateState(ctx context.Context, state *tf
 
 	// Preserve fields that the API never echoes back.
 	var credentialValue types.String
+	var credentialValueWoVersion types.Int64
 	var rbacTags types.Set
 	var mobileApps types.Set
 	var websites types.Set
 
 	if src != nil {
 		diags.Append(src.GetAttribute(ctx, path.Root(SyntheticCredentialFieldCredentialValue), &credentialValue)...)
+		diags.Append(src.GetAttribute(ctx, path.Root(shared.WriteOnlyVersionAttributeName(SyntheticCredentialFieldCredentialValue)), &credentialValueWoVersion)...)
 		diags.Append(src.GetAttribute(ctx, path.Root(SyntheticCredentialFieldRbacTags), &rbacTags)...)
 		diags.Append(src.GetAttribute(ctx, path.Root(SyntheticCredentialFieldMobileApps), &mobileApps)...)
 		diags.Append(src.GetAttribute(ctx, path.Root(SyntheticCredentialFieldWebsites), &websites)...)
@@ -245,9 +260,16 @@ func (r *syntheticCredentialResource) UpdateState(ctx context.Context, state *tf
 		websites = types.SetValueMust(types.StringType, nil)
 	}
 
+	// The value is never stored in the state when it is managed by the write-only attribute.
+	if shared.IsWriteOnlySecret(credentialValueWoVersion) {
+		credentialValue = types.StringNull()
+	}
+
 	model := SyntheticCredentialModel{
-		CredentialName:  types.StringValue(apiObject.CredentialName),
-		CredentialValue: credentialValue,
+		CredentialName:           types.StringValue(apiObject.CredentialName),
+		CredentialValue:          credentialValue,
+		CredentialValueWo:        types.StringNull(),
+		CredentialValueWoVersion: credentialValueWoVersion,
 		// applications is returned by the associations endpoint — use the API value.
 		Applications: stringSliceToSet(apiObject.Applications),
 		// The remaining fields are not returned by the API — preserve from plan/state.
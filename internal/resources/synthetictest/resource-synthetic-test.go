@@ -23,6 +23,7 @@ import (
 	"github.com/instana/instana-go-client/client"
 	"github.com/instana/instana-go-client/shared/rest"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/applicationconfig"
 	"github.com/instana/terraform-provider-instana/internal/util"
 )
 
@@ -30,9 +31,13 @@ import (
 func NewSyntheticTestResourceHandle() resourcehandle.ResourceHandle[*api.SyntheticTest] {
 	return &syntheticTestResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName:  ResourceInstanaSyntheticTest,
-			Schema:        buildSchema(),
-			SchemaVersion: 1,
+			ResourceName:        ResourceInstanaSyntheticTest,
+			ImportNameAttribute: SyntheticTestFieldLabel,
+			Schema:              buildSchema(),
+			SchemaVersion:       1,
+			ReferenceAttributes: map[string]string{
+				SyntheticTestFieldApplicationID: applicationconfig.ResourceInstanaApplicationConfig,
+			},
 		},
 	}
 }
@@ -18,6 +18,10 @@ import (
 	"github.com/instana/instana-go-client/client"
 	"github.com/instana/instana-go-client/shared/rest"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/applicationconfig"
+	"github.com/instana/terraform-provider-instana/internal/resources/roles"
+	"github.com/instana/terraform-provider-instana/internal/resources/sloconfig"
+	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
 	"github.com/instana/terraform-provider-instana/internal/util"
 )
@@ -44,9 +48,15 @@ func setToStrings(ctx context.Context, s types.Set) ([]string, diag.Diagnostics)
 func NewTeamResourceHandle() resourcehandle.ResourceHandle[*api.Team] {
 	return &teamResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName:  ResourceInstanaTeam,
-			Schema:        buildTeamSchema(),
-			SchemaVersion: 1,
+			ResourceName:        ResourceInstanaTeam,
+			ImportNameAttribute: TeamFieldTag,
+			Schema:              buildTeamSchema(),
+			SchemaVersion:       1,
+			ReferenceAttributes: map[string]string{
+				TeamFieldMemberRoleID: roles.ResourceInstanaRole,
+				TeamFieldScopeSloIDs:  sloconfig.ResourceInstanaSloConfig,
+				TeamFieldScopeRestrictedApplicationFilterRestrictingApplicationID: applicationconfig.ResourceInstanaApplicationConfig,
+			},
 		},
 	}
 }
@@ -246,6 +256,13 @@ func buildRestrictedApplicationFilterAttributes() map[string]schema.Attribute {
 		TeamFieldScopeRestrictedApplicationFilterTagFilterExpression: schema.StringAttribute{
 			Optional:    true,
 			Description: TeamDescScopeRestrictedApplicationFilterTagFilterExpression,
+			CustomType:  shared.TagFilterType{},
+			Validators: []validator.String{
+				shared.TagFilterValidator(),
+			},
+			PlanModifiers: []planmodifier.String{
+				shared.TagFilterPlanModifier(),
+			},
 		},
 	}
 }
@@ -413,9 +430,9 @@ func (r *teamResource) mapRestrictedApplicationFilterToModel(apiFilter *api.Rest
 			diags.AddError("Failed to map tag filter expression", err.Error())
 			return nil, diags
 		}
-		filterModel.TagFilterExpression = util.SetStringPointerToState(tagFilterString)
+		filterModel.TagFilterExpression = shared.NewTagFilterPointerValue(tagFilterString)
 	} else {
-		filterModel.TagFilterExpression = types.StringNull()
+		filterModel.TagFilterExpression = shared.NewTagFilterNull()
 	}
 
 	return filterModel, diags
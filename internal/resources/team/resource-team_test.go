@@ -12,6 +12,10 @@ import (
 	tag "github.com/instana/instana-go-client/shared/tagfilter"
 	common "github.com/instana/instana-go-client/shared/types"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/applicationconfig"
+	"github.com/instana/terraform-provider-instana/internal/resources/roles"
+	"github.com/instana/terraform-provider-instana/internal/resources/sloconfig"
+	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -75,6 +79,11 @@ func TestNewTeamResourceHandle(t *testing.T) {
 	assert.Equal(t, ResourceInstanaTeam, metadata.ResourceName)
 	assert.Equal(t, int64(1), metadata.SchemaVersion)
 	assert.NotNil(t, metadata.Schema)
+	assert.Equal(t, map[string]string{
+		TeamFieldMemberRoleID: roles.ResourceInstanaRole,
+		TeamFieldScopeSloIDs:  sloconfig.ResourceInstanaSloConfig,
+		TeamFieldScopeRestrictedApplicationFilterRestrictingApplicationID: applicationconfig.ResourceInstanaApplicationConfig,
+	}, metadata.ReferenceAttributes)
 }
 
 func TestMetaData(t *testing.T) {
@@ -1250,7 +1259,7 @@ func TestMapStateToDataObject(t *testing.T) {
 			Scope: func() *TeamScopeModel {
 				s := emptyTeamScopeModel(t)
 				s.RestrictedApplicationFilter = &TeamRestrictedApplicationFilterModel{
-					TagFilterExpression: types.StringValue("entity.type EQUALS 'service'"),
+					TagFilterExpression: shared.NewTagFilterValue("entity.type EQUALS 'service'"),
 				}
 				return &s
 			}(),
@@ -1275,7 +1284,7 @@ func TestMapStateToDataObject(t *testing.T) {
 				s.RestrictedApplicationFilter = &TeamRestrictedApplicationFilterModel{
 					Label:               types.StringNull(),
 					Scope:               types.StringNull(),
-					TagFilterExpression: types.StringNull(),
+					TagFilterExpression: shared.NewTagFilterNull(),
 				}
 				return &s
 			}(),
@@ -1326,7 +1335,7 @@ func TestMapStateToDataObject(t *testing.T) {
 				RestrictedApplicationFilter: &TeamRestrictedApplicationFilterModel{
 					Label:               types.StringValue("test-label"),
 					Scope:               types.StringValue(string(api.RestrictedApplicationFilterScopeIncludeAllDownstream)),
-					TagFilterExpression: types.StringValue("entity.type EQUALS 'service'"),
+					TagFilterExpression: shared.NewTagFilterValue("entity.type EQUALS 'service'"),
 				},
 			},
 		}
@@ -1542,7 +1551,7 @@ func TestEdgeCases(t *testing.T) {
 			Scope: func() *TeamScopeModel {
 				s := emptyTeamScopeModel(t)
 				s.RestrictedApplicationFilter = &TeamRestrictedApplicationFilterModel{
-					TagFilterExpression: types.StringValue("invalid expression"),
+					TagFilterExpression: shared.NewTagFilterValue("invalid expression"),
 				}
 				return &s
 			}(),
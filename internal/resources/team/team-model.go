@@ -1,6 +1,9 @@
 package team
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+)
 
 // TeamModel represents the data model for RBAC Team
 type TeamModel struct {
@@ -48,7 +51,7 @@ type TeamScopeModel struct {
 
 // TeamRestrictedApplicationFilterModel represents the restricted application filter configuration
 type TeamRestrictedApplicationFilterModel struct {
-	Label               types.String `tfsdk:"label"`
-	Scope               types.String `tfsdk:"scope"`
-	TagFilterExpression types.String `tfsdk:"tag_filter_expression"`
+	Label               types.String          `tfsdk:"label"`
+	Scope               types.String          `tfsdk:"scope"`
+	TagFilterExpression shared.TagFilterValue `tfsdk:"tag_filter_expression"`
 }
@@ -22,7 +22,10 @@ import (
 	"github.com/instana/instana-go-client/shared/rest"
 	tag "github.com/instana/instana-go-client/shared/tagfilter"
 	common "github.com/instana/instana-go-client/shared/types"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
 	"github.com/instana/terraform-provider-instana/internal/resourcehandle"
+	"github.com/instana/terraform-provider-instana/internal/resources/alertingchannel"
+	"github.com/instana/terraform-provider-instana/internal/resources/websitemonitoringconfig"
 	"github.com/instana/terraform-provider-instana/internal/shared"
 	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
 	"github.com/instana/terraform-provider-instana/internal/util"
@@ -32,7 +35,8 @@ import (
 func NewWebsiteAlertConfigResourceHandle() resourcehandle.ResourceHandle[*api.WebsiteAlertConfig] {
 	return &websiteAlertConfigResource{
 		metaData: resourcehandle.ResourceMetaData{
-			ResourceName: ResourceInstanaWebsiteAlertConfig,
+			ResourceName:        ResourceInstanaWebsiteAlertConfig,
+			ImportNameAttribute: WebsiteAlertConfigFieldName,
 			Schema: schema.Schema{
 				Description: WebsiteAlertConfigDescResource,
 				Attributes: map[string]schema.Attribute{
@@ -69,6 +73,7 @@ func NewWebsiteAlertConfigResourceHandle() resourcehandle.ResourceHandle[*api.We
 						Description: WebsiteAlertConfigDescEnabled,
 						Default:     booldefault.StaticBool(WebsiteAlertConfigDefaultEnabled),
 					},
+					shared.BaselineRefreshTriggerFieldName: shared.BaselineRefreshTriggerAttributeSchema(),
 					WebsiteAlertConfigFieldWebsiteID: schema.StringAttribute{
 						Required:    true,
 						Description: WebsiteAlertConfigDescWebsiteID,
@@ -79,6 +84,13 @@ func NewWebsiteAlertConfigResourceHandle() resourcehandle.ResourceHandle[*api.We
 					WebsiteAlertConfigFieldTagFilter: schema.StringAttribute{
 						Optional:    true,
 						Description: WebsiteAlertConfigDescTagFilter,
+						CustomType:  shared.TagFilterType{},
+						Validators: []validator.String{
+							shared.TagFilterValidator(),
+						},
+						PlanModifiers: []planmodifier.String{
+							shared.TagFilterPlanModifier(),
+						},
 					},
 					WebsiteAlertConfigFieldAlertChannelIDs: schema.SetAttribute{
 						Optional:    true,
@@ -284,6 +296,10 @@ func NewWebsiteAlertConfigResourceHandle() resourcehandle.ResourceHandle[*api.We
 				},
 			},
 			SchemaVersion: 2,
+			ReferenceAttributes: map[string]string{
+				WebsiteAlertConfigFieldAlertChannelIDs: alertingchannel.ResourceInstanaAlertingChannel,
+				WebsiteAlertConfigFieldWebsiteID:       websitemonitoringconfig.ResourceInstanaWebsiteMonitoringConfig,
+			},
 		},
 	}
 }
@@ -300,6 +316,34 @@ func (r *websiteAlertConfigResource) GetRestResource(api client.InstanaAPI) rest
 	return api.WebsiteAlertConfigs()
 }
 
+// EnabledAttribute implementation of resourcehandle.EnabledStateToggler
+func (r *websiteAlertConfigResource) EnabledAttribute() string {
+	return WebsiteAlertConfigFieldEnabled
+}
+
+// SetEnabled enables or disables the website alert configuration via the dedicated endpoints
+func (r *websiteAlertConfigResource) SetEnabled(instanaAPI client.InstanaAPI, id string, enabled bool) error {
+	return instanaapi.From(instanaAPI).EnablementToggle(instanaapi.WebsiteAlertConfigsResourcePath).SetEnabled(id, enabled)
+}
+
+// IsEnabled returns the enabled flag of the website alert configuration
+func (r *websiteAlertConfigResource) IsEnabled(_ client.InstanaAPI, config *api.WebsiteAlertConfig) (bool, error) {
+	if config.Enabled == nil {
+		return WebsiteAlertConfigDefaultEnabled, nil
+	}
+	return *config.Enabled, nil
+}
+
+// BaselineRefreshTriggerAttribute implementation of resourcehandle.BaselineRefresher
+func (r *websiteAlertConfigResource) BaselineRefreshTriggerAttribute() string {
+	return shared.BaselineRefreshTriggerFieldName
+}
+
+// RefreshBaseline recalculates the historic baseline of the website alert configuration
+func (r *websiteAlertConfigResource) RefreshBaseline(instanaAPI client.InstanaAPI, id string) error {
+	return instanaapi.From(instanaAPI).BaselineUpdater(instanaapi.WebsiteAlertConfigsResourcePath).UpdateBaseline(id)
+}
+
 func (r *websiteAlertConfigResource) SetComputedFields(_ context.Context, _ *tfsdk.Plan) diag.Diagnostics {
 	return nil
 }
@@ -752,9 +796,9 @@ func (r *websiteAlertConfigResource) mapTagFilterExpressionToState(model *Websit
 			)
 			return diags
 		}
-		model.TagFilter = util.SetStringPointerToState(filterExprStr)
+		model.TagFilter = shared.NewTagFilterPointerValue(filterExprStr)
 	} else {
-		model.TagFilter = types.StringNull()
+		model.TagFilter = shared.NewTagFilterNull()
 	}
 
 	return diags
@@ -146,7 +146,7 @@ func TestMapStateToDataObject_WithRules(t *testing.T) {
 			Description: types.StringValue("Multi Rule Description"),
 			Triggering:  types.BoolValue(true),
 			WebsiteID:   types.StringValue("website-1"),
-			TagFilter:   types.StringNull(),
+			TagFilter:   shared.NewTagFilterNull(),
 			Granularity: types.Int64Value(600000),
 			Rules: []RuleWithThresholdPluginModel{
 				{
@@ -350,7 +350,7 @@ func TestMapStateToDataObject_NullSeverity(t *testing.T) {
 			Description: types.StringValue("Desc"),
 			Triggering:  types.BoolValue(true),
 			WebsiteID:   types.StringValue("website-1"),
-			TagFilter:   types.StringNull(),
+			TagFilter:   shared.NewTagFilterNull(),
 			Granularity: types.Int64Value(600000),
 			Rules:       []RuleWithThresholdPluginModel{},
 			TimeThreshold: &WebsiteTimeThresholdModel{
@@ -395,7 +395,7 @@ func TestMapStateToDataObject_MissingTimeThreshold(t *testing.T) {
 			Description:   types.StringValue("Desc"),
 			Triggering:    types.BoolValue(true),
 			WebsiteID:     types.StringValue("website-1"),
-			TagFilter:     types.StringNull(),
+			TagFilter:     shared.NewTagFilterNull(),
 			Granularity:   types.Int64Value(600000),
 			Rules:         []RuleWithThresholdPluginModel{},
 			TimeThreshold: nil,
@@ -437,7 +437,7 @@ func TestMapStateToDataObject_InvalidSeverity(t *testing.T) {
 			Description: types.StringValue("Desc"),
 			Triggering:  types.BoolValue(true),
 			WebsiteID:   types.StringValue("website-1"),
-			TagFilter:   types.StringNull(),
+			TagFilter:   shared.NewTagFilterNull(),
 			Granularity: types.Int64Value(600000),
 			Rules:       []RuleWithThresholdPluginModel{},
 			TimeThreshold: &WebsiteTimeThresholdModel{
@@ -535,7 +535,7 @@ func TestMapStateToDataObject_InvalidTimeThresholdConfig(t *testing.T) {
 			Description: types.StringValue("Desc"),
 			Triggering:  types.BoolValue(true),
 			WebsiteID:   types.StringValue("website-1"),
-			TagFilter:   types.StringNull(),
+			TagFilter:   shared.NewTagFilterNull(),
 			Granularity: types.Int64Value(600000),
 			Rules:       []RuleWithThresholdPluginModel{},
 			TimeThreshold: &WebsiteTimeThresholdModel{
@@ -580,7 +580,7 @@ func TestMapStateToDataObject_WithTagFilter(t *testing.T) {
 			Description: types.StringValue("Desc"),
 			Triggering:  types.BoolValue(true),
 			WebsiteID:   types.StringValue("website-1"),
-			TagFilter:   types.StringValue("entity.type EQUALS 'website'"),
+			TagFilter:   shared.NewTagFilterValue("entity.type EQUALS 'website'"),
 			Granularity: types.Int64Value(600000),
 			Rules:       []RuleWithThresholdPluginModel{},
 			TimeThreshold: &WebsiteTimeThresholdModel{
@@ -614,7 +614,7 @@ func TestMapStateToDataObject_WithTagFilter(t *testing.T) {
 			Description: types.StringValue("Desc"),
 			Triggering:  types.BoolValue(true),
 			WebsiteID:   types.StringValue("website-1"),
-			TagFilter:   types.StringValue("invalid{{{syntax"),
+			TagFilter:   shared.NewTagFilterValue("invalid{{{syntax"),
 			Granularity: types.Int64Value(600000),
 			Rules:       []RuleWithThresholdPluginModel{},
 			TimeThreshold: &WebsiteTimeThresholdModel{
@@ -658,7 +658,7 @@ func TestMapStateToDataObject_AllRuleTypes(t *testing.T) {
 			Description: types.StringValue("Desc"),
 			Triggering:  types.BoolValue(true),
 			WebsiteID:   types.StringValue("website-1"),
-			TagFilter:   types.StringNull(),
+			TagFilter:   shared.NewTagFilterNull(),
 			Granularity: types.Int64Value(600000),
 			Rules: []RuleWithThresholdPluginModel{
 				{
@@ -710,7 +710,7 @@ func TestMapStateToDataObject_AllRuleTypes(t *testing.T) {
 			Description: types.StringValue("Desc"),
 			Triggering:  types.BoolValue(true),
 			WebsiteID:   types.StringValue("website-1"),
-			TagFilter:   types.StringNull(),
+			TagFilter:   shared.NewTagFilterNull(),
 			Granularity: types.Int64Value(600000),
 			Rules: []RuleWithThresholdPluginModel{
 				{
@@ -766,7 +766,7 @@ func TestMapStateToDataObject_AllRuleTypes(t *testing.T) {
 			Description: types.StringValue("Desc"),
 			Triggering:  types.BoolValue(true),
 			WebsiteID:   types.StringValue("website-1"),
-			TagFilter:   types.StringNull(),
+			TagFilter:   shared.NewTagFilterNull(),
 			Granularity: types.Int64Value(600000),
 			Rules: []RuleWithThresholdPluginModel{
 				{
@@ -827,7 +827,7 @@ func TestMapStateToDataObject_AllRuleTypes(t *testing.T) {
 			Description: types.StringValue("Desc"),
 			Triggering:  types.BoolValue(true),
 			WebsiteID:   types.StringValue("website-1"),
-			TagFilter:   types.StringNull(),
+			TagFilter:   shared.NewTagFilterNull(),
 			Granularity: types.Int64Value(600000),
 			Rules: []RuleWithThresholdPluginModel{
 				{
@@ -1119,7 +1119,7 @@ func initializeEmptyState(t *testing.T, ctx context.Context, state *tfsdk.State)
 		Description:         types.StringNull(),
 		Triggering:          types.BoolNull(),
 		WebsiteID:           types.StringNull(),
-		TagFilter:           types.StringNull(),
+		TagFilter:           shared.NewTagFilterNull(),
 		AlertChannelIDs:     types.SetNull(types.StringType),
 		Granularity:         types.Int64Null(),
 		CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -1226,7 +1226,7 @@ func TestMapStateToDataObject_EnabledField(t *testing.T) {
 				Triggering:          types.BoolValue(false),
 				Enabled:             tt.enabledValue,
 				WebsiteID:           types.StringValue("website-1"),
-				TagFilter:           types.StringNull(),
+				TagFilter:           shared.NewTagFilterNull(),
 				Granularity:         types.Int64Value(600000),
 				AlertChannelIDs:     types.SetNull(types.StringType),
 				CustomPayloadFields: types.ListNull(shared.GetCustomPayloadFieldType()),
@@ -7,19 +7,20 @@ import (
 
 // WebsiteAlertConfigModel represents the data model for the Website Alert Config resource
 type WebsiteAlertConfigModel struct {
-	ID                  types.String                   `tfsdk:"id"`
-	Name                types.String                   `tfsdk:"name"`
-	Description         types.String                   `tfsdk:"description"`
-	Triggering          types.Bool                     `tfsdk:"triggering"`
-	Enabled             types.Bool                     `tfsdk:"enabled"`
-	WebsiteID           types.String                   `tfsdk:"website_id"`
-	TagFilter           types.String                   `tfsdk:"tag_filter"`
-	AlertChannelIDs     types.Set                      `tfsdk:"alert_channel_ids"`
-	Granularity         types.Int64                    `tfsdk:"granularity"`
-	GracePeriod         types.Int64                    `tfsdk:"grace_period"`
-	CustomPayloadFields types.List                     `tfsdk:"custom_payload_fields"`
-	TimeThreshold       *WebsiteTimeThresholdModel     `tfsdk:"time_threshold"`
-	Rules               []RuleWithThresholdPluginModel `tfsdk:"rules"`
+	ID                     types.String                   `tfsdk:"id"`
+	Name                   types.String                   `tfsdk:"name"`
+	Description            types.String                   `tfsdk:"description"`
+	Triggering             types.Bool                     `tfsdk:"triggering"`
+	Enabled                types.Bool                     `tfsdk:"enabled"`
+	BaselineRefreshTrigger types.String                   `tfsdk:"baseline_refresh_trigger"`
+	WebsiteID              types.String                   `tfsdk:"website_id"`
+	TagFilter              shared.TagFilterValue          `tfsdk:"tag_filter"`
+	AlertChannelIDs        types.Set                      `tfsdk:"alert_channel_ids"`
+	Granularity            types.Int64                    `tfsdk:"granularity"`
+	GracePeriod            types.Int64                    `tfsdk:"grace_period"`
+	CustomPayloadFields    types.List                     `tfsdk:"custom_payload_fields"`
+	TimeThreshold          *WebsiteTimeThresholdModel     `tfsdk:"time_threshold"`
+	Rules                  []RuleWithThresholdPluginModel `tfsdk:"rules"`
 }
 
 type RuleWithThresholdPluginModel struct {
@@ -78,9 +78,10 @@ func (r *websiteMonitoringConfigResource) UpdateState(ctx context.Context, state
 // createResourceMetaData creates the resource metadata with schema definition
 func createResourceMetaData() resourcehandle.ResourceMetaData {
 	return resourcehandle.ResourceMetaData{
-		ResourceName:  ResourceInstanaWebsiteMonitoringConfig,
-		Schema:        createResourceSchema(),
-		SchemaVersion: WebsiteMonitoringConfigSchemaVersion,
+		ResourceName:        ResourceInstanaWebsiteMonitoringConfig,
+		ImportNameAttribute: WebsiteMonitoringConfigFieldName,
+		Schema:              createResourceSchema(),
+		SchemaVersion:       WebsiteMonitoringConfigSchemaVersion,
 	}
 }
 
@@ -109,6 +109,42 @@ type MsTeamsAppModel struct {
 	TenantName  types.String `tfsdk:"tenant_name"`
 }
 
+// OpsGenieResourceModel the OpsGenie model of the alerting channel resource including the write-only API key
+type OpsGenieResourceModel struct {
+	OpsGenieModel
+	APIKeyWo        types.String `tfsdk:"api_key_wo"`
+	APIKeyWoVersion types.Int64  `tfsdk:"api_key_wo_version"`
+}
+
+// SplunkResourceModel the Splunk model of the alerting channel resource including the write-only token
+type SplunkResourceModel struct {
+	SplunkModel
+	TokenWo        types.String `tfsdk:"token_wo"`
+	TokenWoVersion types.Int64  `tfsdk:"token_wo_version"`
+}
+
+// VictorOpsResourceModel the VictorOps model of the alerting channel resource including the write-only API key
+type VictorOpsResourceModel struct {
+	VictorOpsModel
+	APIKeyWo        types.String `tfsdk:"api_key_wo"`
+	APIKeyWoVersion types.Int64  `tfsdk:"api_key_wo_version"`
+}
+
+// ServiceNowResourceModel the ServiceNow model of the alerting channel resource including the write-only password
+type ServiceNowResourceModel struct {
+	ServiceNowModel
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+// ServiceNowApplicationResourceModel the ServiceNow Enhanced model of the alerting channel resource including the
+// write-only password
+type ServiceNowApplicationResourceModel struct {
+	ServiceNowApplicationModel
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
 func MapAlertChannelsToState(ctx context.Context, alertChannels map[common.AlertSeverity][]string) (types.Object, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -522,3 +558,20 @@ func MapMsTeamsAppChannelToState(ctx context.Context, channel *api.AlertingChann
 
 	return model, diags
 }
+
+// MapWriteOnlyChannelSecrets applies the write-only secrets of the configured channel of the alerting channel
+// resource to the given API object
+func MapWriteOnlyChannelSecrets(channel *api.AlertingChannel, opsGenie *OpsGenieResourceModel, splunk *SplunkResourceModel, victorOps *VictorOpsResourceModel, serviceNow *ServiceNowResourceModel, serviceNowApp *ServiceNowApplicationResourceModel) {
+	switch {
+	case opsGenie != nil && !opsGenie.APIKeyWo.IsNull():
+		channel.APIKey = opsGenie.APIKeyWo.ValueStringPointer()
+	case splunk != nil && !splunk.TokenWo.IsNull():
+		channel.Token = splunk.TokenWo.ValueStringPointer()
+	case victorOps != nil && !victorOps.APIKeyWo.IsNull():
+		channel.APIKey = victorOps.APIKeyWo.ValueStringPointer()
+	case serviceNow != nil && !serviceNow.PasswordWo.IsNull():
+		channel.Password = serviceNow.PasswordWo.ValueStringPointer()
+	case serviceNowApp != nil && !serviceNowApp.PasswordWo.IsNull():
+		channel.Password = serviceNowApp.PasswordWo.ValueStringPointer()
+	}
+}
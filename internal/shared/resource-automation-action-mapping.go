@@ -71,15 +71,19 @@ type AuthModel struct {
 
 // BasicAuthModel represents the basic authentication configuration
 type BasicAuthModel struct {
-	UserName types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	UserName          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
 }
 
 // ApiKeyModel represents the API key authentication configuration
 type ApiKeyModel struct {
-	Key         types.String `tfsdk:"key"`
-	Value       types.String `tfsdk:"value"`
-	KeyLocation types.String `tfsdk:"key_location"`
+	Key            types.String `tfsdk:"key"`
+	Value          types.String `tfsdk:"value"`
+	ValueWo        types.String `tfsdk:"value_wo"`
+	ValueWoVersion types.Int64  `tfsdk:"value_wo_version"`
+	KeyLocation    types.String `tfsdk:"key_location"`
 }
 
 // BearerTokenModel represents the bearer token authentication configuration
@@ -375,16 +379,17 @@ func GetAutomationActionSchemaAttributes() map[string]schema.Attribute {
 						"basic_auth": schema.SingleNestedAttribute{
 							Optional:    true,
 							Description: "Basic authentication configuration.",
-							Attributes: map[string]schema.Attribute{
+							Attributes: AddWriteOnlySecretAttributes(map[string]schema.Attribute{
 								"username": schema.StringAttribute{
 									Required:    true,
 									Description: "The username for basic authentication.",
 								},
 								"password": schema.StringAttribute{
-									Required:    true,
+									Optional:    true,
 									Description: "The password for basic authentication.",
+									Validators:  RequiredSecretValidators("password"),
 								},
-							},
+							}, "password", "The password for basic authentication."),
 						},
 						"token": schema.SingleNestedAttribute{
 							Optional:    true,
@@ -399,20 +404,21 @@ func GetAutomationActionSchemaAttributes() map[string]schema.Attribute {
 						"api_key": schema.SingleNestedAttribute{
 							Optional:    true,
 							Description: "API key authentication configuration.",
-							Attributes: map[string]schema.Attribute{
+							Attributes: AddWriteOnlySecretAttributes(map[string]schema.Attribute{
 								"key": schema.StringAttribute{
 									Required:    true,
 									Description: "The API key name.",
 								},
 								"value": schema.StringAttribute{
-									Required:    true,
+									Optional:    true,
 									Description: "The API key value.",
+									Validators:  RequiredSecretValidators("value"),
 								},
 								"key_location": schema.StringAttribute{
 									Required:    true,
 									Description: "Where to add the API key (header or query).",
 								},
-							},
+							}, "value", "The API key value."),
 						},
 					},
 				},
@@ -768,8 +774,10 @@ func MapAuthFieldsToState(action *api.AutomationAction) *AuthModel {
 		password, _ := authMap["password"].(string)
 		return &AuthModel{
 			BasicAuth: &BasicAuthModel{
-				UserName: types.StringValue(username),
-				Password: types.StringValue(password),
+				UserName:          types.StringValue(username),
+				Password:          types.StringValue(password),
+				PasswordWo:        types.StringNull(),
+				PasswordWoVersion: types.Int64Null(),
 			},
 		}
 	case "bearerToken":
@@ -785,15 +793,67 @@ func MapAuthFieldsToState(action *api.AutomationAction) *AuthModel {
 		location, _ := authMap["apiKeyAddTo"].(string)
 		return &AuthModel{
 			ApiKey: &ApiKeyModel{
-				Key:         types.StringValue(key),
-				Value:       types.StringValue(value),
-				KeyLocation: types.StringValue(location),
+				Key:            types.StringValue(key),
+				Value:          types.StringValue(value),
+				ValueWo:        types.StringNull(),
+				ValueWoVersion: types.Int64Null(),
+				KeyLocation:    types.StringValue(location),
 			},
 		}
 	}
 	return nil
 }
 
+// ApplyWriteOnlyAuthState keeps the versions of the write-only secrets of the prior authentication (plan or state) in
+// the authentication mapped from the API and removes the secrets which are managed by write-only attributes
+func ApplyWriteOnlyAuthState(auth *AuthModel, prior *AuthModel) {
+	if auth == nil || prior == nil {
+		return
+	}
+	if auth.BasicAuth != nil && prior.BasicAuth != nil {
+		auth.BasicAuth.PasswordWoVersion = prior.BasicAuth.PasswordWoVersion
+		auth.BasicAuth.Password = WriteOnlySecretToState(auth.BasicAuth.Password, auth.BasicAuth.PasswordWoVersion)
+	}
+	if auth.ApiKey != nil && prior.ApiKey != nil {
+		auth.ApiKey.ValueWoVersion = prior.ApiKey.ValueWoVersion
+		auth.ApiKey.Value = WriteOnlySecretToState(auth.ApiKey.Value, auth.ApiKey.ValueWoVersion)
+	}
+}
+
+// HasWriteOnlyAuthSecret returns true when a secret of the given authentication is configured via its write-only
+// attribute
+func HasWriteOnlyAuthSecret(auth *AuthModel) bool {
+	if auth == nil {
+		return false
+	}
+	return (auth.BasicAuth != nil && !auth.BasicAuth.PasswordWo.IsNull()) ||
+		(auth.ApiKey != nil && !auth.ApiKey.ValueWo.IsNull())
+}
+
+// MapWriteOnlyAuthFromConfig replaces the value of the authentication field of the given fields with the
+// authentication of the configuration which is the only source of write-only secrets
+func MapWriteOnlyAuthFromConfig(fields []api.Field, auth *AuthModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !HasWriteOnlyAuthSecret(auth) {
+		return diags
+	}
+
+	authJson, err := json.Marshal(mapAuthFromState(auth))
+	if err != nil {
+		diags.AddError(
+			"Error marshaling auth",
+			fmt.Sprintf("Failed to marshal auth: %s", err),
+		)
+		return diags
+	}
+	for i := range fields {
+		if fields[i].Name == "authen" {
+			fields[i].Value = string(authJson)
+		}
+	}
+	return diags
+}
+
 // MapHeadersToState maps HTTP headers to state
 func MapHeadersToState(action *api.AutomationAction) types.Map {
 	headersData := GetFieldValue(action, api.HttpHeaderFieldName)
@@ -1259,7 +1319,7 @@ func mapAuthFromState(auth *AuthModel) map[string]string {
 		return map[string]string{
 			"type":     "basicAuth",
 			"username": auth.BasicAuth.UserName.ValueString(),
-			"password": auth.BasicAuth.Password.ValueString(),
+			"password": WriteOnlySecretValue(auth.BasicAuth.Password, auth.BasicAuth.PasswordWo),
 		}
 	}
 
@@ -1274,7 +1334,7 @@ func mapAuthFromState(auth *AuthModel) map[string]string {
 		return map[string]string{
 			"type":        "apiKey",
 			"apiKey":      auth.ApiKey.Key.ValueString(),
-			"apiKeyValue": auth.ApiKey.Value.ValueString(),
+			"apiKeyValue": WriteOnlySecretValue(auth.ApiKey.Value, auth.ApiKey.ValueWo),
 			"apiKeyAddTo": auth.ApiKey.KeyLocation.ValueString(),
 		}
 	}
@@ -0,0 +1,37 @@
+package shared
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// TagFilterPlanModifier returns a planmodifier.String which plans the value of the prior state when the configured tag
+// filter expression only differs in formatting from it, so that formatting-only changes of the configuration do not
+// plan an update. Terraform accepts the prior value as planned value of Optional and Required attributes, so the
+// attributes do not need to be Computed.
+func TagFilterPlanModifier() planmodifier.String {
+	return tagFilterPlanModifier{}
+}
+
+type tagFilterPlanModifier struct{}
+
+// Description implementation of planmodifier.String
+func (m tagFilterPlanModifier) Description(_ context.Context) string {
+	return "keeps the tag filter expression of the state when the configured expression only differs in formatting"
+}
+
+// MarkdownDescription implementation of planmodifier.String
+func (m tagFilterPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString implementation of planmodifier.String
+func (m tagFilterPlanModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+	if TagFilterExpressionsEqual(req.StateValue.ValueString(), req.ConfigValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
@@ -0,0 +1,49 @@
+package shared_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func planTagFilter(stateValue types.String, configValue types.String, planValue types.String) types.String {
+	resp := &planmodifier.StringResponse{PlanValue: planValue}
+	shared.TagFilterPlanModifier().PlanModifyString(context.Background(), planmodifier.StringRequest{StateValue: stateValue, ConfigValue: configValue, PlanValue: planValue}, resp)
+	return resp.PlanValue
+}
+
+func TestTagFilterPlanModifierShouldKeepStateWhenExpressionOnlyDiffersInFormatting(t *testing.T) {
+	state := types.StringValue("entity.type EQUALS 'host' AND entity.zone NOT_EMPTY")
+	config := types.StringValue("(entity.type equals 'host')  and entity.zone NOT_EMPTY")
+
+	assert.Equal(t, state, planTagFilter(state, config, config))
+}
+
+func TestTagFilterPlanModifierShouldPlanConfigWhenExpressionChanges(t *testing.T) {
+	state := types.StringValue("entity.type EQUALS 'host'")
+
+	for _, config := range []types.String{
+		types.StringValue("entity.type EQUALS 'container'"),
+		types.StringValue("invalid((expression"),
+		types.StringUnknown(),
+	} {
+		assert.Equal(t, config, planTagFilter(state, config, config), config.String())
+	}
+}
+
+func TestTagFilterPlanModifierShouldPlanConfigWhenStateIsNotKnown(t *testing.T) {
+	config := types.StringValue("entity.type EQUALS 'host'")
+
+	assert.Equal(t, config, planTagFilter(types.StringNull(), config, config))
+	assert.Equal(t, config, planTagFilter(types.StringUnknown(), config, config))
+}
+
+func TestTagFilterPlanModifierShouldPlanNullWhenExpressionIsRemoved(t *testing.T) {
+	state := types.StringValue("entity.type EQUALS 'host'")
+
+	assert.True(t, planTagFilter(state, types.StringNull(), types.StringNull()).IsNull())
+}
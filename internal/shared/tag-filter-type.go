@@ -0,0 +1,145 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ basetypes.StringTypable                    = TagFilterType{}
+	_ basetypes.StringValuableWithSemanticEquals = TagFilterValue{}
+)
+
+// TagFilterType is the custom string type of tag filter expressions. Values of this type are semantically equal when
+// they render to the same normalized expression, so formatting-only differences like additional whitespace, the case
+// of keywords or redundant brackets between the configuration and the API neither produce drift after refresh nor
+// "inconsistent result after apply" errors. Semantic equality is not applied when Terraform plans the configuration
+// against the state, which is covered by the TagFilterPlanModifier.
+//
+// Attributes using this type have to set CustomType: TagFilterType{} and use TagFilterValue in the model.
+type TagFilterType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name
+func (t TagFilterType) String() string {
+	return "shared.TagFilterType"
+}
+
+// Equal returns true if the given type is equivalent
+func (t TagFilterType) Equal(o attr.Type) bool {
+	other, ok := o.(TagFilterType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueType returns the value type of this type
+func (t TagFilterType) ValueType(_ context.Context) attr.Value {
+	return TagFilterValue{}
+}
+
+// ValueFromString converts the given string value to a TagFilterValue
+func (t TagFilterType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return TagFilterValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform converts the given terraform value to a TagFilterValue
+func (t TagFilterType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+// TagFilterValue is the value of a tag filter expression of the TagFilterType
+type TagFilterValue struct {
+	basetypes.StringValue
+}
+
+// NewTagFilterNull creates a null TagFilterValue
+func NewTagFilterNull() TagFilterValue {
+	return TagFilterValue{StringValue: basetypes.NewStringNull()}
+}
+
+// NewTagFilterUnknown creates an unknown TagFilterValue
+func NewTagFilterUnknown() TagFilterValue {
+	return TagFilterValue{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewTagFilterValue creates a TagFilterValue of the given expression
+func NewTagFilterValue(expression string) TagFilterValue {
+	return TagFilterValue{StringValue: basetypes.NewStringValue(expression)}
+}
+
+// NewTagFilterPointerValue creates a TagFilterValue of the given expression or a null value when the pointer is nil
+func NewTagFilterPointerValue(expression *string) TagFilterValue {
+	return TagFilterValue{StringValue: basetypes.NewStringPointerValue(expression)}
+}
+
+// Type returns the TagFilterType
+func (v TagFilterValue) Type(_ context.Context) attr.Type {
+	return TagFilterType{}
+}
+
+// Equal returns true if the given value is a TagFilterValue with the same string value. Semantic equality is
+// implemented by StringSemanticEquals.
+func (v TagFilterValue) Equal(o attr.Value) bool {
+	other, ok := o.(TagFilterValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both expressions render to the same normalized tag filter expression. Values
+// which cannot be parsed are compared as they are.
+func (v TagFilterValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(TagFilterValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("An unexpected value type was received while performing semantic equality checks. Expected value type %T, got: %T. "+
+				"Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+	if v.IsNull() || v.IsUnknown() || newValue.IsNull() || newValue.IsUnknown() {
+		return v.Equal(newValue), diags
+	}
+	return TagFilterExpressionsEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+// TagFilterExpressionsEqual returns true if both tag filter expressions render to the same normalized expression
+func TagFilterExpressionsEqual(a string, b string) bool {
+	if a == b {
+		return true
+	}
+	normalizedA, err := tagfilter.Normalize(a)
+	if err != nil {
+		return false
+	}
+	normalizedB, err := tagfilter.Normalize(b)
+	if err != nil {
+		return false
+	}
+	return normalizedA == normalizedB
+}
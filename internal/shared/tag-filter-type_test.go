@@ -0,0 +1,66 @@
+package shared_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagFilterValueShouldBeSemanticallyEqualForFormattingOnlyDifferences(t *testing.T) {
+	for _, tt := range []struct {
+		prior    string
+		new      string
+		expected bool
+	}{
+		{prior: "entity.type EQUALS 'x'", new: "entity.type  EQUALS   'x'", expected: true},
+		{prior: "entity.type EQUALS 'x'", new: "entity.type equals \"x\"", expected: true},
+		{prior: "a EQUALS 'x' AND b EQUALS 'y' AND c EQUALS 'z'", new: "(a EQUALS 'x' AND b EQUALS 'y') AND c EQUALS 'z'", expected: true},
+		{prior: "a EQUALS 'x' OR b EQUALS 'y'", new: "(a EQUALS 'x') OR (b EQUALS 'y')", expected: true},
+		{prior: "entity.type EQUALS 'x'", new: "entity.type EQUALS 'y'", expected: false},
+		{prior: "a EQUALS 'x' AND b EQUALS 'y' OR c EQUALS 'z'", new: "a EQUALS 'x' AND (b EQUALS 'y' OR c EQUALS 'z')", expected: false},
+		{prior: "invalid ((", new: "invalid  ((", expected: false},
+	} {
+		t.Run(tt.prior+" vs "+tt.new, func(t *testing.T) {
+			result, diags := shared.NewTagFilterValue(tt.prior).StringSemanticEquals(context.Background(), shared.NewTagFilterValue(tt.new))
+
+			require.False(t, diags.HasError())
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestTagFilterValueShouldNotBeSemanticallyEqualToNullOrUnknownValues(t *testing.T) {
+	ctx := context.Background()
+
+	result, _ := shared.NewTagFilterValue("a EQUALS 'x'").StringSemanticEquals(ctx, shared.NewTagFilterNull())
+	assert.False(t, result)
+	result, _ = shared.NewTagFilterUnknown().StringSemanticEquals(ctx, shared.NewTagFilterValue("a EQUALS 'x'"))
+	assert.False(t, result)
+	result, _ = shared.NewTagFilterNull().StringSemanticEquals(ctx, shared.NewTagFilterNull())
+	assert.True(t, result)
+}
+
+func TestTagFilterValueShouldFailSemanticEqualityCheckForOtherValueTypes(t *testing.T) {
+	_, diags := shared.NewTagFilterValue("a EQUALS 'x'").StringSemanticEquals(context.Background(), types.StringValue("a EQUALS 'x'"))
+
+	require.True(t, diags.HasError())
+}
+
+func TestTagFilterTypeShouldCreateTagFilterValues(t *testing.T) {
+	ctx := context.Background()
+
+	value, err := shared.TagFilterType{}.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.String, "a EQUALS 'x'"))
+
+	require.NoError(t, err)
+	assert.Equal(t, shared.NewTagFilterValue("a EQUALS 'x'"), value)
+	assert.Equal(t, shared.TagFilterType{}, value.Type(ctx))
+	assert.True(t, shared.TagFilterType{}.Equal(value.Type(ctx)))
+	assert.False(t, shared.TagFilterType{}.Equal(types.StringType))
+	assert.False(t, shared.NewTagFilterValue("a EQUALS 'x'").Equal(types.StringValue("a EQUALS 'x'")))
+	assert.True(t, shared.NewTagFilterPointerValue(nil).IsNull())
+}
@@ -0,0 +1,64 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/alecthomas/participle"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/instana/terraform-provider-instana/internal/shared/tagfilter"
+)
+
+// TagFilterValidator returns a validator.String which parses the value with the tag filter grammar at plan time, so
+// syntax errors are reported with their position before the configuration is sent to the Instana API. Null, unknown
+// and empty values are not validated.
+func TagFilterValidator() validator.String {
+	return tagFilterValidator{}
+}
+
+type tagFilterValidator struct{}
+
+// Description implementation of validator.String
+func (v tagFilterValidator) Description(_ context.Context) string {
+	return "value must be a valid tag filter expression"
+}
+
+// MarkdownDescription implementation of validator.String
+func (v tagFilterValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implementation of validator.String
+func (v tagFilterValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || strings.TrimSpace(req.ConfigValue.ValueString()) == "" {
+		return
+	}
+
+	expression := req.ConfigValue.ValueString()
+	if _, err := tagfilter.NewParser().Parse(expression); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Tag Filter Expression", describeTagFilterError(expression, err))
+	}
+}
+
+// describeTagFilterError creates the message for a syntax error of the given expression. Errors of the parser point to
+// the line and column of the error, which are highlighted in the expression.
+func describeTagFilterError(expression string, err error) string {
+	var parseErr participle.Error
+	if !errors.As(err, &parseErr) || parseErr.Token().Pos.Column < 1 {
+		return fmt.Sprintf("The tag filter expression %q is invalid: %s", expression, err)
+	}
+
+	pos := parseErr.Token().Pos
+	lines := strings.Split(expression, "\n")
+	line := lines[len(lines)-1]
+	if pos.Line >= 1 && pos.Line <= len(lines) {
+		line = lines[pos.Line-1]
+	}
+	location := fmt.Sprintf("column %d", pos.Column)
+	if len(lines) > 1 {
+		location = fmt.Sprintf("line %d, column %d", pos.Line, pos.Column)
+	}
+	return fmt.Sprintf("The tag filter expression is invalid at %s: %s\n\n  %s\n  %s^", location, parseErr.Message(), line, strings.Repeat(" ", pos.Column-1))
+}
@@ -0,0 +1,57 @@
+package shared_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/instana/terraform-provider-instana/internal/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validateTagFilter(value types.String) *validator.StringResponse {
+	resp := &validator.StringResponse{}
+	shared.TagFilterValidator().ValidateString(context.Background(), validator.StringRequest{Path: path.Root("tag_filter"), ConfigValue: value}, resp)
+	return resp
+}
+
+func TestTagFilterValidatorShouldAcceptValidExpressions(t *testing.T) {
+	for _, value := range []types.String{
+		types.StringValue("service.name EQUALS 'my-service' AND call.http.status@dest GREATER_THAN 499"),
+		types.StringValue("(entity.type EQUALS 'host' OR entity.tag:env EQUALS 'prod') AND entity.zone NOT_EMPTY"),
+		types.StringValue("  "),
+		types.StringNull(),
+		types.StringUnknown(),
+	} {
+		assert.False(t, validateTagFilter(value).Diagnostics.HasError(), value.String())
+	}
+}
+
+func TestTagFilterValidatorShouldReportColumnOfSyntaxError(t *testing.T) {
+	resp := validateTagFilter(types.StringValue("service.name EQUALS 'a' AND AND call.name EQUALS 'b'"))
+
+	require.Equal(t, 1, resp.Diagnostics.ErrorsCount())
+	diagnostic := resp.Diagnostics.Errors()[0]
+	assert.Equal(t, "Invalid Tag Filter Expression", diagnostic.Summary())
+	assert.Contains(t, diagnostic.Detail(), "invalid at column 29:")
+	assert.Contains(t, diagnostic.Detail(), "\n  service.name EQUALS 'a' AND AND call.name EQUALS 'b'\n"+
+		"                              ^")
+}
+
+func TestTagFilterValidatorShouldReportLineAndColumnOfSyntaxErrorInMultilineExpression(t *testing.T) {
+	resp := validateTagFilter(types.StringValue("service.name EQUALS 'a'\nAND call.name EQUALS"))
+
+	require.Equal(t, 1, resp.Diagnostics.ErrorsCount())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "invalid at line 2, column")
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "\n  AND call.name EQUALS\n")
+}
+
+func TestTagFilterValidatorShouldReportInvalidCharacters(t *testing.T) {
+	resp := validateTagFilter(types.StringValue("service.name EQUALS # 'a'"))
+
+	require.Equal(t, 1, resp.Diagnostics.ErrorsCount())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "invalid at column 21:")
+}
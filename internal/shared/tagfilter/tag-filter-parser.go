@@ -207,9 +207,15 @@ func (e *ComparisonExpression) Render() string {
 	return fmt.Sprintf("%s %s '%s'", e.Entity.Render(), e.Operator, escapeStringValue(*e.StringValue))
 }
 
-// escapeStringValue escapes single quotes in string values by replacing them with backslash-escaped quotes
+// escapeStringValue escapes backslashes and single quotes in string values by prefixing them with a backslash. Backslashes
+// are escaped first so the escape characters of the quotes are not escaped again.
 func escapeStringValue(value string) string {
-	return strings.ReplaceAll(value, "'", "\\'")
+	return strings.ReplaceAll(strings.ReplaceAll(value, "\\", "\\\\"), "'", "\\'")
+}
+
+// QuoteStringValue returns the given value as quoted string literal of a tag filter expression
+func QuoteStringValue(value string) string {
+	return "'" + escapeStringValue(value) + "'"
 }
 
 // UnaryOperationExpression representation of a unary expression
@@ -1138,3 +1138,14 @@ func TestShouldHandleRoundTripConversionWithSingleQuotesInValue(t *testing.T) {
 	require.Equal(t, "<class 'ConnectionResetError'>", *convertedAPIModel.StringValue)
 	require.Equal(t, tagfilter.TagFilterEntityNotApplicable, *convertedAPIModel.Entity)
 }
+
+func TestShouldQuoteStringValueSoThatTheValueIsParsedUnchanged(t *testing.T) {
+	for _, value := range []string{"it's OR 'quoted'", `C:\temp\`, `line\nbreak`, `^api\.example\.com$`, `it\'s`, `say "hello"`} {
+		t.Run(value, func(t *testing.T) {
+			parsedExpr, err := NewParser().Parse("service.name EQUALS " + QuoteStringValue(value))
+
+			require.NoError(t, err)
+			require.Equal(t, value, *parsedExpr.Expression.Left.Left.Primary.Comparison.StringValue)
+		})
+	}
+}
@@ -53,6 +53,9 @@ const (
 	ThresholdFieldWarning  = "warning"
 	ThresholdFieldCritical = "critical"
 	ThresholdFieldStatic   = "static"
+
+	//BaselineRefreshTriggerFieldName constant value for field baseline_refresh_trigger
+	BaselineRefreshTriggerFieldName = "baseline_refresh_trigger"
 )
 
 type AdaptiveBaselineModel struct {
@@ -186,6 +189,16 @@ func HistoricAttributeSchema() schema.SingleNestedAttribute {
 	}
 }
 
+// BaselineRefreshTriggerAttributeSchema returns the schema of the baseline_refresh_trigger attribute. Any change of
+// the value recalculates the historic baselines of the alert configuration via the update-baseline endpoint.
+func BaselineRefreshTriggerAttributeSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Description: "Arbitrary value which recalculates the historic baselines of the alert configuration whenever it changes, " +
+			"e.g. after a change of the traffic patterns. The value is not sent to Instana.",
+	}
+}
+
 func StaticAndAdaptiveThresholdAttributeSchema() schema.SingleNestedAttribute {
 	return schema.SingleNestedAttribute{
 		Description: "Threshold configuration",
@@ -0,0 +1,95 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// WriteOnlyAttributeSuffix the suffix of the write-only variant of a secret attribute
+	WriteOnlyAttributeSuffix = "_wo"
+	// WriteOnlyVersionAttributeSuffix the suffix of the version attribute of a write-only secret attribute
+	WriteOnlyVersionAttributeSuffix = "_wo_version"
+)
+
+// WriteOnlyAttributeName returns the name of the write-only variant of the given secret attribute
+func WriteOnlyAttributeName(name string) string {
+	return name + WriteOnlyAttributeSuffix
+}
+
+// WriteOnlyVersionAttributeName returns the name of the version attribute of the write-only variant of the given
+// secret attribute
+func WriteOnlyVersionAttributeName(name string) string {
+	return name + WriteOnlyVersionAttributeSuffix
+}
+
+// AddWriteOnlySecretAttributes adds the write-only variant <name>_wo of the secret attribute with the given name and
+// the companion <name>_wo_version attribute to the given attributes. Write-only values are never stored in the state
+// (Terraform 1.11 or later), so changes of the value are only applied when the version is changed.
+func AddWriteOnlySecretAttributes(attributes map[string]schema.Attribute, name string, description string) map[string]schema.Attribute {
+	writeOnlyName := WriteOnlyAttributeName(name)
+	versionName := WriteOnlyVersionAttributeName(name)
+	attributes[writeOnlyName] = schema.StringAttribute{
+		Optional:  true,
+		Sensitive: true,
+		WriteOnly: true,
+		Description: fmt.Sprintf("%s as write-only value which is never stored in the state (requires Terraform 1.11 or later). Conflicts with %s and requires %s.",
+			strings.TrimSuffix(description, "."), name, versionName),
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(siblingPath(name)),
+			stringvalidator.AlsoRequires(siblingPath(versionName)),
+		},
+	}
+	attributes[versionName] = schema.Int64Attribute{
+		Optional: true,
+		Description: fmt.Sprintf("The version of %s. Terraform does not detect changes of write-only values, so the version must be changed to apply a new value.",
+			writeOnlyName),
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(siblingPath(writeOnlyName)),
+		},
+	}
+	return attributes
+}
+
+// RequiredSecretValidators returns the validators of a secret attribute which must be set unless the write-only
+// variant of the secret is set
+func RequiredSecretValidators(name string) []validator.String {
+	return []validator.String{
+		stringvalidator.ExactlyOneOf(siblingPath(WriteOnlyAttributeName(name))),
+	}
+}
+
+// IsWriteOnlySecret returns true when the secret is managed by its write-only variant, i.e. the version attribute of
+// the write-only variant is set
+func IsWriteOnlySecret(writeOnlyVersion types.Int64) bool {
+	return !writeOnlyVersion.IsNull() && !writeOnlyVersion.IsUnknown()
+}
+
+// WriteOnlySecretToState returns the secret read from the API for the state or null when the secret is managed by its
+// write-only variant with the given version, so that the secret is never stored in the state in this case
+func WriteOnlySecretToState(secret types.String, writeOnlyVersion types.Int64) types.String {
+	if IsWriteOnlySecret(writeOnlyVersion) {
+		return types.StringNull()
+	}
+	return secret
+}
+
+// WriteOnlySecretValue returns the value of the write-only variant of a secret when it is set in the configuration
+// and the value of the secret attribute otherwise
+func WriteOnlySecretValue(value types.String, writeOnlyValue types.String) string {
+	if !writeOnlyValue.IsNull() && !writeOnlyValue.IsUnknown() {
+		return writeOnlyValue.ValueString()
+	}
+	return value.ValueString()
+}
+
+func siblingPath(name string) path.Expression {
+	return path.MatchRelative().AtParent().AtName(name)
+}
@@ -0,0 +1,110 @@
+// Command export reads all objects of an Instana tenant unit and generates the terraform configuration of the objects
+// together with import blocks, so that existing configuration can be brought under management of terraform.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/instana/terraform-provider-instana/internal/exporter"
+	"github.com/instana/terraform-provider-instana/internal/provider"
+)
+
+var version = "dev"
+
+func main() {
+	var outputDir, dumpFile, writeDumpFile string
+	flag.StringVar(&outputDir, "output", "export", "directory of the generated terraform files")
+	flag.StringVar(&dumpFile, "dump", "", "read the objects from the given JSON dump instead of the Instana API")
+	flag.StringVar(&writeDumpFile, "write-dump", "", "write the objects read from the Instana API to the given JSON dump")
+	flag.Parse()
+
+	if err := run(context.Background(), outputDir, dumpFile, writeDumpFile); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, outputDir, dumpFile, writeDumpFile string) error {
+	resourceTypes := provider.ExportResourceTypes()
+
+	var dump exporter.Dump
+	if dumpFile != "" {
+		file, err := os.Open(dumpFile)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		if dump, err = exporter.ReadDump(file); err != nil {
+			return err
+		}
+	} else {
+		meta, diags := provider.ConfigureFromEnvironment(ctx, version)
+		if err := report(diags); err != nil {
+			return err
+		}
+		dump, diags = exporter.FetchDump(resourceTypes, meta.InstanaAPI)
+		if err := report(diags); err != nil {
+			return err
+		}
+	}
+
+	if writeDumpFile != "" {
+		if err := writeDump(dump, writeDumpFile); err != nil {
+			return err
+		}
+	}
+
+	files, diags := exporter.New(resourceTypes).Export(ctx, dump)
+	if err := report(diags); err != nil {
+		return err
+	}
+	return writeFiles(outputDir, files)
+}
+
+func writeDump(dump exporter.Dump, fileName string) error {
+	file, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	if err := dump.Write(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func writeFiles(outputDir string, files map[string][]byte) error {
+	if err := os.MkdirAll(outputDir, 0o755); err != nil {
+		return err
+	}
+	fileNames := make([]string, 0, len(files))
+	for fileName := range files {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+	for _, fileName := range fileNames {
+		if err := os.WriteFile(filepath.Join(outputDir, fileName), files[fileName], 0o644); err != nil {
+			return err
+		}
+		fmt.Println(filepath.Join(outputDir, fileName))
+	}
+	return nil
+}
+
+// report prints the warnings to stderr and returns an error when the diagnostics contain errors
+func report(diags diag.Diagnostics) error {
+	for _, d := range diags.Warnings() {
+		fmt.Fprintf(os.Stderr, "Warning: %s: %s\n", d.Summary(), d.Detail())
+	}
+	if diags.HasError() {
+		d := diags.Errors()[0]
+		return fmt.Errorf("%s: %s", d.Summary(), d.Detail())
+	}
+	return nil
+}
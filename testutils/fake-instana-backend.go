@@ -0,0 +1,491 @@
+package testutils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// FakeInstanaBackendAPIToken the API token accepted by the FakeInstanaBackend
+const FakeInstanaBackendAPIToken = "fake-api-token"
+
+// FakeInstanaBackendIDPrefix the prefix of the IDs assigned by the FakeInstanaBackend to new objects
+const FakeInstanaBackendIDPrefix = "fake-"
+
+const (
+	fakeBackendIDField          = "id"
+	fakeBackendEnabledField     = "enabled"
+	fakeBackendEnableOperation  = "enable"
+	fakeBackendDisableOperation = "disable"
+	fakeBackendOrderOperation   = "order"
+	authorizationHeaderName     = "Authorization"
+	jsonContentType             = "application/json; charset=utf-8"
+)
+
+// FakeBackendValidator validates the payload of a POST or PUT request to the FakeInstanaBackend. The returned error
+// is sent to the client as validation error with http status code 400.
+type FakeBackendValidator func(method string, path string, payload []byte) error
+
+// NewFakeInstanaBackend creates and starts a new stateful FakeInstanaBackend on a random port
+func NewFakeInstanaBackend() FakeInstanaBackend {
+	backend := &fakeInstanaBackendImpl{
+		documents:   make(map[string]*fakeDocument),
+		collections: make(map[string]bool),
+		idFields:    make(map[string]string),
+		callCounter: make(map[string]int),
+	}
+	backend.server = httptest.NewServer(http.HandlerFunc(backend.handle))
+	return backend
+}
+
+// FakeInstanaBackend is an in-memory fake of the Instana REST API for offline tests of plan and apply cycles. The fake
+// does not know the individual endpoints. It stores the payloads as documents by request path and implements the
+// conventions of the Instana API generically:
+//
+//   - POST <collection> creates a new object and assigns an ID when the payload has none
+//   - PUT <collection>/<id> creates or updates the object and sets an empty ID of the payload to the ID of the path,
+//     PUT <path> of singletons overwrites the settings
+//   - POST <collection>/<id> with payload updates an existing object
+//   - GET <collection>/<id> returns the object and GET <collection> all objects of the collection in creation order
+//   - DELETE <path> deletes the object including all nested documents
+//   - PUT or POST <collection>/<id>/enable and <collection>/<id>/disable without payload and content type toggle the
+//     enabled flag, other requests without payload like PUT <collection>/<id>/restore/<version> are accepted for
+//     existing objects
+//   - PUT <collection>/order with a list of IDs changes the order of the objects of the collection
+//
+// Unknown objects result in http status code 404, invalid JSON payloads, payloads with an ID not matching the path and
+// payloads rejected by a registered FakeBackendValidator in http status code 400. Requests without the API token
+// FakeInstanaBackendAPIToken are rejected with http status code 401.
+type FakeInstanaBackend interface {
+	// URL returns the base URL of the fake backend which is used as endpoint of the provider
+	URL() string
+	// Close stops the fake backend
+	Close()
+	// SetDocument stores the JSON representation of the given object at the given path, e.g. to provide objects
+	// which are not managed by the test
+	SetDocument(path string, object any)
+	// SetRawDocument stores the given payload with the given content type at the given path, e.g. to provide
+	// documents which are not JSON
+	SetRawDocument(path string, contentType string, payload []byte)
+	// GetDocument returns the document stored at the given path
+	GetDocument(path string) ([]byte, bool)
+	// DeleteDocument deletes the document at the given path including all nested documents, e.g. to simulate
+	// changes outside of Terraform
+	DeleteDocument(path string) bool
+	// FindDocumentPaths returns the paths of all documents with the given ID
+	FindDocumentPaths(id string) []string
+	// CreatedDocumentPaths returns the paths of all documents which were created through the API and still exist
+	CreatedDocumentPaths() []string
+	// SetIDField sets the field of the objects of the given collection which holds their ID, e.g. for objects
+	// identified by the ID of the entity they belong to. Defaults to id.
+	SetIDField(collectionPath string, field string)
+	// AddValidator registers a validator for POST and PUT requests to all paths starting with the given path prefix
+	AddValidator(pathPrefix string, validator FakeBackendValidator)
+	// GetCallCount returns the call counter for the given method and path
+	GetCallCount(method string, path string) int
+}
+
+type fakeDocument struct {
+	payload     []byte
+	contentType string
+	sequence    int
+	seeded      bool
+}
+
+type fakeBackendValidatorRegistration struct {
+	pathPrefix string
+	validator  FakeBackendValidator
+}
+
+type fakeInstanaBackendImpl struct {
+	server      *httptest.Server
+	documents   map[string]*fakeDocument
+	collections map[string]bool
+	idFields    map[string]string
+	validators  []fakeBackendValidatorRegistration
+	callCounter map[string]int
+	sequence    int
+	idCounter   int
+	mutex       sync.Mutex
+}
+
+// URL returns the base URL of the fake backend
+func (b *fakeInstanaBackendImpl) URL() string {
+	return b.server.URL
+}
+
+// Close stops the fake backend
+func (b *fakeInstanaBackendImpl) Close() {
+	b.server.Close()
+}
+
+// SetDocument stores the JSON representation of the given object at the given path
+func (b *fakeInstanaBackendImpl) SetDocument(documentPath string, object any) {
+	payload, err := json.Marshal(object)
+	if err != nil {
+		log.Fatalf("failed to marshal document %s; %s", documentPath, err)
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.store(cleanPath(documentPath), payload, jsonContentType).seeded = true
+}
+
+// SetRawDocument stores the given payload with the given content type at the given path
+func (b *fakeInstanaBackendImpl) SetRawDocument(documentPath string, contentType string, payload []byte) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.store(cleanPath(documentPath), payload, contentType).seeded = true
+}
+
+// GetDocument returns the document stored at the given path
+func (b *fakeInstanaBackendImpl) GetDocument(documentPath string) ([]byte, bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	document, ok := b.documents[cleanPath(documentPath)]
+	if !ok {
+		return nil, false
+	}
+	return document.payload, true
+}
+
+// DeleteDocument deletes the document at the given path including all nested documents
+func (b *fakeInstanaBackendImpl) DeleteDocument(documentPath string) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.delete(cleanPath(documentPath))
+}
+
+// FindDocumentPaths returns the paths of all documents with the given ID
+func (b *fakeInstanaBackendImpl) FindDocumentPaths(id string) []string {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	var paths []string
+	for documentPath := range b.documents {
+		if path.Base(documentPath) == id {
+			paths = append(paths, documentPath)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// CreatedDocumentPaths returns the paths of all documents which were created through the API and still exist
+func (b *fakeInstanaBackendImpl) CreatedDocumentPaths() []string {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	var paths []string
+	for documentPath, document := range b.documents {
+		if !document.seeded {
+			paths = append(paths, documentPath)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// SetIDField sets the field of the objects of the given collection which holds their ID
+func (b *fakeInstanaBackendImpl) SetIDField(collectionPath string, field string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.idFields[cleanPath(collectionPath)] = field
+}
+
+// AddValidator registers a validator for POST and PUT requests to all paths starting with the given path prefix
+func (b *fakeInstanaBackendImpl) AddValidator(pathPrefix string, validator FakeBackendValidator) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.validators = append(b.validators, fakeBackendValidatorRegistration{pathPrefix: pathPrefix, validator: validator})
+}
+
+// GetCallCount returns the call counter for the given method and path
+func (b *fakeInstanaBackendImpl) GetCallCount(method string, path string) int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.callCounter[method+"_"+path]
+}
+
+func (b *fakeInstanaBackendImpl) handle(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeFakeBackendError(w, http.StatusBadRequest, "failed to read request body; %s", err)
+		return
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	requestPath := cleanPath(r.URL.Path)
+	b.callCounter[r.Method+"_"+requestPath]++
+
+	if r.Header.Get(authorizationHeaderName) != "apiToken "+FakeInstanaBackendAPIToken {
+		writeFakeBackendError(w, http.StatusUnauthorized, "missing or invalid API token")
+		return
+	}
+
+	contentType := r.Header.Get(contentTypeHeaderName)
+	if len(payload) > 0 {
+		if err := b.validate(r.Method, requestPath, contentType, payload); err != nil {
+			writeFakeBackendError(w, http.StatusBadRequest, "%s", err)
+			return
+		}
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		b.handleGet(w, requestPath)
+	case http.MethodPost:
+		b.handlePost(w, requestPath, contentType, payload)
+	case http.MethodPut:
+		b.handlePut(w, requestPath, contentType, payload)
+	case http.MethodDelete:
+		b.handleDelete(w, requestPath)
+	default:
+		writeFakeBackendError(w, http.StatusMethodNotAllowed, "method %s is not supported", r.Method)
+	}
+}
+
+func (b *fakeInstanaBackendImpl) validate(method string, requestPath string, contentType string, payload []byte) error {
+	if isJSONContentType(contentType) && !json.Valid(payload) {
+		return fmt.Errorf("the payload of %s %s is not valid JSON", method, requestPath)
+	}
+	for _, registration := range b.validators {
+		if strings.HasPrefix(requestPath, registration.pathPrefix) {
+			if err := registration.validator(method, requestPath, payload); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (b *fakeInstanaBackendImpl) handleGet(w http.ResponseWriter, requestPath string) {
+	if document, ok := b.documents[requestPath]; ok {
+		writeFakeBackendResponse(w, http.StatusOK, document.contentType, document.payload)
+		return
+	}
+	if b.collections[requestPath] {
+		writeFakeBackendResponse(w, http.StatusOK, jsonContentType, b.list(requestPath))
+		return
+	}
+	writeFakeBackendError(w, http.StatusNotFound, "%s not found", requestPath)
+}
+
+func (b *fakeInstanaBackendImpl) handlePost(w http.ResponseWriter, requestPath string, contentType string, payload []byte) {
+	if len(payload) == 0 && contentType == "" {
+		b.handleOperation(w, requestPath)
+		return
+	}
+	if _, ok := b.documents[requestPath]; ok {
+		b.handleUpsert(w, requestPath, contentType, payload)
+		return
+	}
+
+	object, ok := unmarshalJSONObject(payload)
+	if !ok {
+		writeFakeBackendError(w, http.StatusBadRequest, "the payload of POST %s must be a JSON object", requestPath)
+		return
+	}
+	idField := b.idField(requestPath)
+	id, _ := object[idField].(string)
+	if id == "" {
+		b.idCounter++
+		id = fmt.Sprintf("%s%d", FakeInstanaBackendIDPrefix, b.idCounter)
+		object[idField] = id
+		payload, _ = json.Marshal(object)
+	}
+	documentPath := requestPath + "/" + id
+	if _, exists := b.documents[documentPath]; exists {
+		writeFakeBackendError(w, http.StatusConflict, "%s already exists", documentPath)
+		return
+	}
+	document := b.store(documentPath, payload, contentType)
+	writeFakeBackendResponse(w, http.StatusOK, document.contentType, document.payload)
+}
+
+func (b *fakeInstanaBackendImpl) handlePut(w http.ResponseWriter, requestPath string, contentType string, payload []byte) {
+	if len(payload) == 0 && contentType == "" {
+		b.handleOperation(w, requestPath)
+		return
+	}
+	if path.Base(requestPath) == fakeBackendOrderOperation {
+		var ids []string
+		if err := json.Unmarshal(payload, &ids); err == nil {
+			b.handleOrder(w, path.Dir(requestPath), ids)
+			return
+		}
+	}
+	b.handleUpsert(w, requestPath, contentType, payload)
+}
+
+func (b *fakeInstanaBackendImpl) handleUpsert(w http.ResponseWriter, requestPath string, contentType string, payload []byte) {
+	if object, ok := unmarshalJSONObject(payload); ok {
+		idField := b.idField(path.Dir(requestPath))
+		value, hasID := object[idField]
+		id, _ := value.(string)
+		if id != "" && id != path.Base(requestPath) {
+			writeFakeBackendError(w, http.StatusBadRequest, "the ID %s of the payload does not match the path %s", id, requestPath)
+			return
+		}
+		if hasID && id == "" {
+			object[idField] = path.Base(requestPath)
+			payload, _ = json.Marshal(object)
+		}
+	}
+	document := b.store(requestPath, payload, contentType)
+	writeFakeBackendResponse(w, http.StatusOK, document.contentType, document.payload)
+}
+
+// handleOperation handles the operations without payload on existing objects like <collection>/<id>/enable. The
+// operation applies to the closest object of the request path.
+func (b *fakeInstanaBackendImpl) handleOperation(w http.ResponseWriter, requestPath string) {
+	objectPath := path.Dir(requestPath)
+	document, ok := b.documents[objectPath]
+	for !ok && objectPath != "/" {
+		objectPath = path.Dir(objectPath)
+		document, ok = b.documents[objectPath]
+	}
+	if !ok {
+		writeFakeBackendError(w, http.StatusNotFound, "%s not found", path.Dir(requestPath))
+		return
+	}
+	operation := strings.TrimPrefix(requestPath, objectPath+"/")
+	if operation == fakeBackendEnableOperation || operation == fakeBackendDisableOperation {
+		object, ok := unmarshalJSONObject(document.payload)
+		if !ok {
+			writeFakeBackendError(w, http.StatusBadRequest, "%s cannot be enabled or disabled", objectPath)
+			return
+		}
+		object[fakeBackendEnabledField] = operation == fakeBackendEnableOperation
+		document.payload, _ = json.Marshal(object)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (b *fakeInstanaBackendImpl) handleOrder(w http.ResponseWriter, collectionPath string, ids []string) {
+	children := b.children(collectionPath)
+	if len(ids) != len(children) {
+		writeFakeBackendError(w, http.StatusBadRequest, "the order must contain the IDs of all %d objects of %s", len(children), collectionPath)
+		return
+	}
+	for _, id := range ids {
+		if !slices.Contains(children, collectionPath+"/"+id) {
+			writeFakeBackendError(w, http.StatusBadRequest, "%s/%s not found", collectionPath, id)
+			return
+		}
+	}
+	for _, id := range ids {
+		b.sequence++
+		b.documents[collectionPath+"/"+id].sequence = b.sequence
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (b *fakeInstanaBackendImpl) handleDelete(w http.ResponseWriter, requestPath string) {
+	if !b.delete(requestPath) {
+		writeFakeBackendError(w, http.StatusNotFound, "%s not found", requestPath)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// store stores the payload at the given path. The sequence of existing documents is kept, so that updates do not
+// change the order of the objects of a collection.
+func (b *fakeInstanaBackendImpl) store(documentPath string, payload []byte, contentType string) *fakeDocument {
+	if contentType == "" {
+		contentType = jsonContentType
+	}
+	document, ok := b.documents[documentPath]
+	if !ok {
+		b.sequence++
+		document = &fakeDocument{sequence: b.sequence}
+		b.documents[documentPath] = document
+	}
+	document.payload = payload
+	document.contentType = contentType
+	b.collections[path.Dir(documentPath)] = true
+	return document
+}
+
+func (b *fakeInstanaBackendImpl) delete(documentPath string) bool {
+	if _, ok := b.documents[documentPath]; !ok {
+		return false
+	}
+	for candidate := range b.documents {
+		if candidate == documentPath || strings.HasPrefix(candidate, documentPath+"/") {
+			delete(b.documents, candidate)
+		}
+	}
+	return true
+}
+
+// children returns the paths of the JSON objects of the given collection in the order of the collection
+func (b *fakeInstanaBackendImpl) children(collectionPath string) []string {
+	var paths []string
+	for documentPath, document := range b.documents {
+		if path.Dir(documentPath) == collectionPath && isJSONContentType(document.contentType) {
+			if _, ok := unmarshalJSONObject(document.payload); ok {
+				paths = append(paths, documentPath)
+			}
+		}
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		return b.documents[paths[i]].sequence < b.documents[paths[j]].sequence
+	})
+	return paths
+}
+
+func (b *fakeInstanaBackendImpl) list(collectionPath string) []byte {
+	objects := make([]json.RawMessage, 0)
+	for _, documentPath := range b.children(collectionPath) {
+		objects = append(objects, b.documents[documentPath].payload)
+	}
+	data, _ := json.Marshal(objects)
+	return data
+}
+
+func (b *fakeInstanaBackendImpl) idField(collectionPath string) string {
+	if field, ok := b.idFields[collectionPath]; ok {
+		return field
+	}
+	return fakeBackendIDField
+}
+
+func cleanPath(requestPath string) string {
+	return path.Clean("/" + requestPath)
+}
+
+func isJSONContentType(contentType string) bool {
+	return contentType == "" || strings.Contains(contentType, "json")
+}
+
+func unmarshalJSONObject(payload []byte) (map[string]any, bool) {
+	var object map[string]any
+	if err := json.Unmarshal(payload, &object); err != nil || object == nil {
+		return nil, false
+	}
+	return object, true
+}
+
+func writeFakeBackendResponse(w http.ResponseWriter, statusCode int, contentType string, payload []byte) {
+	w.Header().Set(contentTypeHeaderName, contentType)
+	w.WriteHeader(statusCode)
+	if _, err := w.Write(payload); err != nil {
+		log.Printf("failed to write response of fake backend; %s", err)
+	}
+}
+
+func writeFakeBackendError(w http.ResponseWriter, statusCode int, format string, args ...any) {
+	payload, _ := json.Marshal(map[string]any{
+		"code":    statusCode,
+		"message": fmt.Sprintf(format, args...),
+	})
+	writeFakeBackendResponse(w, statusCode, jsonContentType, payload)
+}
@@ -0,0 +1,318 @@
+package testutils_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/instana/instana-go-client/client"
+	"github.com/instana/instana-go-client/config"
+	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
+	"github.com/instana/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fakeBackendCollectionPath = "/api/test-objects"
+
+type fakeBackendTestObject struct {
+	ID      string `json:"id,omitempty"`
+	Name    string `json:"name"`
+	Enabled *bool  `json:"enabled,omitempty"`
+}
+
+func (o *fakeBackendTestObject) GetIDForResourcePath() string {
+	return o.ID
+}
+
+func newFakeBackendTestRestResource(backend testutils.FakeInstanaBackend, createMode instanaapi.CreateMode) rest.RestResource[*fakeBackendTestObject] {
+	clientConfig := config.DefaultClientConfig()
+	clientConfig.BaseURL = backend.URL()
+	clientConfig.APIToken = testutils.FakeInstanaBackendAPIToken
+	return instanaapi.NewRestResource[*fakeBackendTestObject](fakeBackendCollectionPath, createMode, instanaapi.UpdateModePUT, instanaapi.NewRestClient(clientConfig))
+}
+
+func executeFakeBackendRequest(t *testing.T, backend testutils.FakeInstanaBackend, method string, path string, payload string) (int, string) {
+	var body io.Reader
+	if payload != "" {
+		body = bytes.NewBufferString(payload)
+	}
+	req, err := http.NewRequest(method, backend.URL()+path, body)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "apiToken "+testutils.FakeInstanaBackendAPIToken)
+	if payload != "" {
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	responseBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(responseBody)
+}
+
+func TestFakeBackendShouldCreateReadUpdateAndDeleteObjectsWithAssignedIDs(t *testing.T) {
+	backend := testutils.NewFakeInstanaBackend()
+	defer backend.Close()
+	sut := newFakeBackendTestRestResource(backend, instanaapi.CreateModePOST)
+
+	created, err := sut.Create(&fakeBackendTestObject{Name: "first"})
+	require.NoError(t, err)
+	second, err := sut.Create(&fakeBackendTestObject{Name: "second"})
+	require.NoError(t, err)
+
+	assert.Equal(t, testutils.FakeInstanaBackendIDPrefix+"1", created.ID)
+	assert.Equal(t, testutils.FakeInstanaBackendIDPrefix+"2", second.ID)
+
+	read, err := sut.GetOne(created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created, read)
+
+	updated, err := sut.Update(&fakeBackendTestObject{ID: created.ID, Name: "updated"})
+	require.NoError(t, err)
+	assert.Equal(t, "updated", updated.Name)
+
+	all, err := sut.GetAll()
+	require.NoError(t, err)
+	assert.Equal(t, []*fakeBackendTestObject{updated, second}, *all)
+
+	require.NoError(t, sut.Delete(updated))
+	_, err = sut.GetOne(created.ID)
+	assert.True(t, errors.Is(err, client.ErrEntityNotFound))
+	assert.Equal(t, []string{fakeBackendCollectionPath + "/" + second.ID}, backend.CreatedDocumentPaths())
+	assert.Equal(t, 1, backend.GetCallCount(http.MethodDelete, fakeBackendCollectionPath+"/"+created.ID))
+}
+
+func TestFakeBackendShouldKeepIDsProvidedByTheClient(t *testing.T) {
+	backend := testutils.NewFakeInstanaBackend()
+	defer backend.Close()
+
+	postResult, err := newFakeBackendTestRestResource(backend, instanaapi.CreateModePOST).Create(&fakeBackendTestObject{ID: "post-id", Name: "post"})
+	require.NoError(t, err)
+	putResult, err := newFakeBackendTestRestResource(backend, instanaapi.CreateModePUT).Create(&fakeBackendTestObject{ID: "put-id", Name: "put"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "post-id", postResult.ID)
+	assert.Equal(t, "put-id", putResult.ID)
+	assert.Equal(t, []string{fakeBackendCollectionPath + "/post-id"}, backend.FindDocumentPaths("post-id"))
+	assert.Equal(t, []string{fakeBackendCollectionPath + "/put-id"}, backend.FindDocumentPaths("put-id"))
+}
+
+func TestFakeBackendShouldReturnNotFoundForUnknownObjects(t *testing.T) {
+	backend := testutils.NewFakeInstanaBackend()
+	defer backend.Close()
+	sut := newFakeBackendTestRestResource(backend, instanaapi.CreateModePOST)
+
+	_, err := sut.GetOne("unknown")
+	assert.True(t, errors.Is(err, client.ErrEntityNotFound))
+
+	_, err = sut.GetAll()
+	assert.True(t, errors.Is(err, client.ErrEntityNotFound))
+
+	err = sut.Delete(&fakeBackendTestObject{ID: "unknown"})
+	assert.True(t, errors.Is(err, client.ErrEntityNotFound))
+
+	statusCode, _ := executeFakeBackendRequest(t, backend, http.MethodPut, fakeBackendCollectionPath+"/unknown/enable", "")
+	assert.Equal(t, http.StatusNotFound, statusCode)
+}
+
+func TestFakeBackendShouldReturnEmptyListForCollectionsWithoutObjects(t *testing.T) {
+	backend := testutils.NewFakeInstanaBackend()
+	defer backend.Close()
+	sut := newFakeBackendTestRestResource(backend, instanaapi.CreateModePOST)
+
+	created, err := sut.Create(&fakeBackendTestObject{Name: "name"})
+	require.NoError(t, err)
+	require.NoError(t, sut.Delete(created))
+
+	all, err := sut.GetAll()
+	require.NoError(t, err)
+	assert.Empty(t, *all)
+}
+
+func TestFakeBackendShouldRejectInvalidRequests(t *testing.T) {
+	backend := testutils.NewFakeInstanaBackend()
+	defer backend.Close()
+	backend.SetDocument(fakeBackendCollectionPath+"/existing", fakeBackendTestObject{ID: "existing", Name: "existing"})
+
+	testCases := map[string]struct {
+		method       string
+		path         string
+		payload      string
+		expectedCode int
+	}{
+		"invalid json":           {method: http.MethodPost, path: fakeBackendCollectionPath, payload: "{invalid", expectedCode: http.StatusBadRequest},
+		"no json object":         {method: http.MethodPost, path: fakeBackendCollectionPath, payload: "[]", expectedCode: http.StatusBadRequest},
+		"id not matching path":   {method: http.MethodPut, path: fakeBackendCollectionPath + "/other", payload: `{"id":"existing"}`, expectedCode: http.StatusBadRequest},
+		"duplicate id":           {method: http.MethodPost, path: fakeBackendCollectionPath, payload: `{"id":"existing"}`, expectedCode: http.StatusConflict},
+		"unsupported method":     {method: http.MethodPatch, path: fakeBackendCollectionPath + "/existing", payload: `{"id":"existing"}`, expectedCode: http.StatusMethodNotAllowed},
+		"order with unknown ids": {method: http.MethodPut, path: fakeBackendCollectionPath + "/order", payload: `["unknown"]`, expectedCode: http.StatusBadRequest},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			statusCode, body := executeFakeBackendRequest(t, backend, testCase.method, testCase.path, testCase.payload)
+
+			assert.Equal(t, testCase.expectedCode, statusCode)
+			assert.Contains(t, body, `"message"`)
+		})
+	}
+
+	document, ok := backend.GetDocument(fakeBackendCollectionPath + "/existing")
+	require.True(t, ok)
+	assert.JSONEq(t, `{"id":"existing","name":"existing"}`, string(document))
+	assert.Empty(t, backend.CreatedDocumentPaths())
+}
+
+func TestFakeBackendShouldRejectPayloadsRejectedByValidators(t *testing.T) {
+	backend := testutils.NewFakeInstanaBackend()
+	defer backend.Close()
+	backend.AddValidator(fakeBackendCollectionPath, func(_ string, _ string, payload []byte) error {
+		object := fakeBackendTestObject{}
+		if err := json.Unmarshal(payload, &object); err != nil {
+			return err
+		}
+		if object.Name == "" {
+			return errors.New("name is required")
+		}
+		return nil
+	})
+	sut := newFakeBackendTestRestResource(backend, instanaapi.CreateModePOST)
+
+	_, err := sut.Create(&fakeBackendTestObject{})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status code = 400")
+	assert.Contains(t, err.Error(), "name is required")
+	assert.Empty(t, backend.CreatedDocumentPaths())
+}
+
+func TestFakeBackendShouldRejectRequestsWithoutValidAPIToken(t *testing.T) {
+	backend := testutils.NewFakeInstanaBackend()
+	defer backend.Close()
+
+	resp, err := http.Get(backend.URL() + fakeBackendCollectionPath)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestFakeBackendShouldToggleEnabledFlagOfObjects(t *testing.T) {
+	backend := testutils.NewFakeInstanaBackend()
+	defer backend.Close()
+	backend.SetDocument(fakeBackendCollectionPath+"/builtin", fakeBackendTestObject{ID: "builtin", Name: "builtin"})
+	sut := newFakeBackendTestRestResource(backend, instanaapi.CreateModePOST)
+
+	statusCode, _ := executeFakeBackendRequest(t, backend, http.MethodPost, fakeBackendCollectionPath+"/builtin/disable", "")
+	require.Equal(t, http.StatusNoContent, statusCode)
+	disabled, err := sut.GetOne("builtin")
+	require.NoError(t, err)
+
+	statusCode, _ = executeFakeBackendRequest(t, backend, http.MethodPut, fakeBackendCollectionPath+"/builtin/enable", "")
+	require.Equal(t, http.StatusNoContent, statusCode)
+	enabled, err := sut.GetOne("builtin")
+	require.NoError(t, err)
+
+	assert.False(t, *disabled.Enabled)
+	assert.True(t, *enabled.Enabled)
+	assert.Empty(t, backend.CreatedDocumentPaths())
+}
+
+func TestFakeBackendShouldAcceptOperationsWithoutPayloadOnNestedPathsOfExistingObjects(t *testing.T) {
+	backend := testutils.NewFakeInstanaBackend()
+	defer backend.Close()
+	backend.SetDocument(fakeBackendCollectionPath+"/config", fakeBackendTestObject{ID: "config", Name: "config"})
+
+	statusCode, _ := executeFakeBackendRequest(t, backend, http.MethodPut, fakeBackendCollectionPath+"/config/restore/1234", "")
+	assert.Equal(t, http.StatusNoContent, statusCode)
+	statusCode, _ = executeFakeBackendRequest(t, backend, http.MethodPut, fakeBackendCollectionPath+"/unknown/restore/1234", "")
+	assert.Equal(t, http.StatusNotFound, statusCode)
+}
+
+func TestFakeBackendShouldSetEmptyIDOfObjectsCreatedWithPUTToIDOfPath(t *testing.T) {
+	backend := testutils.NewFakeInstanaBackend()
+	defer backend.Close()
+
+	statusCode, body := executeFakeBackendRequest(t, backend, http.MethodPut, fakeBackendCollectionPath+"/internal-id", `{"id":"","name":"token"}`)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	assert.JSONEq(t, `{"id":"internal-id","name":"token"}`, body)
+}
+
+func TestFakeBackendShouldAssignIDsToConfiguredIDField(t *testing.T) {
+	backend := testutils.NewFakeInstanaBackend()
+	defer backend.Close()
+	backend.SetIDField(fakeBackendCollectionPath, "name")
+
+	statusCode, _ := executeFakeBackendRequest(t, backend, http.MethodPost, fakeBackendCollectionPath, `{"name":"service-id"}`)
+	require.Equal(t, http.StatusOK, statusCode)
+	statusCode, body := executeFakeBackendRequest(t, backend, http.MethodGet, fakeBackendCollectionPath+"/service-id", "")
+	require.Equal(t, http.StatusOK, statusCode)
+	assert.JSONEq(t, `{"name":"service-id"}`, body)
+
+	statusCode, _ = executeFakeBackendRequest(t, backend, http.MethodPut, fakeBackendCollectionPath+"/other-id", `{"name":"service-id"}`)
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+}
+
+func TestFakeBackendShouldStoreEmptyPayloadsWithContentType(t *testing.T) {
+	backend := testutils.NewFakeInstanaBackend()
+	defer backend.Close()
+	rulesPath := "/api/test-settings/config/geo-mapping-rules"
+	backend.SetRawDocument(rulesPath, "text/csv", []byte("a,b,c"))
+	clientConfig := config.DefaultClientConfig()
+	clientConfig.BaseURL = backend.URL()
+	clientConfig.APIToken = testutils.FakeInstanaBackendAPIToken
+	restClient := instanaapi.NewRestClient(clientConfig)
+
+	_, err := restClient.PutWithMediaType(rulesPath, "text/csv", []byte{})
+	require.NoError(t, err)
+	data, err := restClient.GetWithMediaType(rulesPath, "text/csv")
+	require.NoError(t, err)
+
+	assert.Empty(t, data)
+	assert.Empty(t, backend.CreatedDocumentPaths())
+}
+
+func TestFakeBackendShouldChangeOrderOfObjects(t *testing.T) {
+	backend := testutils.NewFakeInstanaBackend()
+	defer backend.Close()
+	sut := newFakeBackendTestRestResource(backend, instanaapi.CreateModePOST)
+	first, err := sut.Create(&fakeBackendTestObject{Name: "first"})
+	require.NoError(t, err)
+	second, err := sut.Create(&fakeBackendTestObject{Name: "second"})
+	require.NoError(t, err)
+
+	statusCode, _ := executeFakeBackendRequest(t, backend, http.MethodPut, fakeBackendCollectionPath+"/order", `["`+second.ID+`","`+first.ID+`"]`)
+	require.Equal(t, http.StatusNoContent, statusCode)
+
+	all, err := sut.GetAll()
+	require.NoError(t, err)
+	assert.Equal(t, []*fakeBackendTestObject{second, first}, *all)
+}
+
+func TestFakeBackendShouldOverwriteAndDeleteSingletonsIncludingNestedDocuments(t *testing.T) {
+	backend := testutils.NewFakeInstanaBackend()
+	defer backend.Close()
+	settingsPath := "/api/settings/test"
+
+	statusCode, _ := executeFakeBackendRequest(t, backend, http.MethodPut, settingsPath, `{"value":1}`)
+	require.Equal(t, http.StatusOK, statusCode)
+	statusCode, _ = executeFakeBackendRequest(t, backend, http.MethodPut, settingsPath+"/nested", `{"value":2}`)
+	require.Equal(t, http.StatusOK, statusCode)
+	statusCode, body := executeFakeBackendRequest(t, backend, http.MethodGet, settingsPath, "")
+	require.Equal(t, http.StatusOK, statusCode)
+	assert.JSONEq(t, `{"value":1}`, body)
+
+	assert.True(t, backend.DeleteDocument(settingsPath))
+
+	statusCode, _ = executeFakeBackendRequest(t, backend, http.MethodGet, settingsPath+"/nested", "")
+	assert.Equal(t, http.StatusNotFound, statusCode)
+	assert.False(t, backend.DeleteDocument(settingsPath))
+	assert.Empty(t, backend.CreatedDocumentPaths())
+}
@@ -3,6 +3,7 @@ package testutils
 import (
 	"github.com/instana/instana-go-client/api"
 	"github.com/instana/instana-go-client/shared/rest"
+	"github.com/instana/terraform-provider-instana/internal/instanaapi"
 )
 
 // MockInstanaAPI is a mock implementation of the InstanaAPI interface for testing purposes.
@@ -179,3 +180,83 @@ func (m *MockInstanaAPI) GroupMappings() rest.RestResource[*api.GroupMapping] {
 func (m *MockInstanaAPI) SessionSettings() rest.SingletonRestResource[*api.SessionSettings] {
 	return nil
 }
+
+// Releases mock implementation
+func (m *MockInstanaAPI) Releases() rest.RestResource[*instanaapi.Release] {
+	return nil
+}
+
+// HTTPEndpointConfigs mock implementation
+func (m *MockInstanaAPI) HTTPEndpointConfigs() rest.RestResource[*instanaapi.HTTPEndpointConfig] {
+	return nil
+}
+
+// ServiceConfigs mock implementation
+func (m *MockInstanaAPI) ServiceConfigs() rest.RestResource[*instanaapi.ServiceConfig] {
+	return nil
+}
+
+// ManualServiceConfigs mock implementation
+func (m *MockInstanaAPI) ManualServiceConfigs() rest.RestResource[*instanaapi.ManualServiceConfig] {
+	return nil
+}
+
+// CustomPayloadConfiguration mock implementation
+func (m *MockInstanaAPI) CustomPayloadConfiguration() rest.SingletonRestResource[*instanaapi.CustomPayloadConfiguration] {
+	return nil
+}
+
+// CustomPayloadTagCatalog mock implementation
+func (m *MockInstanaAPI) CustomPayloadTagCatalog() (*instanaapi.TagCatalog, error) {
+	return nil, nil
+}
+
+// SyntheticCallsSettings mock implementation
+func (m *MockInstanaAPI) SyntheticCallsSettings() rest.SingletonRestResource[*instanaapi.SyntheticCallsSettings] {
+	return nil
+}
+
+// GeoLocationConfigurations mock implementation
+func (m *MockInstanaAPI) GeoLocationConfigurations(_ instanaapi.EUMApplicationType) rest.RestResource[*instanaapi.GeoLocationConfiguration] {
+	return nil
+}
+
+// GeoMappingRules mock implementation
+func (m *MockInstanaAPI) GeoMappingRules(_ instanaapi.EUMApplicationType) rest.RestResource[*instanaapi.GeoMappingRules] {
+	return nil
+}
+
+// IPMaskingConfigurations mock implementation
+func (m *MockInstanaAPI) IPMaskingConfigurations(_ instanaapi.EUMApplicationType) rest.RestResource[*instanaapi.IPMaskingConfiguration] {
+	return nil
+}
+
+// EnablementToggle mock implementation
+func (m *MockInstanaAPI) EnablementToggle(_ string) instanaapi.EnablementToggle {
+	return nil
+}
+
+// BaselineUpdater mock implementation
+func (m *MockInstanaAPI) BaselineUpdater(_ string) instanaapi.BaselineUpdater {
+	return nil
+}
+
+// AlertConfigVersionHistory mock implementation
+func (m *MockInstanaAPI) AlertConfigVersionHistory(_ string) instanaapi.AlertConfigVersionHistory {
+	return nil
+}
+
+// AlertConfigVersionPins mock implementation
+func (m *MockInstanaAPI) AlertConfigVersionPins() rest.RestResource[*instanaapi.AlertConfigVersionPin] {
+	return nil
+}
+
+// BuiltinEventStates mock implementation
+func (m *MockInstanaAPI) BuiltinEventStates() rest.RestResource[*instanaapi.BuiltinEventState] {
+	return nil
+}
+
+// BackendInfo mock implementation
+func (m *MockInstanaAPI) BackendInfo() instanaapi.BackendInfoReader {
+	return nil
+}
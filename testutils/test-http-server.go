@@ -8,6 +8,7 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -73,6 +74,7 @@ type testHTTPServerImpl struct {
 	httpServer  *http.Server
 	listener    net.Listener
 	callCounter map[string]int
+	mutex       sync.Mutex
 }
 
 // GetPort returns the dynamic server port
@@ -85,6 +87,8 @@ func (server *testHTTPServerImpl) GetPort() int {
 
 // GetCallCount returns the call counter for the given method and path
 func (server *testHTTPServerImpl) GetCallCount(method string, path string) int {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
 	key := method + "_" + path
 	val, ok := server.callCounter[key]
 	if !ok {
@@ -100,12 +104,14 @@ func (server *testHTTPServerImpl) AddRoute(method string, path string, handlerFu
 
 func (server *testHTTPServerImpl) wrapHandlerFunc(handlerFunc http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		server.mutex.Lock()
 		key := r.Method + "_" + r.URL.Path
 		val, ok := server.callCounter[key]
 		if !ok {
 			val = 0
 		}
 		server.callCounter[key] = val + 1
+		server.mutex.Unlock()
 		handlerFunc(w, r)
 	}
 }